@@ -6,6 +6,18 @@ package atomix
 
 import "unsafe"
 
+// CanPlaceAligned2 reports whether a 2-byte aligned value can be placed
+// in p starting at offset off. Returns true if there is sufficient space
+// for alignment padding plus 2 bytes.
+func CanPlaceAligned2(p []byte, off int) bool {
+	if off < 0 || off > len(p) {
+		return false
+	}
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := (2 - (addr & 1)) & 1
+	return off+int(pad)+2 <= len(p)
+}
+
 // CanPlaceAligned4 reports whether a 4-byte aligned value can be placed
 // in p starting at offset off. Returns true if there is sufficient space
 // for alignment padding plus 4 bytes.
@@ -53,6 +65,58 @@ func CanPlaceCacheAligned(p []byte, off, size int) bool {
 	return off+pad+size <= len(p)
 }
 
+// PlaceAlignedInt8 places an Int8 at offset off in p (no alignment needed).
+//
+//go:nocheckptr
+func PlaceAlignedInt8(p []byte, off int) (n int, a *Int8) {
+	n = 1
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Int8")
+	}
+	a = (*Int8)(unsafe.Pointer(&p[off]))
+	return n, a
+}
+
+// PlaceAlignedUint8 places a Uint8 at offset off in p (no alignment needed).
+//
+//go:nocheckptr
+func PlaceAlignedUint8(p []byte, off int) (n int, a *Uint8) {
+	n = 1
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Uint8")
+	}
+	a = (*Uint8)(unsafe.Pointer(&p[off]))
+	return n, a
+}
+
+// PlaceAlignedInt16 places an Int16 at a 2-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedInt16(p []byte, off int) (n int, a *Int16) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((2 - (addr & 1)) & 1)
+	n = pad + 2
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Int16")
+	}
+	a = (*Int16)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceAlignedUint16 places a Uint16 at a 2-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedUint16(p []byte, off int) (n int, a *Uint16) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((2 - (addr & 1)) & 1)
+	n = pad + 2
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Uint16")
+	}
+	a = (*Uint16)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
 // PlaceAlignedInt32 places an Int32 at a 4-byte aligned address in p.
 // Returns the number of bytes consumed (padding + 4) and a pointer to the Int32.
 // Panics if there is insufficient space.
@@ -125,6 +189,62 @@ func PlaceAlignedUint64(p []byte, off int) (n int, a *Uint64) {
 	return n, a
 }
 
+// PlaceAlignedFloat32 places a Float32 at a 4-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedFloat32(p []byte, off int) (n int, a *Float32) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((4 - (addr & 3)) & 3)
+	n = pad + 4
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Float32")
+	}
+	a = (*Float32)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceAlignedFloat64 places a Float64 at a 8-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedFloat64(p []byte, off int) (n int, a *Float64) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((8 - (addr & 7)) & 7)
+	n = pad + 8
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Float64")
+	}
+	a = (*Float64)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceAlignedDuration places a Duration at an 8-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedDuration(p []byte, off int) (n int, a *Duration) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((8 - (addr & 7)) & 7)
+	n = pad + 8
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Duration")
+	}
+	a = (*Duration)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceAlignedTime places a Time at an 8-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedTime(p []byte, off int) (n int, a *Time) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((8 - (addr & 7)) & 7)
+	n = pad + 8
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Time")
+	}
+	a = (*Time)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
 // PlaceAlignedUintptr places a Uintptr at a pointer-aligned address in p.
 //
 //go:nocheckptr
@@ -141,12 +261,14 @@ func PlaceAlignedUintptr(p []byte, off int) (n int, a *Uintptr) {
 }
 
 // PlaceAlignedInt128 places an Int128 at a 16-byte aligned address in p.
+// The value occupies the 16 bytes at that address; n counts the padding
+// before them and the whole Int128, unsafe.Sizeof(Int128{}) bytes.
 //
 //go:nocheckptr
 func PlaceAlignedInt128(p []byte, off int) (n int, a *Int128) {
 	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
 	pad := int((16 - (addr & 15)) & 15)
-	n = pad + 16
+	n = pad + int(unsafe.Sizeof(Int128{}))
 	if off+n > len(p) {
 		panic("atomix: insufficient space for Int128")
 	}
@@ -155,12 +277,14 @@ func PlaceAlignedInt128(p []byte, off int) (n int, a *Int128) {
 }
 
 // PlaceAlignedUint128 places a Uint128 at a 16-byte aligned address in p.
+// The value occupies the 16 bytes at that address; n counts the padding
+// before them and the whole Uint128, unsafe.Sizeof(Uint128{}) bytes.
 //
 //go:nocheckptr
 func PlaceAlignedUint128(p []byte, off int) (n int, a *Uint128) {
 	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
 	pad := int((16 - (addr & 15)) & 15)
-	n = pad + 16
+	n = pad + int(unsafe.Sizeof(Uint128{}))
 	if off+n > len(p) {
 		panic("atomix: insufficient space for Uint128")
 	}
@@ -168,6 +292,62 @@ func PlaceAlignedUint128(p []byte, off int) (n int, a *Uint128) {
 	return n, a
 }
 
+// PlaceCacheAlignedInt8 places an Int8Padded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedInt8(p []byte, off int) (n int, a *Int8Padded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Int8Padded")
+	}
+	a = (*Int8Padded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceCacheAlignedUint8 places a Uint8Padded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedUint8(p []byte, off int) (n int, a *Uint8Padded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Uint8Padded")
+	}
+	a = (*Uint8Padded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceCacheAlignedInt16 places an Int16Padded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedInt16(p []byte, off int) (n int, a *Int16Padded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Int16Padded")
+	}
+	a = (*Int16Padded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceCacheAlignedUint16 places a Uint16Padded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedUint16(p []byte, off int) (n int, a *Uint16Padded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Uint16Padded")
+	}
+	a = (*Uint16Padded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
 // PlaceCacheAlignedInt32 places an Int32Padded at a cache-line aligned address.
 //
 //go:nocheckptr
@@ -224,6 +404,62 @@ func PlaceCacheAlignedUint64(p []byte, off int) (n int, a *Uint64Padded) {
 	return n, a
 }
 
+// PlaceCacheAlignedFloat32 places a Float32Padded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedFloat32(p []byte, off int) (n int, a *Float32Padded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Float32Padded")
+	}
+	a = (*Float32Padded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceCacheAlignedFloat64 places a Float64Padded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedFloat64(p []byte, off int) (n int, a *Float64Padded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for Float64Padded")
+	}
+	a = (*Float64Padded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceCacheAlignedDuration places a DurationPadded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedDuration(p []byte, off int) (n int, a *DurationPadded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for DurationPadded")
+	}
+	a = (*DurationPadded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
+// PlaceCacheAlignedTime places a TimePadded at a cache-line aligned address.
+//
+//go:nocheckptr
+func PlaceCacheAlignedTime(p []byte, off int) (n int, a *TimePadded) {
+	addr := uintptr(unsafe.Pointer(&p[0])) + uintptr(off)
+	pad := int((CacheLineSize - int(addr&(CacheLineSize-1))) & (CacheLineSize - 1))
+	n = pad + CacheLineSize
+	if off+n > len(p) {
+		panic("atomix: insufficient space for TimePadded")
+	}
+	a = (*TimePadded)(unsafe.Pointer(&p[off+pad]))
+	return n, a
+}
+
 // PlaceCacheAlignedUintptr places a UintptrPadded at a cache-line aligned address.
 //
 //go:nocheckptr
@@ -279,3 +515,43 @@ func PlaceCacheAlignedUint128(p []byte, off int) (n int, a *Uint128Padded) {
 	a = (*Uint128Padded)(unsafe.Pointer(&p[off+pad]))
 	return n, a
 }
+
+// PlaceAlignedInt32Of places an Int32Of[T] at a 4-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedInt32Of[T ~int32](p []byte, off int) (n int, a *Int32Of[T]) {
+	n, b := PlaceAlignedInt32(p, off)
+	return n, (*Int32Of[T])(unsafe.Pointer(b))
+}
+
+// PlaceAlignedUint32Of places a Uint32Of[T] at a 4-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedUint32Of[T ~uint32](p []byte, off int) (n int, a *Uint32Of[T]) {
+	n, b := PlaceAlignedUint32(p, off)
+	return n, (*Uint32Of[T])(unsafe.Pointer(b))
+}
+
+// PlaceAlignedInt64Of places an Int64Of[T] at an 8-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedInt64Of[T ~int64](p []byte, off int) (n int, a *Int64Of[T]) {
+	n, b := PlaceAlignedInt64(p, off)
+	return n, (*Int64Of[T])(unsafe.Pointer(b))
+}
+
+// PlaceAlignedUint64Of places a Uint64Of[T] at an 8-byte aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedUint64Of[T ~uint64](p []byte, off int) (n int, a *Uint64Of[T]) {
+	n, b := PlaceAlignedUint64(p, off)
+	return n, (*Uint64Of[T])(unsafe.Pointer(b))
+}
+
+// PlaceAlignedUintptrOf places a UintptrOf[T] at a pointer-aligned address in p.
+//
+//go:nocheckptr
+func PlaceAlignedUintptrOf[T ~uintptr](p []byte, off int) (n int, a *UintptrOf[T]) {
+	n, b := PlaceAlignedUintptr(p, off)
+	return n, (*UintptrOf[T])(unsafe.Pointer(b))
+}
@@ -56,6 +56,34 @@ func (a *Allocator) Align(alignment int) {
 	a.off += pad
 }
 
+// Int8 allocates and returns an Int8 at the current offset.
+func (a *Allocator) Int8() *Int8 {
+	n, ptr := PlaceAlignedInt8(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// Uint8 allocates and returns a Uint8 at the current offset.
+func (a *Allocator) Uint8() *Uint8 {
+	n, ptr := PlaceAlignedUint8(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// Int16 allocates and returns an Int16 at a 2-byte aligned address.
+func (a *Allocator) Int16() *Int16 {
+	n, ptr := PlaceAlignedInt16(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// Uint16 allocates and returns a Uint16 at a 2-byte aligned address.
+func (a *Allocator) Uint16() *Uint16 {
+	n, ptr := PlaceAlignedUint16(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
 // Int32 allocates and returns an Int32 at a 4-byte aligned address.
 func (a *Allocator) Int32() *Int32 {
 	n, ptr := PlaceAlignedInt32(a.buf, a.off)
@@ -91,6 +119,34 @@ func (a *Allocator) Uint64() *Uint64 {
 	return ptr
 }
 
+// Float32 allocates and returns a Float32 at a 4-byte aligned address.
+func (a *Allocator) Float32() *Float32 {
+	n, ptr := PlaceAlignedFloat32(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// Float64 allocates and returns a Float64 at an 8-byte aligned address.
+func (a *Allocator) Float64() *Float64 {
+	n, ptr := PlaceAlignedFloat64(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// Duration allocates and returns a Duration at an 8-byte aligned address.
+func (a *Allocator) Duration() *Duration {
+	n, ptr := PlaceAlignedDuration(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// Time allocates and returns a Time at an 8-byte aligned address.
+func (a *Allocator) Time() *Time {
+	n, ptr := PlaceAlignedTime(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
 // Uintptr allocates and returns a Uintptr at a pointer-aligned address.
 func (a *Allocator) Uintptr() *Uintptr {
 	n, ptr := PlaceAlignedUintptr(a.buf, a.off)
@@ -112,6 +168,34 @@ func (a *Allocator) Uint128() *Uint128 {
 	return ptr
 }
 
+// CacheAlignedInt8 allocates and returns an Int8Padded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedInt8() *Int8Padded {
+	n, ptr := PlaceCacheAlignedInt8(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// CacheAlignedUint8 allocates and returns a Uint8Padded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedUint8() *Uint8Padded {
+	n, ptr := PlaceCacheAlignedUint8(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// CacheAlignedInt16 allocates and returns an Int16Padded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedInt16() *Int16Padded {
+	n, ptr := PlaceCacheAlignedInt16(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// CacheAlignedUint16 allocates and returns a Uint16Padded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedUint16() *Uint16Padded {
+	n, ptr := PlaceCacheAlignedUint16(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
 // CacheAlignedInt32 allocates and returns an Int32Padded at a cache-line aligned address.
 func (a *Allocator) CacheAlignedInt32() *Int32Padded {
 	n, ptr := PlaceCacheAlignedInt32(a.buf, a.off)
@@ -140,6 +224,34 @@ func (a *Allocator) CacheAlignedUint64() *Uint64Padded {
 	return ptr
 }
 
+// CacheAlignedFloat32 allocates and returns a Float32Padded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedFloat32() *Float32Padded {
+	n, ptr := PlaceCacheAlignedFloat32(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// CacheAlignedFloat64 allocates and returns a Float64Padded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedFloat64() *Float64Padded {
+	n, ptr := PlaceCacheAlignedFloat64(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// CacheAlignedDuration allocates and returns a DurationPadded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedDuration() *DurationPadded {
+	n, ptr := PlaceCacheAlignedDuration(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
+// CacheAlignedTime allocates and returns a TimePadded at a cache-line aligned address.
+func (a *Allocator) CacheAlignedTime() *TimePadded {
+	n, ptr := PlaceCacheAlignedTime(a.buf, a.off)
+	a.off += n
+	return ptr
+}
+
 // CacheAlignedUintptr allocates and returns a UintptrPadded at a cache-line aligned address.
 func (a *Allocator) CacheAlignedUintptr() *UintptrPadded {
 	n, ptr := PlaceCacheAlignedUintptr(a.buf, a.off)
@@ -167,3 +279,15 @@ func (a *Allocator) CacheAlignedUint128() *Uint128Padded {
 	a.off += n
 	return ptr
 }
+
+// Bitset allocates a Bitset of n bits with its words at an 8-byte aligned
+// address in the buffer. The bits are cleared.
+func (a *Allocator) Bitset(n int) *Bitset {
+	return a.bitset(n, 1)
+}
+
+// BitsetStriped allocates a Bitset of n bits with each word on its own
+// cache line in the buffer. The bits are cleared.
+func (a *Allocator) BitsetStriped(n int) *Bitset {
+	return a.bitset(n, CacheLineSize/8)
+}
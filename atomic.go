@@ -0,0 +1,400 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// Atomic[T] storage kinds.
+const (
+	atomicLocked = iota
+	atomicNative32
+	atomicNative64
+	atomicNative128
+)
+
+// Load atomically loads and returns the value with relaxed ordering.
+func (a *Atomic[T]) Load() T {
+	return a.load(Relaxed)
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+func (a *Atomic[T]) LoadRelaxed() T {
+	return a.load(Relaxed)
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+func (a *Atomic[T]) LoadAcquire() T {
+	return a.load(Acquire)
+}
+
+// Store atomically stores val with relaxed ordering.
+func (a *Atomic[T]) Store(val T) {
+	a.store(Relaxed, val)
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+func (a *Atomic[T]) StoreRelaxed(val T) {
+	a.store(Relaxed, val)
+}
+
+// StoreRelease atomically stores val with release ordering.
+func (a *Atomic[T]) StoreRelease(val T) {
+	a.store(Release, val)
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+func (a *Atomic[T]) Swap(new T) T {
+	return a.swap(AcqRel, new)
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+func (a *Atomic[T]) SwapRelaxed(new T) T {
+	return a.swap(Relaxed, new)
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+func (a *Atomic[T]) SwapAcquire(new T) T {
+	return a.swap(Acquire, new)
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+func (a *Atomic[T]) SwapRelease(new T) T {
+	return a.swap(Release, new)
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+func (a *Atomic[T]) SwapAcqRel(new T) T {
+	return a.swap(AcqRel, new)
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed. Values are compared bitwise.
+func (a *Atomic[T]) CompareAndSwap(old, new T) bool {
+	_, ok := a.cax(AcqRel, old, new)
+	return ok
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+func (a *Atomic[T]) CompareAndSwapRelaxed(old, new T) bool {
+	_, ok := a.cax(Relaxed, old, new)
+	return ok
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+func (a *Atomic[T]) CompareAndSwapAcquire(old, new T) bool {
+	_, ok := a.cax(Acquire, old, new)
+	return ok
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+func (a *Atomic[T]) CompareAndSwapRelease(old, new T) bool {
+	_, ok := a.cax(Release, old, new)
+	return ok
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+func (a *Atomic[T]) CompareAndSwapAcqRel(old, new T) bool {
+	_, ok := a.cax(AcqRel, old, new)
+	return ok
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering. Values are compared bitwise.
+func (a *Atomic[T]) CompareExchange(old, new T) T {
+	prev, _ := a.cax(AcqRel, old, new)
+	return prev
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+func (a *Atomic[T]) CompareExchangeRelaxed(old, new T) T {
+	prev, _ := a.cax(Relaxed, old, new)
+	return prev
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+func (a *Atomic[T]) CompareExchangeAcquire(old, new T) T {
+	prev, _ := a.cax(Acquire, old, new)
+	return prev
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+func (a *Atomic[T]) CompareExchangeRelease(old, new T) T {
+	prev, _ := a.cax(Release, old, new)
+	return prev
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+func (a *Atomic[T]) CompareExchangeAcqRel(old, new T) T {
+	prev, _ := a.cax(AcqRel, old, new)
+	return prev
+}
+
+// IsLockFree reports whether operations on a use native atomic instructions
+// rather than the lock table. The answer depends only on T.
+func (a *Atomic[T]) IsLockFree() bool {
+	return typeInfoOf[T]().kind != atomicLocked
+}
+
+// ptr returns the address of the value of a. A native kind keeps it at the
+// first naturally aligned address in a.v and the spare bytes after it, so
+// the instructions apply wherever a sits; the lock table uses a.v itself.
+func (a *Atomic[T]) ptr(ti *typeInfo) unsafe.Pointer {
+	p := unsafe.Pointer(&a.v)
+	if ti.kind == atomicLocked {
+		return p
+	}
+	return unsafe.Add(p, -uintptr(p)&(unsafe.Sizeof(a.v)-1))
+}
+
+func (a *Atomic[T]) load(o MemoryOrder) (v T) {
+	ti := typeInfoOf[T]()
+	p := a.ptr(ti)
+	switch ti.kind {
+	case atomicNative32:
+		*(*uint32)(unsafe.Pointer(&v)) = o.LoadUint32((*uint32)(p))
+	case atomicNative64:
+		*(*uint64)(unsafe.Pointer(&v)) = o.LoadUint64((*uint64)(p))
+	case atomicNative128:
+		w := (*[2]uint64)(unsafe.Pointer(&v))
+		w[0], w[1] = o.load128((*[16]byte)(p))
+	default:
+		mu := lockFor(p)
+		mu.Lock()
+		v = a.v
+		mu.Unlock()
+	}
+	return v
+}
+
+func (a *Atomic[T]) store(o MemoryOrder, val T) {
+	ti := typeInfoOf[T]()
+	p := a.ptr(ti)
+	ti.clearPadding(unsafe.Pointer(&val))
+	switch ti.kind {
+	case atomicNative32:
+		o.StoreUint32((*uint32)(p), *(*uint32)(unsafe.Pointer(&val)))
+	case atomicNative64:
+		o.StoreUint64((*uint64)(p), *(*uint64)(unsafe.Pointer(&val)))
+	case atomicNative128:
+		w := (*[2]uint64)(unsafe.Pointer(&val))
+		o.store128((*[16]byte)(p), w[0], w[1])
+	default:
+		mu := lockFor(p)
+		mu.Lock()
+		a.v = val
+		ti.clearPadding(p)
+		mu.Unlock()
+	}
+}
+
+func (a *Atomic[T]) swap(o MemoryOrder, new T) (old T) {
+	ti := typeInfoOf[T]()
+	p := a.ptr(ti)
+	ti.clearPadding(unsafe.Pointer(&new))
+	switch ti.kind {
+	case atomicNative32:
+		*(*uint32)(unsafe.Pointer(&old)) = o.SwapUint32((*uint32)(p), *(*uint32)(unsafe.Pointer(&new)))
+	case atomicNative64:
+		*(*uint64)(unsafe.Pointer(&old)) = o.SwapUint64((*uint64)(p), *(*uint64)(unsafe.Pointer(&new)))
+	case atomicNative128:
+		w := (*[2]uint64)(unsafe.Pointer(&new))
+		r := (*[2]uint64)(unsafe.Pointer(&old))
+		r[0], r[1] = o.swap128((*[16]byte)(p), w[0], w[1])
+	default:
+		mu := lockFor(p)
+		mu.Lock()
+		old, a.v = a.v, new
+		ti.clearPadding(p)
+		mu.Unlock()
+	}
+	return old
+}
+
+// cax performs a bitwise compare-exchange and reports whether it swapped.
+func (a *Atomic[T]) cax(o MemoryOrder, old, new T) (prev T, swapped bool) {
+	ti := typeInfoOf[T]()
+	p := a.ptr(ti)
+	ti.clearPadding(unsafe.Pointer(&old))
+	ti.clearPadding(unsafe.Pointer(&new))
+	switch ti.kind {
+	case atomicNative32:
+		want := *(*uint32)(unsafe.Pointer(&old))
+		got := o.CompareExchangeUint32((*uint32)(p), want, *(*uint32)(unsafe.Pointer(&new)))
+		*(*uint32)(unsafe.Pointer(&prev)) = got
+		return prev, got == want
+	case atomicNative64:
+		want := *(*uint64)(unsafe.Pointer(&old))
+		got := o.CompareExchangeUint64((*uint64)(p), want, *(*uint64)(unsafe.Pointer(&new)))
+		*(*uint64)(unsafe.Pointer(&prev)) = got
+		return prev, got == want
+	case atomicNative128:
+		want := (*[2]uint64)(unsafe.Pointer(&old))
+		w := (*[2]uint64)(unsafe.Pointer(&new))
+		r := (*[2]uint64)(unsafe.Pointer(&prev))
+		r[0], r[1] = o.cax128((*[16]byte)(p), want[0], want[1], w[0], w[1])
+		return prev, r[0] == want[0] && r[1] == want[1]
+	default:
+		mu := lockFor(p)
+		mu.Lock()
+		prev = a.v
+		ti.clearPadding(unsafe.Pointer(&prev))
+		if bitsEqual(&prev, &old) {
+			a.v = new
+			ti.clearPadding(p)
+			swapped = true
+		}
+		mu.Unlock()
+		return prev, swapped
+	}
+}
+
+// bitsEqual reports whether *x and *y have identical memory representations.
+func bitsEqual[T any](x, y *T) bool {
+	n := unsafe.Sizeof(*x)
+	if n == 0 {
+		return true
+	}
+	bx := unsafe.Slice((*byte)(unsafe.Pointer(x)), n)
+	by := unsafe.Slice((*byte)(unsafe.Pointer(y)), n)
+	return string(bx) == string(by)
+}
+
+// typeInfo describes the layout of an Atomic[T] value type.
+type typeInfo struct {
+	key  unsafe.Pointer // type descriptor of *T
+	kind int
+	mask []byte // 0xFF for data bytes, 0 for padding; nil if none
+}
+
+// typeCacheBits is log2 of the number of typeCache entries.
+const typeCacheBits = 8
+
+// typeCache maps the type descriptor of *T to its typeInfo, direct-mapped,
+// so that operations find their layout with one load and a compare. Misses
+// and collisions fall back to typeInfos.
+var typeCache [1 << typeCacheBits]atomic.Pointer[typeInfo]
+
+// typeInfos holds every typeInfo computed, by reflect.Type.
+var typeInfos sync.Map
+
+// typeInfoOf returns the layout description of T.
+func typeInfoOf[T any]() *typeInfo {
+	// The type word of an interface holding a *T identifies the
+	// instantiation; converting a nil pointer does not allocate.
+	var x any = (*T)(nil)
+	key := (*[2]unsafe.Pointer)(unsafe.Pointer(&x))[0]
+	if ti := typeCache[uintptr(key)>>4%uintptr(len(typeCache))].Load(); ti != nil && ti.key == key {
+		return ti
+	}
+	return typeInfoMiss(x, key)
+}
+
+// typeInfoMiss fills the typeCache entry for the type x points to.
+func typeInfoMiss(x any, key unsafe.Pointer) *typeInfo {
+	t := reflect.TypeOf(x).Elem()
+	v, ok := typeInfos.Load(t)
+	if !ok {
+		v, _ = typeInfos.LoadOrStore(t, newTypeInfo(t, key))
+	}
+	ti := v.(*typeInfo)
+	typeCache[uintptr(key)>>4%uintptr(len(typeCache))].Store(ti)
+	return ti
+}
+
+// newTypeInfo computes the typeInfo of t.
+func newTypeInfo(t reflect.Type, key unsafe.Pointer) *typeInfo {
+	ti := &typeInfo{key: key, kind: atomicLocked}
+	switch size := t.Size(); {
+	case typeHasPointers(t):
+	case size == 4:
+		ti.kind = atomicNative32
+	case size == 8:
+		ti.kind = atomicNative64
+	case size == 16 && arch.LockFree128:
+		ti.kind = atomicNative128
+	}
+	mask := make([]byte, t.Size())
+	markData(mask, t)
+	for _, m := range mask {
+		if m == 0 {
+			ti.mask = mask
+			break
+		}
+	}
+	return ti
+}
+
+// clearPadding zeroes the padding bytes of the value at p.
+func (ti *typeInfo) clearPadding(p unsafe.Pointer) {
+	if ti.mask == nil {
+		return
+	}
+	b := unsafe.Slice((*byte)(p), len(ti.mask))
+	for i, m := range ti.mask {
+		b[i] &= m
+	}
+}
+
+// markData sets the bytes of mask that hold field data of t.
+func markData(mask []byte, t reflect.Type) {
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := range t.NumField() {
+			f := t.Field(i)
+			markData(mask[f.Offset:f.Offset+f.Type.Size()], f.Type)
+		}
+	case reflect.Array:
+		n := t.Elem().Size()
+		for i := range uintptr(t.Len()) {
+			markData(mask[i*n:(i+1)*n], t.Elem())
+		}
+	default:
+		for i := range mask {
+			mask[i] = 0xFF
+		}
+	}
+}
+
+// typeHasPointers reports whether values of t contain pointers the garbage
+// collector must see. Such values are never updated with raw word stores.
+func typeHasPointers(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Map, reflect.Chan,
+		reflect.Func, reflect.Interface, reflect.Slice, reflect.String:
+		return true
+	case reflect.Array:
+		return t.Len() > 0 && typeHasPointers(t.Elem())
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if typeHasPointers(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lockStripeBits is log2 of the number of mutexes in the lock table.
+const lockStripeBits = 6
+
+// lockTable serializes operations on values that have no native atomic path.
+// Each stripe occupies its own cache line to avoid false sharing.
+var lockTable [1 << lockStripeBits]struct {
+	sync.Mutex
+	_ [CacheLineSize - unsafe.Sizeof(sync.Mutex{})]byte
+}
+
+// lockFor returns the lock table stripe guarding address p.
+func lockFor(p unsafe.Pointer) *sync.Mutex {
+	h := uint64(uintptr(p)>>3) * 0x9E3779B97F4A7C15
+	return &lockTable[h>>(64-lockStripeBits)].Mutex
+}
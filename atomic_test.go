@@ -0,0 +1,250 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+type ringIndex struct {
+	head, tail uint32
+}
+
+type taggedSlot struct {
+	ptr uintptr
+	gen uint64
+}
+
+type bigValue struct {
+	a, b, c uint64
+}
+
+type withPointer struct {
+	p *int
+	n uint32
+}
+
+// =============================================================================
+// Atomic[T] Tests
+// =============================================================================
+
+func TestAtomicSmallStruct(t *testing.T) {
+	a := new(atomix.Atomic[ringIndex])
+	if !a.IsLockFree() {
+		t.Fatal("Atomic[ringIndex] should be lock-free")
+	}
+	if got := a.Load(); got != (ringIndex{}) {
+		t.Fatalf("zero value: got %+v", got)
+	}
+
+	a.Store(ringIndex{1, 2})
+	if got := a.LoadAcquire(); got != (ringIndex{1, 2}) {
+		t.Fatalf("LoadAcquire: got %+v, want {1 2}", got)
+	}
+	a.StoreRelease(ringIndex{3, 4})
+	if got := a.LoadRelaxed(); got != (ringIndex{3, 4}) {
+		t.Fatalf("LoadRelaxed: got %+v, want {3 4}", got)
+	}
+
+	if old := a.Swap(ringIndex{5, 6}); old != (ringIndex{3, 4}) {
+		t.Fatalf("Swap: got %+v, want {3 4}", old)
+	}
+	if a.CompareAndSwap(ringIndex{0, 0}, ringIndex{7, 8}) {
+		t.Fatal("CompareAndSwap should fail on mismatch")
+	}
+	if !a.CompareAndSwap(ringIndex{5, 6}, ringIndex{7, 8}) {
+		t.Fatal("CompareAndSwap should succeed")
+	}
+	if prev := a.CompareExchange(ringIndex{7, 8}, ringIndex{9, 10}); prev != (ringIndex{7, 8}) {
+		t.Fatalf("CompareExchange: got %+v, want {7 8}", prev)
+	}
+	if prev := a.CompareExchange(ringIndex{7, 8}, ringIndex{0, 0}); prev != (ringIndex{9, 10}) {
+		t.Fatalf("CompareExchange failure: got %+v, want {9 10}", prev)
+	}
+}
+
+func TestAtomic32(t *testing.T) {
+	type pair struct{ lo, hi uint16 }
+	a := new(atomix.Atomic[pair])
+	if !a.IsLockFree() {
+		t.Fatal("Atomic[pair] should be lock-free")
+	}
+	a.Store(pair{1, 2})
+	if !a.CompareAndSwapAcqRel(pair{1, 2}, pair{3, 4}) {
+		t.Fatal("CompareAndSwapAcqRel should succeed")
+	}
+	if got := a.SwapRelaxed(pair{5, 6}); got != (pair{3, 4}) {
+		t.Fatalf("SwapRelaxed: got %+v, want {3 4}", got)
+	}
+	if got := a.Load(); got != (pair{5, 6}) {
+		t.Fatalf("Load: got %+v, want {5 6}", got)
+	}
+}
+
+func TestAtomic128(t *testing.T) {
+	a := new(atomix.Atomic[taggedSlot])
+	a.Store(taggedSlot{0x1000, 1})
+	if !a.CompareAndSwapRelease(taggedSlot{0x1000, 1}, taggedSlot{0x2000, 2}) {
+		t.Fatal("CompareAndSwapRelease should succeed")
+	}
+	if a.CompareAndSwapAcquire(taggedSlot{0x1000, 1}, taggedSlot{0x3000, 3}) {
+		t.Fatal("CompareAndSwapAcquire should fail on stale generation")
+	}
+	if got := a.SwapAcquire(taggedSlot{0x3000, 3}); got != (taggedSlot{0x2000, 2}) {
+		t.Fatalf("SwapAcquire: got %+v", got)
+	}
+	if got := a.CompareExchangeRelaxed(taggedSlot{0x3000, 3}, taggedSlot{0x4000, 4}); got != (taggedSlot{0x3000, 3}) {
+		t.Fatalf("CompareExchangeRelaxed: got %+v", got)
+	}
+	if got := a.Load(); got != (taggedSlot{0x4000, 4}) {
+		t.Fatalf("Load: got %+v", got)
+	}
+}
+
+func TestAtomicLocked(t *testing.T) {
+	a := new(atomix.Atomic[bigValue])
+	if a.IsLockFree() {
+		t.Fatal("Atomic[bigValue] should use the lock table")
+	}
+	a.Store(bigValue{1, 2, 3})
+	if got := a.SwapAcqRel(bigValue{4, 5, 6}); got != (bigValue{1, 2, 3}) {
+		t.Fatalf("SwapAcqRel: got %+v", got)
+	}
+	if a.CompareAndSwapRelaxed(bigValue{1, 2, 3}, bigValue{}) {
+		t.Fatal("CompareAndSwapRelaxed should fail on mismatch")
+	}
+	if got := a.CompareExchangeAcquire(bigValue{4, 5, 6}, bigValue{7, 8, 9}); got != (bigValue{4, 5, 6}) {
+		t.Fatalf("CompareExchangeAcquire: got %+v", got)
+	}
+	if got := a.CompareExchangeRelease(bigValue{}, bigValue{}); got != (bigValue{7, 8, 9}) {
+		t.Fatalf("CompareExchangeRelease: got %+v", got)
+	}
+	if got := a.CompareExchangeAcqRel(bigValue{7, 8, 9}, bigValue{}); got != (bigValue{7, 8, 9}) {
+		t.Fatalf("CompareExchangeAcqRel: got %+v", got)
+	}
+	if got := a.Load(); got != (bigValue{}) {
+		t.Fatalf("Load: got %+v", got)
+	}
+}
+
+func TestAtomicPointerTypeIsLocked(t *testing.T) {
+	a := new(atomix.Atomic[withPointer])
+	if a.IsLockFree() {
+		t.Fatal("Atomic[withPointer] must not use raw word stores")
+	}
+	x, y := 1, 2
+	a.Store(withPointer{&x, 1})
+	if !a.CompareAndSwap(withPointer{&x, 1}, withPointer{&y, 2}) {
+		t.Fatal("CompareAndSwap should succeed")
+	}
+	if got := a.Load(); got.p != &y || *got.p != 2 {
+		t.Fatalf("Load: got %+v", got)
+	}
+
+	s := new(atomix.Atomic[string])
+	if s.IsLockFree() {
+		t.Fatal("Atomic[string] must not use raw word stores")
+	}
+	s.Store("hello")
+	if got := s.Swap("world"); got != "hello" {
+		t.Fatalf("Swap: got %q, want %q", got, "hello")
+	}
+}
+
+func TestAtomicConcurrentCAS(t *testing.T) {
+	const goroutines, iterations = 8, 1000
+	testConcurrentCAS(t, new(atomix.Atomic[ringIndex]), func(v ringIndex) ringIndex {
+		return ringIndex{v.head + 1, v.tail + 2}
+	}, func(v ringIndex) bool {
+		return v == ringIndex{goroutines * iterations, 2 * goroutines * iterations}
+	}, goroutines, iterations)
+	testConcurrentCAS(t, new(atomix.Atomic[taggedSlot]), func(v taggedSlot) taggedSlot {
+		return taggedSlot{v.ptr + 8, v.gen + 1}
+	}, func(v taggedSlot) bool {
+		return v == taggedSlot{8 * goroutines * iterations, goroutines * iterations}
+	}, goroutines, iterations)
+	testConcurrentCAS(t, new(atomix.Atomic[bigValue]), func(v bigValue) bigValue {
+		return bigValue{v.a + 1, v.b + 1, v.c + 1}
+	}, func(v bigValue) bool {
+		return v == bigValue{goroutines * iterations, goroutines * iterations, goroutines * iterations}
+	}, goroutines, iterations)
+}
+
+func testConcurrentCAS[T comparable](t *testing.T, a *atomix.Atomic[T], next func(T) T, done func(T) bool, goroutines, iterations int) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				for {
+					old := a.Load()
+					if a.CompareAndSwap(old, next(old)) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if got := a.Load(); !done(got) {
+		t.Fatalf("%T: final value %+v", a, got)
+	}
+}
+
+func TestAtomicPaddingIgnored(t *testing.T) {
+	type padded struct {
+		flag uint8
+		n    uint32
+	}
+	a := new(atomix.Atomic[padded])
+	a.Store(padded{1, 2})
+	if !a.CompareAndSwap(padded{1, 2}, padded{3, 4}) {
+		t.Fatal("CompareAndSwap should ignore padding")
+	}
+	if got := a.Load(); got != (padded{3, 4}) {
+		t.Fatalf("Load: got %+v, want {3 4}", got)
+	}
+}
+
+func TestAtomicSelfAligning(t *testing.T) {
+	// slot sits 8 bytes into each element, off the 16-byte grid.
+	type embedded struct {
+		pad  uint64
+		slot atomix.Atomic[taggedSlot]
+		word atomix.Atomic[ringIndex]
+	}
+	s := make([]embedded, 4)
+	for i := range s {
+		if s[i].slot.IsLockFree() != atomix.Uint128IsLockFree() {
+			t.Fatalf("element %d: Atomic[taggedSlot].IsLockFree = %v, Uint128IsLockFree = %v",
+				i, s[i].slot.IsLockFree(), atomix.Uint128IsLockFree())
+		}
+		if !s[i].word.IsLockFree() {
+			t.Fatalf("element %d: Atomic[ringIndex] should be lock-free", i)
+		}
+		s[i].pad = ^uint64(0)
+		s[i].slot.Store(taggedSlot{uintptr(i), uint64(i)})
+		s[i].word.Store(ringIndex{uint32(i), uint32(i)})
+	}
+	for i := range s {
+		if !s[i].slot.CompareAndSwap(taggedSlot{uintptr(i), uint64(i)}, taggedSlot{uintptr(i) + 1, uint64(i) + 1}) {
+			t.Fatalf("element %d: CompareAndSwap failed, value %+v", i, s[i].slot.Load())
+		}
+		if got := s[i].slot.Load(); got != (taggedSlot{uintptr(i) + 1, uint64(i) + 1}) {
+			t.Fatalf("element %d: Load = %+v", i, got)
+		}
+		if got := s[i].word.Swap(ringIndex{}); got != (ringIndex{uint32(i), uint32(i)}) {
+			t.Fatalf("element %d: Swap = %+v", i, got)
+		}
+		if s[i].pad != ^uint64(0) {
+			t.Fatalf("element %d: neighbouring field overwritten: %#x", i, s[i].pad)
+		}
+	}
+}
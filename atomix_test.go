@@ -5,8 +5,12 @@
 package atomix_test
 
 import (
+	"math"
+	"runtime"
+	"slices"
 	"sync"
 	"testing"
+	"unsafe"
 
 	"code.hybscloud.com/atomix"
 )
@@ -472,6 +476,130 @@ func TestPointerCompareAndSwap(t *testing.T) {
 	}
 }
 
+// The publish functions store a pointer to a fresh local. If the pointee were
+// not seen to escape, it would live in the callee's frame and be clobbered
+// once that frame is reused.
+
+//go:noinline
+func publishSwap(a *atomix.Pointer[[4]int], n int) {
+	v := [4]int{n, n, n, n}
+	a.SwapRelaxed(&v)
+}
+
+//go:noinline
+func publishCAS(a *atomix.Pointer[[4]int], n int) {
+	v := [4]int{n, n, n, n}
+	a.CompareAndSwapRelease(a.LoadRelaxed(), &v)
+}
+
+//go:noinline
+func publishCAX(a *atomix.Pointer[[4]int], n int) {
+	v := [4]int{n, n, n, n}
+	a.CompareExchangeAcquire(a.LoadRelaxed(), &v)
+}
+
+//go:noinline
+func publishRaw(p *unsafe.Pointer, n int) {
+	v := [4]int{n, n, n, n}
+	atomix.AcqRel.SwapPointer(p, unsafe.Pointer(&v))
+}
+
+//go:noinline
+func clobberStack(n int) int {
+	var buf [256]int
+	for i := range buf {
+		buf[i] = -n
+	}
+	return buf[n%len(buf)]
+}
+
+func TestPointerGC(t *testing.T) {
+	var a atomix.Pointer[[4]int]
+	var raw unsafe.Pointer
+	publish := []func(int){
+		func(n int) { publishSwap(&a, n) },
+		func(n int) { publishCAS(&a, n) },
+		func(n int) { publishCAX(&a, n) },
+	}
+	for n := range 300 {
+		publish[n%len(publish)](n)
+		publishRaw(&raw, n)
+		clobberStack(n)
+		if n%50 == 0 {
+			runtime.GC()
+		}
+		want := [4]int{n, n, n, n}
+		if p := a.Load(); p == nil || *p != want {
+			t.Fatalf("iteration %d: Pointer pointee corrupted: %v", n, p)
+		}
+		if p := (*[4]int)(raw); *p != want {
+			t.Fatalf("iteration %d: SwapPointer pointee corrupted: %v", n, *p)
+		}
+	}
+}
+
+// TestPointerWriteBarrier moves objects between two heap slot arrays while
+// the collector runs. Each object is reachable only through the slots, and
+// is carried from one to the other on a goroutine stack the collector has
+// already scanned, so it survives only if Swap and CompareAndSwap run the
+// write barrier: the old value must be shaded when it leaves a slot, the new
+// one when it lands in a slot already scanned. The slices are large so that
+// marking them overlaps the moves.
+func TestPointerWriteBarrier(t *testing.T) {
+	const slots = 1 << 16
+	rounds := 100
+	if testing.Short() {
+		rounds = 20
+	}
+	type obj [8]uint64
+	src := make([]atomix.Pointer[obj], slots)
+	dst := make([]atomix.Pointer[obj], slots)
+	for i := range src {
+		src[i].Store(&obj{uint64(i), uint64(i), uint64(i), uint64(i), uint64(i), uint64(i), uint64(i), uint64(i)})
+	}
+
+	var done atomix.Bool
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for !done.Load() {
+			runtime.GC()
+		}
+	}()
+
+	var sink []*obj
+	for r := range rounds {
+		for i := range src {
+			p := src[i].Swap(nil)
+			switch r % 3 {
+			case 0:
+				dst[i].Swap(p)
+			case 1:
+				dst[i].CompareAndSwap(nil, p)
+			default:
+				dst[i].CompareExchange(nil, p)
+			}
+		}
+		src, dst = dst, src
+		// Reuse any memory the collector wrongly freed.
+		sink = sink[:0]
+		for range slots {
+			sink = append(sink, &obj{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)})
+		}
+		for i := range src {
+			if p := src[i].Load(); p == nil || p[0] != uint64(i) || p[7] != uint64(i) {
+				done.Store(true)
+				wg.Wait()
+				t.Fatalf("round %d: slot %d pointee freed or corrupted", r, i)
+			}
+		}
+	}
+	done.Store(true)
+	wg.Wait()
+	runtime.KeepAlive(sink)
+}
+
 // =============================================================================
 // Uint128 Tests (require 16-byte alignment)
 // =============================================================================
@@ -649,6 +777,276 @@ func TestUint128Concurrent(t *testing.T) {
 	}
 }
 
+func TestUint128Bitwise(t *testing.T) {
+	_, a := atomix.PlaceAlignedUint128(make([]byte, 64), 0)
+	type op struct {
+		name string
+		f    func(lo, hi uint64) (uint64, uint64)
+		ref  func(x, y uint64) uint64
+	}
+	ops := []op{
+		{"And", a.And, func(x, y uint64) uint64 { return x & y }},
+		{"AndRelaxed", a.AndRelaxed, func(x, y uint64) uint64 { return x & y }},
+		{"AndAcquire", a.AndAcquire, func(x, y uint64) uint64 { return x & y }},
+		{"AndRelease", a.AndRelease, func(x, y uint64) uint64 { return x & y }},
+		{"AndAcqRel", a.AndAcqRel, func(x, y uint64) uint64 { return x & y }},
+		{"AndNot", a.AndNot, func(x, y uint64) uint64 { return x &^ y }},
+		{"AndNotRelaxed", a.AndNotRelaxed, func(x, y uint64) uint64 { return x &^ y }},
+		{"AndNotAcquire", a.AndNotAcquire, func(x, y uint64) uint64 { return x &^ y }},
+		{"AndNotRelease", a.AndNotRelease, func(x, y uint64) uint64 { return x &^ y }},
+		{"AndNotAcqRel", a.AndNotAcqRel, func(x, y uint64) uint64 { return x &^ y }},
+		{"Or", a.Or, func(x, y uint64) uint64 { return x | y }},
+		{"OrRelaxed", a.OrRelaxed, func(x, y uint64) uint64 { return x | y }},
+		{"OrAcquire", a.OrAcquire, func(x, y uint64) uint64 { return x | y }},
+		{"OrRelease", a.OrRelease, func(x, y uint64) uint64 { return x | y }},
+		{"OrAcqRel", a.OrAcqRel, func(x, y uint64) uint64 { return x | y }},
+		{"Xor", a.Xor, func(x, y uint64) uint64 { return x ^ y }},
+		{"XorRelaxed", a.XorRelaxed, func(x, y uint64) uint64 { return x ^ y }},
+		{"XorAcquire", a.XorAcquire, func(x, y uint64) uint64 { return x ^ y }},
+		{"XorRelease", a.XorRelease, func(x, y uint64) uint64 { return x ^ y }},
+		{"XorAcqRel", a.XorAcqRel, func(x, y uint64) uint64 { return x ^ y }},
+	}
+	const initLo, initHi uint64 = 0xF0F0_F0F0_0000_FFFF, 0x0123_4567_89AB_CDEF
+	const argLo, argHi uint64 = 0xFF00_FF00_FF00_FF00, 0xFFFF_0000_FFFF_0000
+	for _, o := range ops {
+		a.Store(initLo, initHi)
+		oldLo, oldHi := o.f(argLo, argHi)
+		if oldLo != initLo || oldHi != initHi {
+			t.Fatalf("%s: old = (%#x, %#x), want (%#x, %#x)", o.name, oldLo, oldHi, initLo, initHi)
+		}
+		lo, hi := a.Load()
+		if lo != o.ref(initLo, argLo) || hi != o.ref(initHi, argHi) {
+			t.Fatalf("%s: got (%#x, %#x), want (%#x, %#x)", o.name, lo, hi, o.ref(initLo, argLo), o.ref(initHi, argHi))
+		}
+	}
+}
+
+func TestUint128MaxMin(t *testing.T) {
+	_, a := atomix.PlaceAlignedUint128(make([]byte, 64), 0)
+	maxes := []func(lo, hi uint64) (uint64, uint64){a.Max, a.MaxRelaxed, a.MaxAcquire, a.MaxRelease, a.MaxAcqRel}
+	mins := []func(lo, hi uint64) (uint64, uint64){a.Min, a.MinRelaxed, a.MinAcquire, a.MinRelease, a.MinAcqRel}
+	for i := range maxes {
+		a.Store(5, 1)
+		if lo, hi := maxes[i](math.MaxUint64, 0); lo != 5 || hi != 1 {
+			t.Fatalf("Max[%d] no-op: old = (%d, %d)", i, lo, hi)
+		}
+		if !a.Equal(5, 1) {
+			t.Fatalf("Max[%d]: (MaxUint64, 0) must not replace (5, 1)", i)
+		}
+		maxes[i](0, 2)
+		if !a.Equal(0, 2) {
+			t.Fatalf("Max[%d]: (0, 2) should replace (5, 1)", i)
+		}
+		if lo, hi := mins[i](7, 1); lo != 0 || hi != 2 {
+			t.Fatalf("Min[%d]: old = (%d, %d)", i, lo, hi)
+		}
+		mins[i](8, 1)
+		if !a.Equal(7, 1) {
+			t.Fatalf("Min[%d]: want (7, 1)", i)
+		}
+	}
+}
+
+func TestInt128MaxMinSigned(t *testing.T) {
+	_, a := atomix.PlaceAlignedInt128(make([]byte, 64), 0)
+	maxes := []func(lo, hi int64) (int64, int64){a.Max, a.MaxRelaxed, a.MaxAcquire, a.MaxRelease, a.MaxAcqRel}
+	mins := []func(lo, hi int64) (int64, int64){a.Min, a.MinRelaxed, a.MinAcquire, a.MinRelease, a.MinAcqRel}
+	for i := range maxes {
+		a.Store(-1, -1) // -1
+		maxes[i](-5, -1)
+		if !a.Equal(-1, -1) {
+			t.Fatalf("Max[%d]: -5 must not replace -1", i)
+		}
+		maxes[i](0, 0)
+		if !a.Equal(0, 0) {
+			t.Fatalf("Max[%d]: 0 should replace -1", i)
+		}
+		mins[i](-1, 0) // 2^64 - 1 as (lo, hi) with unsigned low word
+		if !a.Equal(0, 0) {
+			t.Fatalf("Min[%d]: 2^64-1 must not replace 0", i)
+		}
+		mins[i](0, math.MinInt64)
+		if !a.Equal(0, math.MinInt64) {
+			t.Fatalf("Min[%d]: min Int128 should replace 0", i)
+		}
+	}
+	a.Store(3, 0)
+	if lo, hi := a.Xor(1, -1); lo != 3 || hi != 0 || !a.Equal(2, -1) {
+		t.Fatalf("Int128 Xor: old = (%d, %d)", lo, hi)
+	}
+	if lo, hi := a.AndNot(2, 0); lo != 2 || hi != -1 || !a.Equal(0, -1) {
+		t.Fatalf("Int128 AndNot: old = (%d, %d)", lo, hi)
+	}
+}
+
+func TestMemoryOrder128Bitwise(t *testing.T) {
+	buf := make([]byte, 96)
+	n, u := atomix.PlaceAlignedUint128(buf, 0)
+	_, i := atomix.PlaceAlignedInt128(buf, n)
+	for _, o := range []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel} {
+		u.Store(0b1100, 1)
+		o.AndUint128(u, 0b1010, 1)
+		o.OrUint128(u, 0b0001, 2)
+		o.XorUint128(u, 0b1111, 0)
+		o.AndNotUint128(u, 0b0100, 0)
+		if !u.Equal(0b0010, 3) {
+			lo, hi := u.Load()
+			t.Fatalf("%v: Uint128 bitwise got (%#b, %d)", o, lo, hi)
+		}
+		o.MaxUint128(u, 0, 4)
+		o.MinUint128(u, 9, 3)
+		if !u.Equal(9, 3) {
+			t.Fatalf("%v: Uint128 Max/Min wrong", o)
+		}
+
+		i.Store(0, 0)
+		o.MinInt128(i, -1, -1)
+		o.MaxInt128(i, -2, -1)
+		if !i.Equal(-1, -1) {
+			t.Fatalf("%v: Int128 Max/Min wrong", o)
+		}
+		o.AndInt128(i, 0xFF, 0)
+		o.OrInt128(i, 0, 1)
+		o.XorInt128(i, 1, 0)
+		o.AndNotInt128(i, 2, 0)
+		if !i.Equal(0xFC, 1) {
+			t.Fatalf("%v: Int128 bitwise wrong", o)
+		}
+	}
+}
+
+func TestUint128ConcurrentOrMax(t *testing.T) {
+	buf := make([]byte, 96)
+	n, flags := atomix.PlaceAlignedUint128(buf, 0)
+	_, hw := atomix.PlaceAlignedUint128(buf, n)
+	const goroutines = 16
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				flags.Or(1<<(g*4%64), 1<<((g*4+1)%64))
+				hw.Max(uint64(j), uint64(g))
+			}
+		}()
+	}
+	wg.Wait()
+	var wantLo, wantHi uint64
+	for g := range goroutines {
+		wantLo |= 1 << (g * 4 % 64)
+		wantHi |= 1 << ((g*4 + 1) % 64)
+	}
+	if !flags.Equal(wantLo, wantHi) {
+		lo, hi := flags.Load()
+		t.Fatalf("concurrent Or: got (%#x, %#x), want (%#x, %#x)", lo, hi, wantLo, wantHi)
+	}
+	if !hw.Equal(99, goroutines-1) {
+		lo, hi := hw.Load()
+		t.Fatalf("concurrent Max: got (%d, %d)", lo, hi)
+	}
+}
+
+func TestUint128Variant(t *testing.T) {
+	v, lockFree := atomix.Uint128Variant(), atomix.Uint128IsLockFree()
+	t.Logf("Uint128Variant=%s Uint128IsLockFree=%v", v, lockFree)
+	want := []string{"lock table"}
+	switch {
+	case raceEnabled:
+	case runtime.GOARCH == "amd64":
+		want = []string{"CMPXCHG16B"}
+	case runtime.GOARCH == "arm64":
+		want = []string{"CASP", "LDXP/STXP"}
+	}
+	if !slices.Contains(want, v) {
+		t.Fatalf("Uint128Variant on %s: got %q, want one of %q", runtime.GOARCH, v, want)
+	}
+	if lockFree != (v != "lock table") {
+		t.Fatalf("Uint128IsLockFree = %v with variant %q", lockFree, v)
+	}
+	if atomix.Uint128Variant() != v {
+		t.Fatal("Uint128Variant changed")
+	}
+}
+
+// =============================================================================
+// Self-aligning 128-bit Tests
+// =============================================================================
+
+// Declared 128-bit values must work at any offset the compiler picks,
+// including ones off 16-byte alignment, where CMPXCHG16B or LDXP would fault.
+func TestUint128SelfAligning(t *testing.T) {
+	var s struct {
+		a  byte
+		u  atomix.Uint128
+		b  [3]byte
+		i  atomix.Int128
+		c  byte
+		up atomix.Uint128Padded
+		d  [5]byte
+		ip atomix.Int128Padded
+	}
+	var misaligned bool
+	for _, off := range []uintptr{unsafe.Offsetof(s.u), unsafe.Offsetof(s.i), unsafe.Offsetof(s.up), unsafe.Offsetof(s.ip)} {
+		misaligned = misaligned || (uintptr(unsafe.Pointer(&s))+off)&15 != 0
+	}
+	if !misaligned {
+		t.Fatal("every field is 16-byte aligned; the test no longer covers misaligned bases")
+	}
+
+	us := []*atomix.Uint128{&s.u, &s.up.Uint128}
+	is := []*atomix.Int128{&s.i, &s.ip.Int128}
+	for n, u := range us {
+		u.Store(^uint64(0), 1)
+		if lo, hi := u.Inc(); lo != 0 || hi != 2 {
+			t.Fatalf("Uint128 #%d Inc: (%d, %d)", n, lo, hi)
+		}
+		if !u.CompareAndSwap(0, 2, 7, 8) {
+			t.Fatalf("Uint128 #%d CompareAndSwap failed", n)
+		}
+	}
+	for n, i := range is {
+		i.Store(0, 0)
+		if lo, hi := i.Dec(); lo != -1 || hi != -1 {
+			t.Fatalf("Int128 #%d Dec: (%d, %d)", n, lo, hi)
+		}
+	}
+	if s.a != 0 || s.b != [3]byte{} || s.c != 0 || s.d != [5]byte{} {
+		t.Fatal("128-bit operation wrote outside its field")
+	}
+}
+
+func TestUint128SliceElements(t *testing.T) {
+	const goroutines, iters = 4, 500
+	vals := make([]atomix.Uint128, 5)
+	ints := make([]atomix.Int128, 5)
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iters {
+				for k := range vals {
+					vals[k].AddAcqRel(^uint64(0), 0)
+					ints[k].SubRelaxed(1, 0)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	// n additions of 2^64-1 total n*2^64 - n.
+	n := uint64(goroutines * iters)
+	want, wantHi := -n, n-1
+	for k := range vals {
+		if lo, hi := vals[k].Load(); lo != want || hi != wantHi {
+			t.Errorf("vals[%d] = (%#x, %d), want (%#x, %d)", k, lo, hi, want, wantHi)
+		}
+		if lo, hi := ints[k].Load(); lo != -goroutines*iters || hi != -1 {
+			t.Errorf("ints[%d] = (%d, %d)", k, lo, hi)
+		}
+	}
+}
+
 // =============================================================================
 // Barrier Tests
 // =============================================================================
@@ -658,6 +1056,13 @@ func TestBarriers(t *testing.T) {
 	atomix.BarrierAcquire()
 	atomix.BarrierRelease()
 	atomix.BarrierAcqRel()
+	atomix.BarrierSeqCst()
+	atomix.BarrierLoadLoad()
+	atomix.BarrierLoadStore()
+	atomix.BarrierStoreStore()
+	atomix.BarrierStoreLoad()
+	atomix.BarrierNonTemporal()
+	atomix.BarrierCompiler()
 }
 
 // =============================================================================
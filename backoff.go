@@ -0,0 +1,32 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"runtime"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// backoffSpinRounds is the number of exponentially growing busy-wait rounds
+// before a retrying CAS loop starts yielding the processor.
+const backoffSpinRounds = 6
+
+// backoff paces CAS retry loops under contention. The zero value is ready
+// to use; wait is called after each failed attempt.
+type backoff struct {
+	n uint8
+}
+
+// wait spins for 2^n rounds of the processor's spin-wait hint on the first
+// rounds, then yields.
+func (b *backoff) wait() {
+	if b.n < backoffSpinRounds {
+		arch.SpinWait(1 << b.n)
+		b.n++
+		return
+	}
+	runtime.Gosched()
+}
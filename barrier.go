@@ -29,3 +29,70 @@ func BarrierRelease() {
 func BarrierAcqRel() {
 	arch.BarrierAcqRel()
 }
+
+// BarrierSeqCst issues a sequentially consistent fence.
+// Unlike the acquire and release barriers, it also orders prior stores
+// before subsequent loads (MFENCE on amd64, DMB ISH on arm64, FENCE on
+// riscv64, DBAR on loong64).
+//
+//go:nosplit
+func BarrierSeqCst() {
+	arch.BarrierSeqCst()
+}
+
+// BarrierLoadLoad orders prior loads before subsequent loads.
+// Compiler-only on amd64 (TSO), DMB ISHLD on arm64, FENCE r,r on riscv64,
+// DBAR 0x15 on loong64.
+//
+//go:nosplit
+func BarrierLoadLoad() {
+	arch.BarrierLoadLoad()
+}
+
+// BarrierLoadStore orders prior loads before subsequent stores.
+// Compiler-only on amd64 (TSO), DMB ISHLD on arm64, FENCE r,w on riscv64,
+// DBAR 0x16 on loong64.
+//
+//go:nosplit
+func BarrierLoadStore() {
+	arch.BarrierLoadStore()
+}
+
+// BarrierStoreStore orders prior stores before subsequent stores, e.g. ring
+// entries before the tail index that publishes them.
+// Compiler-only on amd64 (TSO), DMB ISHST on arm64, FENCE w,w on riscv64,
+// DBAR 0x1A on loong64. Use BarrierNonTemporal after non-temporal stores.
+//
+//go:nosplit
+func BarrierStoreStore() {
+	arch.BarrierStoreStore()
+}
+
+// BarrierStoreLoad orders prior stores before subsequent loads.
+// This is the only reordering TSO permits, so it is as costly as
+// BarrierSeqCst on most targets: MFENCE on amd64, DMB ISH on arm64,
+// FENCE w,r on riscv64, DBAR 0x19 on loong64.
+//
+//go:nosplit
+func BarrierStoreLoad() {
+	arch.BarrierStoreLoad()
+}
+
+// BarrierNonTemporal orders prior stores, including non-temporal and
+// write-combining ones, before subsequent stores.
+// SFENCE on amd64, DMB ISHST on arm64, FENCE w,w on riscv64,
+// DBAR 0x1A on loong64.
+//
+//go:nosplit
+func BarrierNonTemporal() {
+	arch.BarrierNonTemporal()
+}
+
+// BarrierCompiler prevents the compiler from reordering memory operations
+// across the call but emits no fence instruction, like a C signal fence.
+// It orders nothing between CPUs.
+//
+//go:nosplit
+func BarrierCompiler() {
+	arch.BarrierCompiler()
+}
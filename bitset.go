@@ -0,0 +1,163 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// Bitset is a fixed-size set of bits supporting atomic per-bit operations.
+//
+// Bits are stored in 64-bit words. Each operation on a single bit is atomic;
+// Count and Snapshot read words one at a time and are not atomic across
+// words. A striped Bitset gives every word its own cache line, so that
+// goroutines claiming bits in different words do not contend.
+//
+// Bitset is safe for concurrent use. Must not be copied after first use.
+type Bitset struct {
+	_      noCopy
+	words  []Uint64 // word k is words[k*stride]
+	stride int
+	n      int
+}
+
+// NewBitset returns a heap-allocated Bitset of n bits, all clear.
+func NewBitset(n int) *Bitset {
+	return newBitset(n, 1)
+}
+
+// NewBitsetStriped returns a heap-allocated Bitset of n bits, all clear,
+// with each 64-bit word on its own cache line.
+func NewBitsetStriped(n int) *Bitset {
+	return newBitset(n, CacheLineSize/8)
+}
+
+func newBitset(n, stride int) *Bitset {
+	if n < 0 {
+		panic("atomix: negative Bitset size")
+	}
+	buf := make([]byte, (n+63)/64*stride*8+stride*8)
+	return NewAllocator(buf).bitset(n, stride)
+}
+
+func (a *Allocator) bitset(n, stride int) *Bitset {
+	if n < 0 {
+		panic("atomix: negative Bitset size")
+	}
+	b := &Bitset{stride: stride, n: n}
+	nw := (n + 63) / 64
+	if nw == 0 {
+		return b
+	}
+	a.Align(stride * 8)
+	size := nw * stride * 8
+	if a.off+size > len(a.buf) {
+		panic("atomix: insufficient space for Bitset")
+	}
+	b.words = unsafe.Slice((*Uint64)(unsafe.Pointer(&a.buf[a.off])), nw*stride)
+	a.off += size
+	for k := range nw {
+		b.words[k*stride].StoreRelaxed(0)
+	}
+	return b
+}
+
+// Len returns the number of bits in b.
+func (b *Bitset) Len() int {
+	return b.n
+}
+
+// word returns the word holding bit i and the mask selecting it.
+func (b *Bitset) word(i int) (*uint64, uint64) {
+	if uint(i) >= uint(b.n) {
+		panic("atomix: Bitset index out of range")
+	}
+	return &b.words[(i>>6)*b.stride].v, 1 << (uint(i) & 63)
+}
+
+// Set atomically sets bit i and reports whether it was already set.
+// Unknown orderings fallback to AcqRel.
+func (b *Bitset) Set(i int, order MemoryOrder) (wasSet bool) {
+	w, _ := b.word(i)
+	return order.BitSetUint64(w, uint(i))
+}
+
+// Clear atomically clears bit i and reports whether it was set.
+// Unknown orderings fallback to AcqRel.
+func (b *Bitset) Clear(i int, order MemoryOrder) (wasSet bool) {
+	w, _ := b.word(i)
+	return order.BitClearUint64(w, uint(i))
+}
+
+// Toggle atomically flips bit i and reports whether it was set.
+// Unknown orderings fallback to AcqRel.
+func (b *Bitset) Toggle(i int, order MemoryOrder) (wasSet bool) {
+	w, _ := b.word(i)
+	return order.BitToggleUint64(w, uint(i))
+}
+
+// Test atomically loads bit i.
+// Unknown orderings fallback to Acquire.
+func (b *Bitset) Test(i int, order MemoryOrder) bool {
+	w, m := b.word(i)
+	return order.LoadUint64(w)&m != 0
+}
+
+// ClaimFirstZero atomically sets the lowest clear bit and returns its index.
+// Returns (-1, false) if every bit is set. Unknown orderings fallback to AcqRel.
+//
+// Claiming uses Or rather than CompareExchange, so concurrent changes to
+// other bits in the same word never cause a retry.
+func (b *Bitset) ClaimFirstZero(order MemoryOrder) (int, bool) {
+	nw := (b.n + 63) / 64
+	for k := range nw {
+		w := &b.words[k*b.stride].v
+		valid := ^uint64(0)
+		if k == nw-1 && b.n&63 != 0 {
+			valid = 1<<(uint(b.n)&63) - 1
+		}
+		cur := Relaxed.LoadUint64(w)
+		for free := ^cur & valid; free != 0; free = ^cur & valid {
+			m := free & -free
+			cur = order.OrUint64(w, m)
+			if cur&m == 0 {
+				return k*64 + bits.TrailingZeros64(m), true
+			}
+		}
+	}
+	return -1, false
+}
+
+// ReleaseBit atomically clears bit i, which must be set, typically after a
+// ClaimFirstZero. Panics if the bit was already clear, which indicates a
+// double release. Unknown orderings fallback to AcqRel.
+func (b *Bitset) ReleaseBit(i int, order MemoryOrder) {
+	if !b.Clear(i, order) {
+		panic("atomix: Bitset.ReleaseBit of clear bit")
+	}
+}
+
+// Count returns the number of set bits. Words are loaded one at a time with
+// relaxed ordering, so the result is not a consistent snapshot under
+// concurrent modification.
+func (b *Bitset) Count() int {
+	c := 0
+	for k := range (b.n + 63) / 64 {
+		c += bits.OnesCount64(b.words[k*b.stride].LoadRelaxed())
+	}
+	return c
+}
+
+// Snapshot appends the words of b to dst[:0] and returns the result. Bit i
+// is bit i%64 of word i/64. Words are loaded one at a time with relaxed
+// ordering, so the result is not atomic across words.
+func (b *Bitset) Snapshot(dst []uint64) []uint64 {
+	dst = dst[:0]
+	for k := range (b.n + 63) / 64 {
+		dst = append(dst, b.words[k*b.stride].LoadRelaxed())
+	}
+	return dst
+}
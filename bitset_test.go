@@ -0,0 +1,162 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Bitset Tests
+// =============================================================================
+
+func TestBitsetBasic(t *testing.T) {
+	for _, b := range []*atomix.Bitset{atomix.NewBitset(130), atomix.NewBitsetStriped(130)} {
+		if b.Len() != 130 || b.Count() != 0 {
+			t.Fatalf("new Bitset: Len=%d Count=%d", b.Len(), b.Count())
+		}
+		if b.Set(0, atomix.Relaxed) || b.Set(64, atomix.Release) || b.Set(129, atomix.AcqRel) {
+			t.Fatal("Set: bit reported as already set")
+		}
+		if !b.Set(64, atomix.Acquire) {
+			t.Fatal("Set: bit 64 should already be set")
+		}
+		if !b.Test(129, atomix.Acquire) || b.Test(128, atomix.Relaxed) {
+			t.Fatal("Test: unexpected result")
+		}
+		if !b.Toggle(0, atomix.AcqRel) || b.Toggle(1, atomix.Relaxed) {
+			t.Fatal("Toggle: unexpected previous value")
+		}
+		if !b.Clear(1, atomix.Release) || b.Clear(1, atomix.Release) {
+			t.Fatal("Clear: unexpected previous value")
+		}
+		if b.Count() != 2 {
+			t.Fatalf("Count: got %d, want 2", b.Count())
+		}
+		snap := b.Snapshot(nil)
+		if len(snap) != 3 || snap[0] != 0 || snap[1] != 1 || snap[2] != 1<<1 {
+			t.Fatalf("Snapshot: got %#x", snap)
+		}
+	}
+}
+
+func TestBitsetClaimRelease(t *testing.T) {
+	b := atomix.NewBitset(70)
+	for want := range 70 {
+		i, ok := b.ClaimFirstZero(atomix.Acquire)
+		if !ok || i != want {
+			t.Fatalf("ClaimFirstZero: got (%d, %v), want (%d, true)", i, ok, want)
+		}
+	}
+	if i, ok := b.ClaimFirstZero(atomix.AcqRel); ok || i != -1 {
+		t.Fatalf("ClaimFirstZero on full set: got (%d, %v)", i, ok)
+	}
+	b.ReleaseBit(66, atomix.Release)
+	if i, ok := b.ClaimFirstZero(atomix.Relaxed); !ok || i != 66 {
+		t.Fatalf("ClaimFirstZero after release: got (%d, %v), want (66, true)", i, ok)
+	}
+	if b.Count() != 70 {
+		t.Fatalf("Count: got %d, want 70", b.Count())
+	}
+}
+
+func TestBitsetPanics(t *testing.T) {
+	b := atomix.NewBitset(10)
+	for name, f := range map[string]func(){
+		"index":          func() { b.Set(10, atomix.Relaxed) },
+		"negative index": func() { b.Test(-1, atomix.Relaxed) },
+		"double release": func() { b.ReleaseBit(3, atomix.Release) },
+		"negative size":  func() { atomix.NewBitset(-1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+	empty := atomix.NewBitset(0)
+	if _, ok := empty.ClaimFirstZero(atomix.AcqRel); ok || empty.Count() != 0 || len(empty.Snapshot(nil)) != 0 {
+		t.Fatal("empty Bitset: unexpected result")
+	}
+}
+
+func TestBitsetAllocator(t *testing.T) {
+	buf := make([]byte, 1024)
+	for i := range buf {
+		buf[i] = 0xFF
+	}
+	alloc := atomix.NewAllocator(buf)
+	alloc.Skip(1)
+	dense := alloc.Bitset(100)
+	striped := alloc.BitsetStriped(100)
+	if dense.Count() != 0 || striped.Count() != 0 {
+		t.Fatal("Allocator Bitset: bits not cleared")
+	}
+	dense.Set(99, atomix.Relaxed)
+	striped.Set(99, atomix.Relaxed)
+	if dense.Count() != 1 || striped.Count() != 1 {
+		t.Fatal("Allocator Bitset: bits overlap")
+	}
+	if used := alloc.Offset(); used > 1+7+16+atomix.CacheLineSize-1+2*atomix.CacheLineSize {
+		t.Fatalf("Allocator Bitset: used %d bytes", used)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on insufficient space")
+		}
+	}()
+	atomix.NewAllocator(make([]byte, 16)).Bitset(1000)
+}
+
+func TestBitsetSnapshotReuse(t *testing.T) {
+	b := atomix.NewBitsetStriped(256)
+	b.Set(255, atomix.Relaxed)
+	dst := make([]uint64, 1, 4)
+	snap := b.Snapshot(dst)
+	if len(snap) != 4 || &snap[0] != &dst[0] || snap[3] != 1<<63 {
+		t.Fatalf("Snapshot: got %#x", snap)
+	}
+}
+
+func TestBitsetConcurrentClaim(t *testing.T) {
+	const goroutines, slots = 8, 500
+	for _, b := range []*atomix.Bitset{atomix.NewBitset(slots), atomix.NewBitsetStriped(slots)} {
+		claimed := make([][]int, goroutines)
+		var wg sync.WaitGroup
+		for g := range goroutines {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					i, ok := b.ClaimFirstZero(atomix.AcqRel)
+					if !ok {
+						return
+					}
+					claimed[g] = append(claimed[g], i)
+				}
+			}()
+		}
+		wg.Wait()
+		seen := make(map[int]bool)
+		for _, c := range claimed {
+			for _, i := range c {
+				if seen[i] {
+					t.Fatalf("slot %d claimed twice", i)
+				}
+				seen[i] = true
+			}
+		}
+		if len(seen) != slots || b.Count() != slots {
+			t.Fatalf("claimed %d slots, Count=%d, want %d", len(seen), b.Count(), slots)
+		}
+	}
+}
@@ -119,6 +119,151 @@ func (a *Bool) CompareAndSwapAcqRel(old, new bool) bool {
 	return arch.CasUint32AcqRel(&a.v, b2u(old), b2u(new))
 }
 
+// Toggle atomically negates the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Bool) Toggle() bool {
+	return arch.BitToggleUint32AcqRel(&a.v, 0)
+}
+
+// ToggleRelaxed atomically negates the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Bool) ToggleRelaxed() bool {
+	return arch.BitToggleUint32Relaxed(&a.v, 0)
+}
+
+// ToggleAcquire atomically negates the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Bool) ToggleAcquire() bool {
+	return arch.BitToggleUint32Acquire(&a.v, 0)
+}
+
+// ToggleRelease atomically negates the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Bool) ToggleRelease() bool {
+	return arch.BitToggleUint32Release(&a.v, 0)
+}
+
+// ToggleAcqRel atomically negates the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Bool) ToggleAcqRel() bool {
+	return arch.BitToggleUint32AcqRel(&a.v, 0)
+}
+
+// And atomically performs a logical AND with val and returns the old value
+// with acquire-release ordering.
+//
+//go:nosplit
+func (a *Bool) And(val bool) bool {
+	if val {
+		return arch.AddUint32AcqRel(&a.v, 0) != 0
+	}
+	return arch.BitClearUint32AcqRel(&a.v, 0)
+}
+
+// AndRelaxed atomically performs a logical AND with val and returns the old value
+// with relaxed ordering.
+//
+//go:nosplit
+func (a *Bool) AndRelaxed(val bool) bool {
+	if val {
+		return arch.AddUint32Relaxed(&a.v, 0) != 0
+	}
+	return arch.BitClearUint32Relaxed(&a.v, 0)
+}
+
+// AndAcquire atomically performs a logical AND with val and returns the old value
+// with acquire ordering.
+//
+//go:nosplit
+func (a *Bool) AndAcquire(val bool) bool {
+	if val {
+		return arch.AddUint32Acquire(&a.v, 0) != 0
+	}
+	return arch.BitClearUint32Acquire(&a.v, 0)
+}
+
+// AndRelease atomically performs a logical AND with val and returns the old value
+// with release ordering.
+//
+//go:nosplit
+func (a *Bool) AndRelease(val bool) bool {
+	if val {
+		return arch.AddUint32Release(&a.v, 0) != 0
+	}
+	return arch.BitClearUint32Release(&a.v, 0)
+}
+
+// AndAcqRel atomically performs a logical AND with val and returns the old value
+// with acquire-release ordering.
+//
+//go:nosplit
+func (a *Bool) AndAcqRel(val bool) bool {
+	if val {
+		return arch.AddUint32AcqRel(&a.v, 0) != 0
+	}
+	return arch.BitClearUint32AcqRel(&a.v, 0)
+}
+
+// Or atomically performs a logical OR with val and returns the old value
+// with acquire-release ordering.
+//
+//go:nosplit
+func (a *Bool) Or(val bool) bool {
+	if val {
+		return arch.BitSetUint32AcqRel(&a.v, 0)
+	}
+	return arch.AddUint32AcqRel(&a.v, 0) != 0
+}
+
+// OrRelaxed atomically performs a logical OR with val and returns the old value
+// with relaxed ordering.
+//
+//go:nosplit
+func (a *Bool) OrRelaxed(val bool) bool {
+	if val {
+		return arch.BitSetUint32Relaxed(&a.v, 0)
+	}
+	return arch.AddUint32Relaxed(&a.v, 0) != 0
+}
+
+// OrAcquire atomically performs a logical OR with val and returns the old value
+// with acquire ordering.
+//
+//go:nosplit
+func (a *Bool) OrAcquire(val bool) bool {
+	if val {
+		return arch.BitSetUint32Acquire(&a.v, 0)
+	}
+	return arch.AddUint32Acquire(&a.v, 0) != 0
+}
+
+// OrRelease atomically performs a logical OR with val and returns the old value
+// with release ordering.
+//
+//go:nosplit
+func (a *Bool) OrRelease(val bool) bool {
+	if val {
+		return arch.BitSetUint32Release(&a.v, 0)
+	}
+	return arch.AddUint32Release(&a.v, 0) != 0
+}
+
+// OrAcqRel atomically performs a logical OR with val and returns the old value
+// with acquire-release ordering.
+//
+//go:nosplit
+func (a *Bool) OrAcqRel(val bool) bool {
+	if val {
+		return arch.BitSetUint32AcqRel(&a.v, 0)
+	}
+	return arch.AddUint32AcqRel(&a.v, 0) != 0
+}
+
 // b2u converts a bool to uint32.
 //
 //go:nosplit
@@ -128,3 +273,57 @@ func b2u(b bool) uint32 {
 	}
 	return 0
 }
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Bool) Update(f func(old bool) bool) (old, new bool) {
+	return AcqRel.UpdateBool(&a.v, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Bool) UpdateRelaxed(f func(old bool) bool) (old, new bool) {
+	return Relaxed.UpdateBool(&a.v, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Bool) UpdateAcquire(f func(old bool) bool) (old, new bool) {
+	return Acquire.UpdateBool(&a.v, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Bool) UpdateRelease(f func(old bool) bool) (old, new bool) {
+	return Release.UpdateBool(&a.v, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Bool) UpdateAcqRel(f func(old bool) bool) (old, new bool) {
+	return AcqRel.UpdateBool(&a.v, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Bool) TryUpdate(f func(old bool) (bool, bool)) (old, new bool, updated bool) {
+	return AcqRel.TryUpdateBool(&a.v, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Bool) TryUpdateRelaxed(f func(old bool) (bool, bool)) (old, new bool, updated bool) {
+	return Relaxed.TryUpdateBool(&a.v, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Bool) TryUpdateAcquire(f func(old bool) (bool, bool)) (old, new bool, updated bool) {
+	return Acquire.TryUpdateBool(&a.v, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Bool) TryUpdateRelease(f func(old bool) (bool, bool)) (old, new bool, updated bool) {
+	return Release.TryUpdateBool(&a.v, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Bool) TryUpdateAcqRel(f func(old bool) (bool, bool)) (old, new bool, updated bool) {
+	return AcqRel.TryUpdateBool(&a.v, f)
+}
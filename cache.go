@@ -8,6 +8,30 @@ import "unsafe"
 
 // CacheLineSize is defined in cache_*.go per architecture.
 
+// Int8Padded is an Int8 padded to cache line size.
+type Int8Padded struct {
+	Int8
+	_ [CacheLineSize - 1]byte
+}
+
+// Uint8Padded is a Uint8 padded to cache line size.
+type Uint8Padded struct {
+	Uint8
+	_ [CacheLineSize - 1]byte
+}
+
+// Int16Padded is an Int16 padded to cache line size.
+type Int16Padded struct {
+	Int16
+	_ [CacheLineSize - 2]byte
+}
+
+// Uint16Padded is a Uint16 padded to cache line size.
+type Uint16Padded struct {
+	Uint16
+	_ [CacheLineSize - 2]byte
+}
+
 // Int32Padded is an Int32 padded to cache line size.
 type Int32Padded struct {
 	Int32
@@ -32,6 +56,30 @@ type Uint64Padded struct {
 	_ [CacheLineSize - 8]byte
 }
 
+// Float32Padded is a Float32 padded to cache line size.
+type Float32Padded struct {
+	Float32
+	_ [CacheLineSize - 4]byte
+}
+
+// Float64Padded is a Float64 padded to cache line size.
+type Float64Padded struct {
+	Float64
+	_ [CacheLineSize - 8]byte
+}
+
+// DurationPadded is a Duration padded to cache line size.
+type DurationPadded struct {
+	Duration
+	_ [CacheLineSize - 8]byte
+}
+
+// TimePadded is a Time padded to cache line size.
+type TimePadded struct {
+	Time
+	_ [CacheLineSize - 8]byte
+}
+
 // UintptrPadded is a Uintptr padded to cache line size.
 type UintptrPadded struct {
 	Uintptr
@@ -47,11 +95,11 @@ type BoolPadded struct {
 // Int128Padded is an Int128 padded to cache line size.
 type Int128Padded struct {
 	Int128
-	_ [CacheLineSize - 16]byte
+	_ [CacheLineSize - unsafe.Sizeof(Int128{})]byte
 }
 
 // Uint128Padded is a Uint128 padded to cache line size.
 type Uint128Padded struct {
 	Uint128
-	_ [CacheLineSize - 16]byte
+	_ [CacheLineSize - unsafe.Sizeof(Uint128{})]byte
 }
@@ -0,0 +1,191 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"math"
+	"unsafe"
+)
+
+// Conditional arithmetic: the generated AddIfBelow, SubIfAtLeast,
+// IncIfNonZero, DecIfPositive, AddChecked and AddSaturating methods run a
+// CompareExchange loop around these steps. Each step maps the observed value
+// to the value to store and reports whether the operation applies.
+
+// integer is the set of value types with conditional arithmetic.
+type integer interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// addOverflow returns x + d and reports whether it wrapped around.
+// For unsigned T, d < 0 is never true and the check reduces to s < x.
+//
+//go:nosplit
+func addOverflow[T integer](x, d T) (s T, overflow bool) {
+	s = x + d
+	return s, (d > 0 && s < x) || (d < 0 && s > x)
+}
+
+// subOverflow returns x - d and reports whether it wrapped around.
+//
+//go:nosplit
+func subOverflow[T integer](x, d T) (s T, overflow bool) {
+	s = x - d
+	return s, (d > 0 && s > x) || (d < 0 && s < x)
+}
+
+// bounds returns the minimum and maximum values of T.
+//
+//go:nosplit
+func bounds[T integer]() (lo, hi T) {
+	var zero T
+	if ^zero > 0 { // unsigned
+		return 0, ^zero
+	}
+	lo = T(1) << (8*unsafe.Sizeof(zero) - 1)
+	return lo, ^lo
+}
+
+//go:nosplit
+func addBelow[T integer](old, delta, limit T) (T, bool) {
+	s, o := addOverflow(old, delta)
+	return s, !o && s < limit
+}
+
+//go:nosplit
+func subAtLeast[T integer](old, delta, floor T) (T, bool) {
+	s, o := subOverflow(old, delta)
+	return s, !o && s >= floor
+}
+
+//go:nosplit
+func incNonZero[T integer](old T) (T, bool) {
+	s, o := addOverflow(old, 1)
+	return s, !o && old != 0
+}
+
+//go:nosplit
+func decPositive[T integer](old T) (T, bool) {
+	return old - 1, old > 0
+}
+
+//go:nosplit
+func addChecked[T integer](old, delta T) (T, bool) {
+	s, o := addOverflow(old, delta)
+	return s, !o
+}
+
+// addSaturating returns old + delta clamped to the range of T and reports
+// whether the sum was exact.
+//
+//go:nosplit
+func addSaturating[T integer](old, delta T) (T, bool) {
+	s, o := addOverflow(old, delta)
+	if !o {
+		return s, true
+	}
+	lo, hi := bounds[T]()
+	if delta > 0 {
+		return hi, false
+	}
+	return lo, false
+}
+
+// integer128 is the set of 128-bit value types with conditional arithmetic.
+// The 128-bit steps mirror the ones above through the value methods.
+type integer128[T any] interface {
+	U128 | I128
+	Add(T) T
+	Sub(T) T
+	Cmp(T) int
+}
+
+//go:nosplit
+func u128(lo, hi uint64) U128 {
+	return U128{lo, hi}
+}
+
+//go:nosplit
+func i128(lo, hi uint64) I128 {
+	return I128{lo, int64(hi)}
+}
+
+// one128 returns 1 as a T.
+func one128[T integer128[T]]() (one T) {
+	switch p := any(&one).(type) {
+	case *U128:
+		p.Lo = 1
+	case *I128:
+		p.Lo = 1
+	}
+	return one
+}
+
+// bounds128 returns the minimum and maximum values of T.
+func bounds128[T integer128[T]]() (lo, hi T) {
+	switch p := any(&lo).(type) {
+	case *U128:
+		*any(&hi).(*U128) = U128{math.MaxUint64, math.MaxUint64}
+	case *I128:
+		p.Hi = math.MinInt64
+		*any(&hi).(*I128) = I128{math.MaxUint64, math.MaxInt64}
+	}
+	return lo, hi
+}
+
+// addOverflow128 returns x + d and reports whether it wrapped around.
+func addOverflow128[T integer128[T]](x, d T) (s T, overflow bool) {
+	var zero T
+	s = x.Add(d)
+	return s, (d.Cmp(zero) > 0 && s.Cmp(x) < 0) || (d.Cmp(zero) < 0 && s.Cmp(x) > 0)
+}
+
+// subOverflow128 returns x - d and reports whether it wrapped around.
+func subOverflow128[T integer128[T]](x, d T) (s T, overflow bool) {
+	var zero T
+	s = x.Sub(d)
+	return s, (d.Cmp(zero) > 0 && s.Cmp(x) > 0) || (d.Cmp(zero) < 0 && s.Cmp(x) < 0)
+}
+
+func addBelow128[T integer128[T]](old, delta, limit T) (T, bool) {
+	s, o := addOverflow128(old, delta)
+	return s, !o && s.Cmp(limit) < 0
+}
+
+func subAtLeast128[T integer128[T]](old, delta, floor T) (T, bool) {
+	s, o := subOverflow128(old, delta)
+	return s, !o && s.Cmp(floor) >= 0
+}
+
+func incNonZero128[T integer128[T]](old T) (T, bool) {
+	var zero T
+	s, o := addOverflow128(old, one128[T]())
+	return s, !o && old != zero
+}
+
+func decPositive128[T integer128[T]](old T) (T, bool) {
+	var zero T
+	return old.Sub(one128[T]()), old.Cmp(zero) > 0
+}
+
+func addChecked128[T integer128[T]](old, delta T) (T, bool) {
+	s, o := addOverflow128(old, delta)
+	return s, !o
+}
+
+// addSaturating128 returns old + delta clamped to the range of T and
+// reports whether the sum was exact.
+func addSaturating128[T integer128[T]](old, delta T) (T, bool) {
+	var zero T
+	s, o := addOverflow128(old, delta)
+	if !o {
+		return s, true
+	}
+	lo, hi := bounds128[T]()
+	if delta.Cmp(zero) > 0 {
+		return hi, false
+	}
+	return lo, false
+}
@@ -0,0 +1,230 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Conditional Arithmetic Tests
+// =============================================================================
+
+func TestConditionalArithmeticMatrix(t *testing.T) {
+	types := []any{
+		new(atomix.Int8), new(atomix.Uint8), new(atomix.Int16), new(atomix.Uint16),
+		new(atomix.Int32), new(atomix.Uint32), new(atomix.Int64), new(atomix.Uint64),
+		new(atomix.Uintptr),
+		new(atomix.Int32Of[int32]), new(atomix.Uint32Of[uint32]),
+		new(atomix.Int64Of[int64]), new(atomix.Uint64Of[uint64]),
+		new(atomix.UintptrOf[uintptr]),
+		new(atomix.Uint128), new(atomix.Int128),
+	}
+	for _, v := range types {
+		typ := reflect.TypeOf(v)
+		for _, op := range []string{"AddIfBelow", "SubIfAtLeast", "IncIfNonZero", "DecIfPositive", "AddChecked", "AddSaturating"} {
+			for _, ord := range []string{"", "Relaxed", "Acquire", "Release", "AcqRel", "SeqCst"} {
+				if _, ok := typ.MethodByName(op + ord); !ok {
+					t.Errorf("%v lacks %s%s", typ.Elem(), op, ord)
+				}
+			}
+		}
+	}
+}
+
+func TestAddIfBelowSubIfAtLeast(t *testing.T) {
+	var a atomix.Int64
+	a.Store(8)
+	if old, ok := a.AddIfBelow(1, 10); !ok || old != 8 || a.Load() != 9 {
+		t.Fatalf("AddIfBelow below limit: old=%d ok=%v now=%d", old, ok, a.Load())
+	}
+	if old, ok := a.AddIfBelowAcquire(1, 10); ok || old != 9 || a.Load() != 9 {
+		t.Fatalf("AddIfBelow to limit: old=%d ok=%v now=%d", old, ok, a.Load())
+	}
+	if old, ok := a.SubIfAtLeastRelease(9, 0); !ok || old != 9 || a.Load() != 0 {
+		t.Fatalf("SubIfAtLeast to floor: old=%d ok=%v now=%d", old, ok, a.Load())
+	}
+	if old, ok := a.SubIfAtLeastRelaxed(1, 0); ok || old != 0 {
+		t.Fatalf("SubIfAtLeast past floor: old=%d ok=%v", old, ok)
+	}
+
+	// A sum that wraps around must not pass the limit check.
+	a.Store(math.MaxInt64 - 1)
+	if _, ok := a.AddIfBelowAcqRel(5, math.MaxInt64); ok || a.Load() != math.MaxInt64-1 {
+		t.Fatalf("AddIfBelow overflow applied: now=%d", a.Load())
+	}
+	var u atomix.Uint32
+	if _, ok := u.SubIfAtLeastSeqCst(1, 0); ok || u.Load() != 0 {
+		t.Fatalf("Uint32 SubIfAtLeast underflow applied: now=%d", u.Load())
+	}
+	if old, ok := u.AddIfBelow(math.MaxUint32-1, math.MaxUint32); !ok || old != 0 || u.Load() != math.MaxUint32-1 {
+		t.Fatalf("Uint32 AddIfBelow below max: old=%d ok=%v", old, ok)
+	}
+	if _, ok := u.AddIfBelow(1, math.MaxUint32); ok {
+		t.Fatal("Uint32 AddIfBelow reached the limit")
+	}
+	if _, ok := u.AddIfBelow(2, math.MaxUint32); ok {
+		t.Fatal("Uint32 AddIfBelow wrapped past max")
+	}
+}
+
+func TestIncIfNonZeroDecIfPositive(t *testing.T) {
+	var rc atomix.Uint64
+	if old, ok := rc.IncIfNonZero(); ok || old != 0 || rc.Load() != 0 {
+		t.Fatalf("IncIfNonZero on 0: old=%d ok=%v", old, ok)
+	}
+	rc.Store(1)
+	if old, ok := rc.IncIfNonZeroAcquire(); !ok || old != 1 || rc.Load() != 2 {
+		t.Fatalf("IncIfNonZero on 1: old=%d ok=%v now=%d", old, ok, rc.Load())
+	}
+	rc.Store(math.MaxUint64)
+	if _, ok := rc.IncIfNonZeroRelaxed(); ok || rc.Load() != math.MaxUint64 {
+		t.Fatalf("IncIfNonZero overflow applied: now=%d", rc.Load())
+	}
+
+	var s atomix.Int8
+	s.Store(1)
+	if old, ok := s.DecIfPositiveRelease(); !ok || old != 1 || s.Load() != 0 {
+		t.Fatalf("DecIfPositive on 1: old=%d ok=%v", old, ok)
+	}
+	if old, ok := s.DecIfPositive(); ok || old != 0 {
+		t.Fatalf("DecIfPositive on 0: old=%d ok=%v", old, ok)
+	}
+	s.Store(-3)
+	if _, ok := s.DecIfPositiveAcqRel(); ok || s.Load() != -3 {
+		t.Fatalf("DecIfPositive on -3 applied: now=%d", s.Load())
+	}
+	var u atomix.Uint16
+	u.Store(1)
+	if _, ok := u.DecIfPositiveSeqCst(); !ok || u.Load() != 0 {
+		t.Fatalf("Uint16 DecIfPositive: now=%d", u.Load())
+	}
+}
+
+func TestAddCheckedSaturating(t *testing.T) {
+	var i8 atomix.Int8
+	i8.Store(120)
+	if old, ok := i8.AddChecked(7); !ok || old != 120 || i8.Load() != 127 {
+		t.Fatalf("AddChecked exact: old=%d ok=%v now=%d", old, ok, i8.Load())
+	}
+	if old, ok := i8.AddCheckedRelaxed(1); ok || old != 127 || i8.Load() != 127 {
+		t.Fatalf("AddChecked overflow: old=%d ok=%v now=%d", old, ok, i8.Load())
+	}
+	i8.Store(-100)
+	if old, exact := i8.AddSaturatingAcquire(-100); exact || old != -100 || i8.Load() != math.MinInt8 {
+		t.Fatalf("AddSaturating down: old=%d exact=%v now=%d", old, exact, i8.Load())
+	}
+	if old, exact := i8.AddSaturatingRelease(-1); exact || old != math.MinInt8 || i8.Load() != math.MinInt8 {
+		t.Fatalf("AddSaturating at min: old=%d exact=%v now=%d", old, exact, i8.Load())
+	}
+	if old, exact := i8.AddSaturatingAcqRel(28); !exact || old != math.MinInt8 || i8.Load() != -100 {
+		t.Fatalf("AddSaturating exact: old=%d exact=%v now=%d", old, exact, i8.Load())
+	}
+
+	var u8 atomix.Uint8
+	u8.Store(250)
+	if old, exact := u8.AddSaturating(10); exact || old != 250 || u8.Load() != math.MaxUint8 {
+		t.Fatalf("Uint8 AddSaturating: old=%d exact=%v now=%d", old, exact, u8.Load())
+	}
+	var up atomix.Uintptr
+	up.Store(^uintptr(0))
+	if _, ok := up.AddCheckedSeqCst(1); ok || up.Load() != ^uintptr(0) {
+		t.Fatalf("Uintptr AddChecked overflow applied: now=%d", up.Load())
+	}
+
+	type quota int32
+	var q atomix.Int32Of[quota]
+	q.Store(math.MaxInt32 - 1)
+	if old, exact := q.AddSaturatingSeqCst(5); exact || old != math.MaxInt32-1 || q.Load() != math.MaxInt32 {
+		t.Fatalf("Int32Of AddSaturating: old=%d exact=%v now=%d", old, exact, q.Load())
+	}
+}
+
+func TestConditionalArithmetic128(t *testing.T) {
+	var u atomix.Uint128
+	u.Store(math.MaxUint64, 0)
+	if old, ok := u.AddIfBelow(atomix.U128From64(1), atomix.U128{Hi: 1}); ok || old != (atomix.U128{Lo: math.MaxUint64}) {
+		t.Fatalf("Uint128 AddIfBelow to limit: old=%v ok=%v", old, ok)
+	}
+	if old, ok := u.AddIfBelowAcquire(atomix.U128From64(1), atomix.U128{Hi: 2}); !ok || old.Lo != math.MaxUint64 || u.LoadValue() != (atomix.U128{Hi: 1}) {
+		t.Fatalf("Uint128 AddIfBelow carry: old=%v ok=%v now=%v", old, ok, u.LoadValue())
+	}
+	if old, ok := u.SubIfAtLeastRelease(atomix.U128From64(1), atomix.U128{}); !ok || u.LoadValue() != (atomix.U128{Lo: math.MaxUint64}) {
+		t.Fatalf("Uint128 SubIfAtLeast borrow: old=%v ok=%v now=%v", old, ok, u.LoadValue())
+	}
+	u.Store(0, 0)
+	if _, ok := u.SubIfAtLeastSeqCst(atomix.U128From64(1), atomix.U128{}); ok || !u.LoadValue().IsZero() {
+		t.Fatalf("Uint128 SubIfAtLeast underflow applied: now=%v", u.LoadValue())
+	}
+	if _, ok := u.IncIfNonZeroRelaxed(); ok || !u.LoadValue().IsZero() {
+		t.Fatalf("Uint128 IncIfNonZero on 0 applied: now=%v", u.LoadValue())
+	}
+	u.Store(math.MaxUint64, math.MaxUint64)
+	if _, ok := u.AddChecked(atomix.U128From64(1)); ok {
+		t.Fatal("Uint128 AddChecked overflow applied")
+	}
+	if _, ok := u.IncIfNonZero(); ok {
+		t.Fatal("Uint128 IncIfNonZero overflow applied")
+	}
+	u.Store(math.MaxUint64-1, math.MaxUint64)
+	if old, exact := u.AddSaturatingAcqRel(atomix.U128From64(5)); exact || old.Lo != math.MaxUint64-1 || u.LoadValue() != (atomix.U128{Lo: math.MaxUint64, Hi: math.MaxUint64}) {
+		t.Fatalf("Uint128 AddSaturating: old=%v exact=%v now=%v", old, exact, u.LoadValue())
+	}
+
+	var i atomix.Int128
+	i.StoreValue(atomix.I128From64(1))
+	if old, ok := i.DecIfPositive(); !ok || old != atomix.I128From64(1) || !i.LoadValue().IsZero() {
+		t.Fatalf("Int128 DecIfPositive on 1: old=%v ok=%v now=%v", old, ok, i.LoadValue())
+	}
+	if _, ok := i.DecIfPositiveRelease(); ok || !i.LoadValue().IsZero() {
+		t.Fatalf("Int128 DecIfPositive on 0 applied: now=%v", i.LoadValue())
+	}
+	if old, ok := i.SubIfAtLeastAcquire(atomix.I128From64(3), atomix.I128From64(-3)); !ok || !old.IsZero() || i.LoadValue() != atomix.I128From64(-3) {
+		t.Fatalf("Int128 SubIfAtLeast to floor: old=%v ok=%v now=%v", old, ok, i.LoadValue())
+	}
+	minI128 := atomix.I128{Hi: math.MinInt64}
+	i.StoreValue(minI128.Add(atomix.I128From64(1)))
+	if old, exact := i.AddSaturatingSeqCst(atomix.I128From64(-5)); exact || old != minI128.Add(atomix.I128From64(1)) || i.LoadValue() != minI128 {
+		t.Fatalf("Int128 AddSaturating down: old=%v exact=%v now=%v", old, exact, i.LoadValue())
+	}
+	if _, ok := i.AddCheckedRelaxed(atomix.I128From64(-1)); ok || i.LoadValue() != minI128 {
+		t.Fatalf("Int128 AddChecked underflow applied: now=%v", i.LoadValue())
+	}
+	if old, ok := i.IncIfNonZeroAcqRel(); !ok || old != minI128 || i.LoadValue() != minI128.Add(atomix.I128From64(1)) {
+		t.Fatalf("Int128 IncIfNonZero on min: old=%v ok=%v now=%v", old, ok, i.LoadValue())
+	}
+}
+
+func TestAddIfBelowSemaphore(t *testing.T) {
+	const limit, goroutines, iters = 3, 8, 2000
+	var held, peak atomix.Int32
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iters {
+				old, ok := held.AddIfBelowAcquire(1, limit)
+				if !ok {
+					continue
+				}
+				peak.Max(old + 1)
+				if _, ok := held.SubIfAtLeastRelease(1, 0); !ok {
+					t.Error("release found no permit")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if held.Load() != 0 || peak.Load() >= limit {
+		t.Fatalf("held=%d peak=%d, want 0 and below %d", held.Load(), peak.Load(), limit)
+	}
+}
@@ -2607,6 +2607,50 @@ func TestAllocatorAlignNonPowerOf2(t *testing.T) {
 	a.Align(3) // 3 is not a power of 2
 }
 
+// A placed Uint128 or Int128 reserves its whole struct, not only the 16-byte
+// slot, so the next object starts past its end and the struct never extends
+// beyond the buffer.
+func TestPlaceAligned128Extent(t *testing.T) {
+	const size = int(unsafe.Sizeof(atomix.Uint128{}))
+	buf := make([]byte, 256)
+	off := int(-uintptr(unsafe.Pointer(&buf[0])) & 15)
+
+	if n, _ := atomix.PlaceAlignedUint128(buf, off); n != size {
+		t.Fatalf("PlaceAlignedUint128 consumed %d bytes, want %d", n, size)
+	}
+	if n, _ := atomix.PlaceAlignedInt128(buf, off); n != int(unsafe.Sizeof(atomix.Int128{})) {
+		t.Fatalf("PlaceAlignedInt128 consumed %d bytes, want %d", n, unsafe.Sizeof(atomix.Int128{}))
+	}
+	if _, a := atomix.PlaceAlignedUint128(buf[:off+size], off); a == nil {
+		t.Fatal("PlaceAlignedUint128 at the end of the buffer failed")
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("PlaceAlignedUint128 with room for the slot only did not panic")
+			}
+		}()
+		atomix.PlaceAlignedUint128(buf[:off+16], off)
+	}()
+
+	alloc := atomix.NewAllocator(buf)
+	u := alloc.Uint128()
+	i := alloc.Int128()
+	next := alloc.Uint64()
+	if end := uintptr(unsafe.Pointer(u)) + unsafe.Sizeof(*u); uintptr(unsafe.Pointer(i)) < end {
+		t.Fatalf("Int128 at %p overlaps the Uint128 ending at %#x", i, end)
+	}
+	if end := uintptr(unsafe.Pointer(i)) + unsafe.Sizeof(*i); uintptr(unsafe.Pointer(next)) < end {
+		t.Fatalf("Uint64 at %p overlaps the Int128 ending at %#x", next, end)
+	}
+	u.Store(^uint64(0), ^uint64(0))
+	i.StoreValue(atomix.I128From64(-1))
+	next.Store(7)
+	if lo, hi := u.Load(); lo != ^uint64(0) || hi != ^uint64(0) || next.Load() != 7 {
+		t.Fatalf("neighbours clobbered: u=(%#x, %#x) next=%d", lo, hi, next.Load())
+	}
+}
+
 // =============================================================================
 // Bitwise Operations Ordering Variants Coverage
 // =============================================================================
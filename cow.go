@@ -0,0 +1,226 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"maps"
+	"slices"
+)
+
+// Snapshot holds an immutable value of type T that is replaced atomically
+// as a whole, such as a hot-reloaded configuration struct or string.
+//
+// Readers get the current value with acquire ordering and never block.
+// Writers publish a fresh copy with release ordering; Update retries a
+// copy-modify-publish with CompareAndSwap when writers race. Values must be
+// treated as immutable once stored: anything T refers to is shared with
+// every reader.
+//
+// The zero value holds the zero value of T. Snapshot is safe for concurrent
+// use. Must not be copied after first use.
+type Snapshot[T any] struct {
+	p Pointer[T]
+}
+
+// Load returns the current value with acquire ordering.
+func (s *Snapshot[T]) Load() T {
+	if v := s.p.LoadAcquire(); v != nil {
+		return *v
+	}
+	var zero T
+	return zero
+}
+
+// Store publishes v with release ordering.
+func (s *Snapshot[T]) Store(v T) {
+	s.p.StoreRelease(&v)
+}
+
+// Update publishes f(old) and returns it. When another writer publishes
+// first, f runs again on the newer value, so f must be free of side effects.
+func (s *Snapshot[T]) Update(f func(old T) T) T {
+	var b backoff
+	for {
+		cur := s.p.LoadAcquire()
+		var old T
+		if cur != nil {
+			old = *cur
+		}
+		v := f(old)
+		if s.p.CompareAndSwapAcqRel(cur, &v) {
+			return v
+		}
+		b.wait()
+	}
+}
+
+// CowMap is a copy-on-write map for read-mostly data.
+//
+// Readers see an immutable snapshot published with release ordering and
+// loaded with acquire ordering, so lookups take no locks. Each write copies
+// the map once and publishes the copy with CompareAndSwap, retrying if
+// another writer published first; use Update to apply many edits for the
+// cost of one copy.
+//
+// The zero value is an empty map. CowMap is safe for concurrent use.
+// Must not be copied after first use.
+type CowMap[K comparable, V any] struct {
+	p Pointer[map[K]V]
+}
+
+// Load returns the current snapshot with acquire ordering. The result may be
+// nil and must not be modified.
+func (m *CowMap[K, V]) Load() map[K]V {
+	if p := m.p.LoadAcquire(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// Get returns the value stored under k in the current snapshot.
+func (m *CowMap[K, V]) Get(k K) (v V, ok bool) {
+	v, ok = m.Load()[k]
+	return
+}
+
+// Len returns the number of entries in the current snapshot.
+func (m *CowMap[K, V]) Len() int {
+	return len(m.Load())
+}
+
+// Store sets k to v in a new snapshot.
+func (m *CowMap[K, V]) Store(k K, v V) {
+	m.Update(func(w map[K]V) { w[k] = v })
+}
+
+// Delete removes k in a new snapshot and reports whether it was present.
+// Nothing is copied if k is absent.
+func (m *CowMap[K, V]) Delete(k K) (deleted bool) {
+	var b backoff
+	for {
+		cur := m.p.LoadAcquire()
+		if cur == nil {
+			return false
+		}
+		if _, ok := (*cur)[k]; !ok {
+			return false
+		}
+		w := maps.Clone(*cur)
+		delete(w, k)
+		if m.p.CompareAndSwapAcqRel(cur, &w) {
+			return true
+		}
+		b.wait()
+	}
+}
+
+// Replace publishes src as the new snapshot with release ordering.
+// The caller must not modify src afterwards.
+func (m *CowMap[K, V]) Replace(src map[K]V) {
+	m.p.StoreRelease(&src)
+}
+
+// Update copies the current snapshot once, lets f edit the copy in place,
+// and publishes it. When another writer publishes first, f runs again on a
+// fresh copy of the newer snapshot, so f must be free of side effects.
+func (m *CowMap[K, V]) Update(f func(w map[K]V)) {
+	var b backoff
+	for {
+		cur := m.p.LoadAcquire()
+		var w map[K]V
+		if cur != nil {
+			w = maps.Clone(*cur)
+		}
+		if w == nil {
+			w = make(map[K]V)
+		}
+		f(w)
+		if m.p.CompareAndSwapAcqRel(cur, &w) {
+			return
+		}
+		b.wait()
+	}
+}
+
+// CowSlice is a copy-on-write slice for read-mostly data.
+//
+// Readers see an immutable snapshot published with release ordering and
+// loaded with acquire ordering. Each write copies the slice once and
+// publishes the copy with CompareAndSwap, retrying if another writer
+// published first; use Update to apply many edits for the cost of one copy.
+// Published slices have cap equal to len, so appending to a snapshot always
+// reallocates rather than writing into shared memory.
+//
+// The zero value is an empty slice. CowSlice is safe for concurrent use.
+// Must not be copied after first use.
+type CowSlice[T any] struct {
+	p Pointer[[]T]
+}
+
+// Load returns the current snapshot with acquire ordering. The result must
+// not be modified.
+func (s *CowSlice[T]) Load() []T {
+	if p := s.p.LoadAcquire(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// Len returns the length of the current snapshot.
+func (s *CowSlice[T]) Len() int {
+	return len(s.Load())
+}
+
+// At returns element i of the current snapshot. Panics if i is out of range.
+func (s *CowSlice[T]) At(i int) T {
+	return s.Load()[i]
+}
+
+// Append appends vals in a new snapshot.
+func (s *CowSlice[T]) Append(vals ...T) {
+	// Snapshots are clipped, so append copies exactly once.
+	s.publish(func(cur []T) []T { return append(cur, vals...) })
+}
+
+// Set replaces element i in a new snapshot. Panics if i is out of range.
+func (s *CowSlice[T]) Set(i int, v T) {
+	s.Update(func(w []T) []T {
+		w[i] = v
+		return w
+	})
+}
+
+// Replace publishes src as the new snapshot with release ordering.
+// The caller must not modify src afterwards.
+func (s *CowSlice[T]) Replace(src []T) {
+	src = slices.Clip(src)
+	s.p.StoreRelease(&src)
+}
+
+// Update copies the current snapshot once, passes the copy to f, and
+// publishes the slice f returns. f may modify, reslice, or append to its
+// argument. When another writer publishes first, f runs again on a fresh
+// copy of the newer snapshot, so f must be free of side effects.
+func (s *CowSlice[T]) Update(f func(w []T) []T) {
+	s.publish(func(cur []T) []T { return f(slices.Clone(cur)) })
+}
+
+// publish runs the CAS-retry loop, passing build the current snapshot.
+// build must not modify its argument in place.
+func (s *CowSlice[T]) publish(build func(cur []T) []T) {
+	var b backoff
+	for {
+		cur := s.p.LoadAcquire()
+		var old []T
+		if cur != nil {
+			old = *cur
+		}
+		w := slices.Clip(build(old))
+		if s.p.CompareAndSwapAcqRel(cur, &w) {
+			return
+		}
+		b.wait()
+	}
+}
@@ -0,0 +1,134 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Copy-on-Write Snapshot Tests
+// =============================================================================
+
+func TestSnapshot(t *testing.T) {
+	type config struct {
+		Name    string
+		Workers int
+	}
+	var s atomix.Snapshot[config]
+	if s.Load() != (config{}) {
+		t.Fatal("zero Snapshot should load the zero value")
+	}
+	s.Store(config{"a", 1})
+	got := s.Update(func(c config) config { c.Workers *= 4; return c })
+	if got != (config{"a", 4}) || s.Load() != got {
+		t.Fatalf("Update: got %+v, stored %+v", got, s.Load())
+	}
+
+	var str atomix.Snapshot[string]
+	str.Store("v1")
+	str.Update(func(old string) string { return old + ".1" })
+	if str.Load() != "v1.1" {
+		t.Fatalf("Snapshot[string]: got %q", str.Load())
+	}
+}
+
+func TestCowMap(t *testing.T) {
+	var m atomix.CowMap[string, int]
+	if m.Len() != 0 || m.Load() != nil {
+		t.Fatal("zero CowMap should be empty")
+	}
+	if _, ok := m.Get("x"); ok || m.Delete("x") {
+		t.Fatal("zero CowMap: unexpected entry")
+	}
+	m.Store("a", 1)
+	before := m.Load()
+	m.Store("b", 2)
+	if len(before) != 1 {
+		t.Fatal("an earlier snapshot must not observe later writes")
+	}
+	m.Update(func(w map[string]int) {
+		w["c"] = 3
+		w["a"] = 10
+		delete(w, "b")
+	})
+	if v, ok := m.Get("a"); !ok || v != 10 || m.Len() != 2 {
+		t.Fatalf("Update batch: got %v", m.Load())
+	}
+	if !m.Delete("c") || m.Delete("c") || m.Len() != 1 {
+		t.Fatalf("Delete: got %v", m.Load())
+	}
+	src := map[string]int{"z": 26}
+	m.Replace(src)
+	if v, _ := m.Get("z"); v != 26 || m.Len() != 1 {
+		t.Fatalf("Replace: got %v", m.Load())
+	}
+}
+
+func TestCowSlice(t *testing.T) {
+	var s atomix.CowSlice[int]
+	if s.Len() != 0 {
+		t.Fatal("zero CowSlice should be empty")
+	}
+	s.Append(1, 2, 3)
+	snap := s.Load()
+	if cap(snap) != len(snap) {
+		t.Fatalf("published slice should be clipped: len=%d cap=%d", len(snap), cap(snap))
+	}
+	s.Set(0, 100)
+	s.Append(4)
+	if snap[0] != 1 || len(snap) != 3 {
+		t.Fatal("an earlier snapshot must not observe later writes")
+	}
+	if !slices.Equal(s.Load(), []int{100, 2, 3, 4}) || s.At(3) != 4 {
+		t.Fatalf("got %v", s.Load())
+	}
+	s.Update(func(w []int) []int {
+		slices.Reverse(w)
+		return append(w[:2], 0)
+	})
+	if !slices.Equal(s.Load(), []int{4, 3, 0}) {
+		t.Fatalf("Update batch: got %v", s.Load())
+	}
+	s.Replace(make([]int, 2, 10))
+	if cap(s.Load()) != 2 {
+		t.Fatal("Replace should clip the published slice")
+	}
+}
+
+func TestCowConcurrentWriters(t *testing.T) {
+	const writers, perWriter = 8, 200
+	var m atomix.CowMap[int, int]
+	var s atomix.CowSlice[int]
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWriter {
+				m.Store(w*perWriter+i, i)
+				s.Append(w)
+			}
+		}()
+	}
+	// Readers only ever see complete snapshots.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for m.Len() < writers*perWriter {
+			snap := s.Load()
+			_ = len(snap)
+		}
+	}()
+	wg.Wait()
+	<-done
+	if m.Len() != writers*perWriter || s.Len() != writers*perWriter {
+		t.Fatalf("lost writes: map %d, slice %d, want %d", m.Len(), s.Len(), writers*perWriter)
+	}
+}
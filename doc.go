@@ -12,17 +12,25 @@
 //
 // # Memory Ordering
 //
-// The package exposes four memory orderings:
+// The package exposes five memory orderings:
 //
 //   - [Relaxed]: Only atomicity guaranteed; no ordering constraints
 //   - [Acquire]: Subsequent operations cannot reorder before the load
 //   - [Release]: Prior operations cannot reorder after the store
 //   - [AcqRel]: Acquire + Release; for read-modify-write operations
+//   - [SeqCst]: A total order over SeqCst operations; stores are not
+//     reordered with later loads. [BarrierSeqCst] is the matching fence
 //
 // Unlike sync/atomic which provides sequential consistency, this package
 // allows choosing the minimal ordering required for weakly-ordered
 // architectures (ARM, RISC-V).
 //
+// Standalone fences cover the cases an ordered access cannot:
+// [BarrierLoadLoad], [BarrierLoadStore], [BarrierStoreStore] and
+// [BarrierStoreLoad] order one direction only, [BarrierNonTemporal] also
+// orders non-temporal stores (SFENCE on amd64), and [BarrierCompiler]
+// restrains only the compiler and emits no instruction.
+//
 // # Two APIs
 //
 // Type-based API for embedding in structs:
@@ -43,40 +51,92 @@
 // Unknown orderings fall back to safe defaults (Load→Acquire, Store→Release,
 // RMW→AcqRel).
 //
+// 128-bit slots in raw memory use the At variants, such as
+// [MemoryOrder.LoadUint128At], which take an unsafe.Pointer and panic unless
+// it is 16-byte aligned.
+//
 // # Types
 //
 // Core atomic types:
 //   - [Bool]: Atomic boolean (backed by uint32)
+//   - [Int8], [Uint8], [Int16], [Uint16]: 8/16-bit integers
 //   - [Int32], [Uint32]: 32-bit integers
 //   - [Int64], [Uint64]: 64-bit integers
+//   - [Float32], [Float64]: IEEE 754 floating-point numbers
+//   - [Duration], [Time]: time.Duration and wall-clock time (sign-flipped Unix nanoseconds, 0 for the zero time)
 //   - [Uintptr]: Pointer-sized integer
+//   - [Int32Of], [Uint32Of], [Int64Of], [Uint64Of], [UintptrOf]: Integers of
+//     named types such as `type State uint32`, without conversions
 //   - [Pointer]: Generic atomic pointer
-//   - [Int128], [Uint128]: 128-bit integers (requires 16-byte alignment)
+//   - [Int128], [Uint128]: 128-bit integers that align themselves to 16
+//     bytes wherever declared; [I128] and [U128] are the matching plain
+//     values for arithmetic
+//   - [KCASUint64], [KCASPointer]: Words that [KCAS] updates together
+//   - [TaggedPointer]: Pointer plus 64-bit ABA tag, updated by 128-bit CAS
+//   - [Atomic]: Generic atomic value for small pointer-free types
+//   - [Value]: Interface value with explicit ordering and a configurable
+//     type-consistency [TypePolicy]
+//   - [Bitset]: Multi-word bit set with atomic per-bit ops and slot claiming
+//   - [Flags32], [Flags64]: Flag words indexed by a named bit-position type
+//   - [Snapshot], [CowMap], [CowSlice]: Copy-on-write values for read-mostly
+//     data, built on [Pointer]
 //
 // Cache-line padded variants prevent false sharing:
+//   - [Int8Padded], [Uint8Padded], [Int16Padded], [Uint16Padded]
 //   - [Int32Padded], [Uint32Padded], [Int64Padded], [Uint64Padded]
+//   - [Float32Padded], [Float64Padded], [DurationPadded], [TimePadded]
 //   - [UintptrPadded], [BoolPadded], [Int128Padded], [Uint128Padded]
 //
 // All types are safe for concurrent use. The zero value is valid (0 or nil).
 //
 // # Operations
 //
-// All types support Load, Store, Swap, CompareAndSwap, CompareExchange,
-// Add, Sub, And, Or, Xor, Max, Min, Inc, Dec with explicit ordering suffixes.
+// All integer types support Load, Store, Swap, CompareAndSwap,
+// CompareExchange, Add, Sub, And, Or, Xor, Max, Min, Inc, Dec, FetchAdd,
+// FetchSub, FetchInc, FetchDec; every read-modify-write comes with Relaxed,
+// Acquire, Release, AcqRel, and SeqCst suffixes, and loads and stores with
+// SeqCst.
 //
 // Default methods use: Load=Relaxed, Store=Relaxed, RMW=AcqRel.
 // Note: sync/atomic uses acquire for Load and release for Store.
 // Use LoadAcquire/StoreRelease for sync/atomic-equivalent ordering.
 //
+// Conditional arithmetic (AddIfBelow, SubIfAtLeast, IncIfNonZero,
+// DecIfPositive, AddChecked, AddSaturating) returns the observed value and
+// whether the operation applied, so semaphores, quotas and refcount try-get
+// need no hand-written CAS loop or reload. AddIfBelow applies only while the
+// sum stays strictly below the limit. Uint128 and Int128 take and return U128
+// and I128 values.
+//
+// Uint32, Uint64 and Uintptr add BitSet, BitClear and BitToggle, which
+// change one bit and report its old value (LOCK BTS/BTR/BTC on amd64); Bool
+// adds Toggle, And and Or.
+//
+// KCAS atomically replaces the values of several KCASUint64 and KCASPointer
+// words if all of them hold their expected values. It takes no locks, in the
+// style of Harris, Fraser and Pratt: operations publish descriptors that
+// other threads help complete, and the methods of the KCAS word types account
+// for descriptors in flight. Uint64 and Pointer do not take part in KCAS and
+// pay nothing for it: resolving descriptors in them would put a check on
+// every load and read-modify-write, raw MemoryOrder accesses included, and
+// would reserve values for the marker. Words that a KCAS updates are
+// declared as KCASUint64 or KCASPointer instead.
+//
+// Update and TryUpdate apply a function in a CompareExchange loop with
+// backoff, on the integer, Bool, Pointer, and 128-bit types and through the
+// MemoryOrder API.
+//
 // Return value semantics match sync/atomic:
 //   - Add/Sub/Inc/Dec return the NEW value (after the operation)
-//   - Swap/And/Or/Xor/Max/Min return the OLD value (before the operation)
+//   - Swap/And/Or/Xor/Max/Min and FetchAdd/FetchSub/FetchInc/FetchDec return
+//     the OLD value (before the operation)
 //
 // # Platform Support
 //
 // Primary (native atomic instructions):
 //   - amd64: LOCK-prefixed instructions; TSO provides acquire/release
-//   - arm64: LSE atomics (ARMv8.1+) for 32/64-bit; LL/SC or CASP for 128-bit
+//   - arm64: LSE atomics (ARMv8.1+), or LDAXR/STLXR loops on ARMv8.0 cores;
+//     LL/SC or CASP for 128-bit
 //
 // Secondary (native with limitations):
 //   - riscv64: AMO instructions with .aq/.rl suffixes
@@ -84,26 +144,44 @@
 //
 // Fallback: Other architectures use sync/atomic (over-synchronized).
 //
+// Race builds (-race) use sync/atomic on every architecture, with a mutex for
+// 128-bit operations, so the race detector observes every atomic access.
+//
+// # ARM64 LSE Detection
+//
+// At startup the arm64 backend checks HWCAP_ATOMICS and, on cores without
+// LSE such as Cortex-A53/A72, runs every read-modify-write as an exclusive
+// load/store loop. -tags=lse skips the check and always uses LSE;
+// -tags=nolse always uses the loops.
+//
 // # ARM64 128-bit Build Options
 //
-// ARM64 128-bit atomics support two implementations via build tags:
+// ARM64 128-bit atomics have two implementations, chosen at startup:
 //
-//   - Default (!lse2): LL/SC using LDXP/STXP instructions
-//   - -tags=lse2: CASP instruction (LSE2)
+//   - CASP, with LDP/STP loads and stores, on cores with LSE and LSE2
+//     (ARMv8.4+)
+//   - LL/SC using LDXP/STXP instructions everywhere else
 //
-// LL/SC uses LDXP/STXP pair; CASP uses a single instruction.
-// Use -tags=lse2 for ARMv8.4+ hardware with high-contention workloads.
+// -tags=lse2 always uses CASP; -tags=nolse2 (or -tags=nolse) always uses
+// LDXP/STXP. [Uint128Variant] reports the choice.
+//
+// Independently, -tags=lse128 implements 128-bit And/AndNot/Or with the
+// single-instruction LDCLRP/LDSETP (ARMv9.4+ FEAT_LSE128). Without it, and
+// for Xor/Max/Min, 128-bit read-modify-writes are CAS loops.
 //
 // # 128-bit Atomics
 //
-// [Int128] and [Uint128] require 16-byte alignment. Use [PlaceAlignedInt128]
-// or [PlaceAlignedUint128] to ensure proper alignment.
+// [Int128] and [Uint128] align themselves to 16 bytes; the At variants
+// and [PlaceAlignedUint128] cover raw memory.
 //
-// True 128-bit atomicity is only available on:
+// Native 16-byte atomic instructions are used on:
 //   - amd64: LOCK CMPXCHG16B
-//   - arm64: LDXP/STXP (default) or CASP (-tags=lse2)
+//   - arm64: CASP with LSE2, else LDXP/STXP
 //
-// Other architectures provide mutual exclusion but may exhibit torn reads.
+// Other architectures, and race builds, guard each slot with a mutex from an
+// address-hashed lock table. Every operation, loads included, takes it, so no
+// reader sees a torn value, but the operations block and exclude only other
+// operations of this process. [Uint128IsLockFree] reports which applies.
 //
 // # Placement Helpers
 //
@@ -112,3 +190,5 @@
 //   - [PlaceAlignedInt32], [PlaceAlignedInt64], [PlaceAlignedUint128], etc.
 //   - [Allocator]: Sequential allocator for building atomic structures
 package atomix
+
+//go:generate go run ./internal/opsgen
@@ -0,0 +1,298 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "time"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) Load() time.Duration {
+	return time.Duration(a.v.LoadRelaxed())
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) LoadRelaxed() time.Duration {
+	return time.Duration(a.v.LoadRelaxed())
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) LoadAcquire() time.Duration {
+	return time.Duration(a.v.LoadAcquire())
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) Store(val time.Duration) {
+	a.v.StoreRelaxed(int64(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) StoreRelaxed(val time.Duration) {
+	a.v.StoreRelaxed(int64(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Duration) StoreRelease(val time.Duration) {
+	a.v.StoreRelease(int64(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) Swap(new time.Duration) time.Duration {
+	return time.Duration(a.v.SwapAcqRel(int64(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) SwapRelaxed(new time.Duration) time.Duration {
+	return time.Duration(a.v.SwapRelaxed(int64(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) SwapAcquire(new time.Duration) time.Duration {
+	return time.Duration(a.v.SwapAcquire(int64(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Duration) SwapRelease(new time.Duration) time.Duration {
+	return time.Duration(a.v.SwapRelease(int64(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) SwapAcqRel(new time.Duration) time.Duration {
+	return time.Duration(a.v.SwapAcqRel(int64(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Duration) CompareAndSwap(old, new time.Duration) bool {
+	return a.v.CompareAndSwapAcqRel(int64(old), int64(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) CompareAndSwapRelaxed(old, new time.Duration) bool {
+	return a.v.CompareAndSwapRelaxed(int64(old), int64(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) CompareAndSwapAcquire(old, new time.Duration) bool {
+	return a.v.CompareAndSwapAcquire(int64(old), int64(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Duration) CompareAndSwapRelease(old, new time.Duration) bool {
+	return a.v.CompareAndSwapRelease(int64(old), int64(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) CompareAndSwapAcqRel(old, new time.Duration) bool {
+	return a.v.CompareAndSwapAcqRel(int64(old), int64(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) CompareExchange(old, new time.Duration) time.Duration {
+	return time.Duration(a.v.CompareExchangeAcqRel(int64(old), int64(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) CompareExchangeRelaxed(old, new time.Duration) time.Duration {
+	return time.Duration(a.v.CompareExchangeRelaxed(int64(old), int64(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) CompareExchangeAcquire(old, new time.Duration) time.Duration {
+	return time.Duration(a.v.CompareExchangeAcquire(int64(old), int64(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Duration) CompareExchangeRelease(old, new time.Duration) time.Duration {
+	return time.Duration(a.v.CompareExchangeRelease(int64(old), int64(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) CompareExchangeAcqRel(old, new time.Duration) time.Duration {
+	return time.Duration(a.v.CompareExchangeAcqRel(int64(old), int64(new)))
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) Max(val time.Duration) time.Duration {
+	return time.Duration(AcqRel.MaxInt64(&a.v.v, int64(val)))
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) MaxRelaxed(val time.Duration) time.Duration {
+	return time.Duration(Relaxed.MaxInt64(&a.v.v, int64(val)))
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) MaxAcquire(val time.Duration) time.Duration {
+	return time.Duration(Acquire.MaxInt64(&a.v.v, int64(val)))
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Duration) MaxRelease(val time.Duration) time.Duration {
+	return time.Duration(Release.MaxInt64(&a.v.v, int64(val)))
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) MaxAcqRel(val time.Duration) time.Duration {
+	return time.Duration(AcqRel.MaxInt64(&a.v.v, int64(val)))
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) Min(val time.Duration) time.Duration {
+	return time.Duration(AcqRel.MinInt64(&a.v.v, int64(val)))
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) MinRelaxed(val time.Duration) time.Duration {
+	return time.Duration(Relaxed.MinInt64(&a.v.v, int64(val)))
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) MinAcquire(val time.Duration) time.Duration {
+	return time.Duration(Acquire.MinInt64(&a.v.v, int64(val)))
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Duration) MinRelease(val time.Duration) time.Duration {
+	return time.Duration(Release.MinInt64(&a.v.v, int64(val)))
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) MinAcqRel(val time.Duration) time.Duration {
+	return time.Duration(AcqRel.MinInt64(&a.v.v, int64(val)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) Add(delta time.Duration) time.Duration {
+	return time.Duration(a.v.AddAcqRel(int64(delta)))
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) AddRelaxed(delta time.Duration) time.Duration {
+	return time.Duration(a.v.AddRelaxed(int64(delta)))
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) AddAcquire(delta time.Duration) time.Duration {
+	return time.Duration(a.v.AddAcquire(int64(delta)))
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Duration) AddRelease(delta time.Duration) time.Duration {
+	return time.Duration(a.v.AddRelease(int64(delta)))
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) AddAcqRel(delta time.Duration) time.Duration {
+	return time.Duration(a.v.AddAcqRel(int64(delta)))
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) Sub(delta time.Duration) time.Duration {
+	return time.Duration(a.v.SubAcqRel(int64(delta)))
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Duration) SubRelaxed(delta time.Duration) time.Duration {
+	return time.Duration(a.v.SubRelaxed(int64(delta)))
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Duration) SubAcquire(delta time.Duration) time.Duration {
+	return time.Duration(a.v.SubAcquire(int64(delta)))
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Duration) SubRelease(delta time.Duration) time.Duration {
+	return time.Duration(a.v.SubRelease(int64(delta)))
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Duration) SubAcqRel(delta time.Duration) time.Duration {
+	return time.Duration(a.v.SubAcqRel(int64(delta)))
+}
@@ -0,0 +1,364 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// BitIndex is satisfied by integer types whose values name bit positions,
+// such as `type SlotFlag uint8` with `const (Ready SlotFlag = iota; Busy)`.
+type BitIndex interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Flags32 is an atomic set of up to 32 flags named by bit positions of type F.
+//
+// Set, Clear and Toggle change one flag with a single-bit read-modify-write
+// (LOCK BTS/BTR/BTC on amd64, LDSET/LDCLR/LDEOR on arm64) and report whether
+// it was set before. Flag positions are taken modulo 32.
+//
+// The zero value has every flag clear. Flags32 is safe for concurrent use.
+// Must not be copied after first use.
+type Flags32[F BitIndex] struct {
+	_ noCopy
+	v uint32
+}
+
+// Load atomically loads the flag word with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) Load() uint32 {
+	return arch.LoadUint32Relaxed(&a.v)
+}
+
+// LoadAcquire atomically loads the flag word with acquire ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) LoadAcquire() uint32 {
+	return arch.LoadUint32Acquire(&a.v)
+}
+
+// Store atomically replaces the flag word with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) Store(bits uint32) {
+	arch.StoreUint32Relaxed(&a.v, bits)
+}
+
+// StoreRelease atomically replaces the flag word with release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) StoreRelease(bits uint32) {
+	arch.StoreUint32Release(&a.v, bits)
+}
+
+// Test reports whether flag f is set, with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) Test(f F) bool {
+	return arch.LoadUint32Relaxed(&a.v)&(1<<(uint(f)&31)) != 0
+}
+
+// TestAcquire reports whether flag f is set, with acquire ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) TestAcquire(f F) bool {
+	return arch.LoadUint32Acquire(&a.v)&(1<<(uint(f)&31)) != 0
+}
+
+// Set atomically sets flag f and reports whether it was already set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) Set(f F) (wasSet bool) {
+	return arch.BitSetUint32AcqRel(&a.v, uint(f))
+}
+
+// SetRelaxed atomically sets flag f and reports whether it was already set,
+// with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) SetRelaxed(f F) (wasSet bool) {
+	return arch.BitSetUint32Relaxed(&a.v, uint(f))
+}
+
+// SetAcquire atomically sets flag f and reports whether it was already set,
+// with acquire ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) SetAcquire(f F) (wasSet bool) {
+	return arch.BitSetUint32Acquire(&a.v, uint(f))
+}
+
+// SetRelease atomically sets flag f and reports whether it was already set,
+// with release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) SetRelease(f F) (wasSet bool) {
+	return arch.BitSetUint32Release(&a.v, uint(f))
+}
+
+// SetAcqRel atomically sets flag f and reports whether it was already set,
+// with acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) SetAcqRel(f F) (wasSet bool) {
+	return arch.BitSetUint32AcqRel(&a.v, uint(f))
+}
+
+// Clear atomically clears flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) Clear(f F) (wasSet bool) {
+	return arch.BitClearUint32AcqRel(&a.v, uint(f))
+}
+
+// ClearRelaxed atomically clears flag f and reports whether it was set, with
+// relaxed ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ClearRelaxed(f F) (wasSet bool) {
+	return arch.BitClearUint32Relaxed(&a.v, uint(f))
+}
+
+// ClearAcquire atomically clears flag f and reports whether it was set, with
+// acquire ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ClearAcquire(f F) (wasSet bool) {
+	return arch.BitClearUint32Acquire(&a.v, uint(f))
+}
+
+// ClearRelease atomically clears flag f and reports whether it was set, with
+// release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ClearRelease(f F) (wasSet bool) {
+	return arch.BitClearUint32Release(&a.v, uint(f))
+}
+
+// ClearAcqRel atomically clears flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ClearAcqRel(f F) (wasSet bool) {
+	return arch.BitClearUint32AcqRel(&a.v, uint(f))
+}
+
+// Toggle atomically flips flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) Toggle(f F) (wasSet bool) {
+	return arch.BitToggleUint32AcqRel(&a.v, uint(f))
+}
+
+// ToggleRelaxed atomically flips flag f and reports whether it was set, with
+// relaxed ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ToggleRelaxed(f F) (wasSet bool) {
+	return arch.BitToggleUint32Relaxed(&a.v, uint(f))
+}
+
+// ToggleAcquire atomically flips flag f and reports whether it was set, with
+// acquire ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ToggleAcquire(f F) (wasSet bool) {
+	return arch.BitToggleUint32Acquire(&a.v, uint(f))
+}
+
+// ToggleRelease atomically flips flag f and reports whether it was set, with
+// release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ToggleRelease(f F) (wasSet bool) {
+	return arch.BitToggleUint32Release(&a.v, uint(f))
+}
+
+// ToggleAcqRel atomically flips flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags32[F]) ToggleAcqRel(f F) (wasSet bool) {
+	return arch.BitToggleUint32AcqRel(&a.v, uint(f))
+}
+
+// Flags64 is an atomic set of up to 64 flags named by bit positions of type F.
+//
+// Set, Clear and Toggle change one flag with a single-bit read-modify-write
+// (LOCK BTS/BTR/BTC on amd64, LDSET/LDCLR/LDEOR on arm64) and report whether
+// it was set before. Flag positions are taken modulo 64.
+//
+// The zero value has every flag clear. Flags64 is safe for concurrent use.
+// Must not be copied after first use.
+type Flags64[F BitIndex] struct {
+	_ noCopy
+	v uint64
+}
+
+// Load atomically loads the flag word with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) Load() uint64 {
+	return arch.LoadUint64Relaxed(&a.v)
+}
+
+// LoadAcquire atomically loads the flag word with acquire ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) LoadAcquire() uint64 {
+	return arch.LoadUint64Acquire(&a.v)
+}
+
+// Store atomically replaces the flag word with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) Store(bits uint64) {
+	arch.StoreUint64Relaxed(&a.v, bits)
+}
+
+// StoreRelease atomically replaces the flag word with release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) StoreRelease(bits uint64) {
+	arch.StoreUint64Release(&a.v, bits)
+}
+
+// Test reports whether flag f is set, with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) Test(f F) bool {
+	return arch.LoadUint64Relaxed(&a.v)&(1<<(uint(f)&63)) != 0
+}
+
+// TestAcquire reports whether flag f is set, with acquire ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) TestAcquire(f F) bool {
+	return arch.LoadUint64Acquire(&a.v)&(1<<(uint(f)&63)) != 0
+}
+
+// Set atomically sets flag f and reports whether it was already set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) Set(f F) (wasSet bool) {
+	return arch.BitSetUint64AcqRel(&a.v, uint(f))
+}
+
+// SetRelaxed atomically sets flag f and reports whether it was already set,
+// with relaxed ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) SetRelaxed(f F) (wasSet bool) {
+	return arch.BitSetUint64Relaxed(&a.v, uint(f))
+}
+
+// SetAcquire atomically sets flag f and reports whether it was already set,
+// with acquire ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) SetAcquire(f F) (wasSet bool) {
+	return arch.BitSetUint64Acquire(&a.v, uint(f))
+}
+
+// SetRelease atomically sets flag f and reports whether it was already set,
+// with release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) SetRelease(f F) (wasSet bool) {
+	return arch.BitSetUint64Release(&a.v, uint(f))
+}
+
+// SetAcqRel atomically sets flag f and reports whether it was already set,
+// with acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) SetAcqRel(f F) (wasSet bool) {
+	return arch.BitSetUint64AcqRel(&a.v, uint(f))
+}
+
+// Clear atomically clears flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) Clear(f F) (wasSet bool) {
+	return arch.BitClearUint64AcqRel(&a.v, uint(f))
+}
+
+// ClearRelaxed atomically clears flag f and reports whether it was set, with
+// relaxed ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ClearRelaxed(f F) (wasSet bool) {
+	return arch.BitClearUint64Relaxed(&a.v, uint(f))
+}
+
+// ClearAcquire atomically clears flag f and reports whether it was set, with
+// acquire ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ClearAcquire(f F) (wasSet bool) {
+	return arch.BitClearUint64Acquire(&a.v, uint(f))
+}
+
+// ClearRelease atomically clears flag f and reports whether it was set, with
+// release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ClearRelease(f F) (wasSet bool) {
+	return arch.BitClearUint64Release(&a.v, uint(f))
+}
+
+// ClearAcqRel atomically clears flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ClearAcqRel(f F) (wasSet bool) {
+	return arch.BitClearUint64AcqRel(&a.v, uint(f))
+}
+
+// Toggle atomically flips flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) Toggle(f F) (wasSet bool) {
+	return arch.BitToggleUint64AcqRel(&a.v, uint(f))
+}
+
+// ToggleRelaxed atomically flips flag f and reports whether it was set, with
+// relaxed ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ToggleRelaxed(f F) (wasSet bool) {
+	return arch.BitToggleUint64Relaxed(&a.v, uint(f))
+}
+
+// ToggleAcquire atomically flips flag f and reports whether it was set, with
+// acquire ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ToggleAcquire(f F) (wasSet bool) {
+	return arch.BitToggleUint64Acquire(&a.v, uint(f))
+}
+
+// ToggleRelease atomically flips flag f and reports whether it was set, with
+// release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ToggleRelease(f F) (wasSet bool) {
+	return arch.BitToggleUint64Release(&a.v, uint(f))
+}
+
+// ToggleAcqRel atomically flips flag f and reports whether it was set, with
+// acquire-release ordering.
+//
+//go:nosplit
+func (a *Flags64[F]) ToggleAcqRel(f F) (wasSet bool) {
+	return arch.BitToggleUint64AcqRel(&a.v, uint(f))
+}
@@ -0,0 +1,192 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Single-bit Operation Tests
+// =============================================================================
+
+func TestBitOpsAllOrderings(t *testing.T) {
+	var a atomix.Uint32
+	sets := []func(uint) bool{a.BitSet, a.BitSetRelaxed, a.BitSetAcquire, a.BitSetRelease, a.BitSetAcqRel, a.BitSetSeqCst}
+	clears := []func(uint) bool{a.BitClear, a.BitClearRelaxed, a.BitClearAcquire, a.BitClearRelease, a.BitClearAcqRel, a.BitClearSeqCst}
+	toggles := []func(uint) bool{a.BitToggle, a.BitToggleRelaxed, a.BitToggleAcquire, a.BitToggleRelease, a.BitToggleAcqRel, a.BitToggleSeqCst}
+	for i := range sets {
+		a.Store(0x8000_0001)
+		if sets[i](4) || a.Load() != 0x8000_0011 {
+			t.Fatalf("BitSet #%d: got %#x", i, a.Load())
+		}
+		if !sets[i](4) || a.Load() != 0x8000_0011 {
+			t.Fatalf("BitSet #%d again: got %#x", i, a.Load())
+		}
+		if !clears[i](31) || a.Load() != 0x11 {
+			t.Fatalf("BitClear #%d: got %#x", i, a.Load())
+		}
+		if clears[i](31) || a.Load() != 0x11 {
+			t.Fatalf("BitClear #%d again: got %#x", i, a.Load())
+		}
+		if !toggles[i](0) || toggles[i](2) || a.Load() != 0x14 {
+			t.Fatalf("BitToggle #%d: got %#x", i, a.Load())
+		}
+	}
+}
+
+func TestBitOpsWidths(t *testing.T) {
+	var u32 atomix.Uint32
+	if u32.BitSet(33) || u32.Load() != 2 {
+		t.Fatalf("Uint32 bit 33: got %#x, want bit 1", u32.Load())
+	}
+
+	var u64 atomix.Uint64
+	u64.Store(1)
+	if u64.BitSetRelease(63) || !u64.BitClearAcquire(64) || u64.Load() != 1<<63 {
+		t.Fatalf("Uint64: got %#x", u64.Load())
+	}
+	if !u64.BitToggleRelaxed(63) || u64.Load() != 0 {
+		t.Fatalf("Uint64 toggle: got %#x", u64.Load())
+	}
+
+	var up atomix.Uintptr
+	if up.BitSet(7) || !up.BitSet(7) || !up.BitClear(7) || up.Load() != 0 {
+		t.Fatalf("Uintptr: got %#x", up.Load())
+	}
+}
+
+func TestMemoryOrderBitOps(t *testing.T) {
+	orders := []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel, atomix.SeqCst}
+	for _, o := range orders {
+		var u32 uint32
+		if o.BitSetUint32(&u32, 5) || !o.BitToggleUint32(&u32, 5) || o.BitClearUint32(&u32, 5) || u32 != 0 {
+			t.Fatalf("%v Uint32: got %#x", o, u32)
+		}
+		var u64 uint64 = 1 << 40
+		if !o.BitClearUint64(&u64, 40) || o.BitToggleUint64(&u64, 41) || u64 != 1<<41 {
+			t.Fatalf("%v Uint64: got %#x", o, u64)
+		}
+		var up uintptr
+		if o.BitSetUintptr(&up, 3) || up != 8 {
+			t.Fatalf("%v Uintptr: got %#x", o, up)
+		}
+	}
+}
+
+func TestBitSetConcurrent(t *testing.T) {
+	const goroutines, bits = 8, 64
+	var a atomix.Uint64
+	var wins [bits]atomix.Int32
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range uint(bits) {
+				if !a.BitSet(i) {
+					wins[i].Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for i := range wins {
+		if n := wins[i].Load(); n != 1 {
+			t.Errorf("bit %d claimed %d times, want 1", i, n)
+		}
+	}
+	if a.Load() != ^uint64(0) {
+		t.Fatalf("got %#x, want all bits set", a.Load())
+	}
+}
+
+// =============================================================================
+// Bool Toggle/And/Or Tests
+// =============================================================================
+
+func TestBoolToggleAndOr(t *testing.T) {
+	var b atomix.Bool
+	toggles := []func() bool{b.Toggle, b.ToggleRelaxed, b.ToggleAcquire, b.ToggleRelease, b.ToggleAcqRel, b.ToggleSeqCst}
+	ands := []func(bool) bool{b.And, b.AndRelaxed, b.AndAcquire, b.AndRelease, b.AndAcqRel, b.AndSeqCst}
+	ors := []func(bool) bool{b.Or, b.OrRelaxed, b.OrAcquire, b.OrRelease, b.OrAcqRel, b.OrSeqCst}
+	for i := range toggles {
+		b.Store(false)
+		if toggles[i]() || !b.Load() {
+			t.Fatalf("Toggle #%d from false", i)
+		}
+		if !toggles[i]() || b.Load() {
+			t.Fatalf("Toggle #%d from true", i)
+		}
+		if ors[i](false) || b.Load() {
+			t.Fatalf("Or #%d false|false", i)
+		}
+		if ors[i](true) || !b.Load() {
+			t.Fatalf("Or #%d false|true", i)
+		}
+		if !ands[i](true) || !b.Load() {
+			t.Fatalf("And #%d true&true", i)
+		}
+		if !ands[i](false) || b.Load() {
+			t.Fatalf("And #%d true&false", i)
+		}
+	}
+
+	var raw uint32
+	if atomix.AcqRel.ToggleBool(&raw) || !atomix.Relaxed.OrBool(&raw, false) || !atomix.Release.AndBool(&raw, false) || raw != 0 {
+		t.Fatalf("MemoryOrder Bool ops: got %d", raw)
+	}
+}
+
+// =============================================================================
+// Flags Tests
+// =============================================================================
+
+type slotFlag uint8
+
+const (
+	slotReady slotFlag = iota
+	slotBusy
+	slotClosed = 63
+)
+
+func TestFlags32(t *testing.T) {
+	var f atomix.Flags32[slotFlag]
+	sets := []func(slotFlag) bool{f.Set, f.SetRelaxed, f.SetAcquire, f.SetRelease, f.SetAcqRel}
+	clears := []func(slotFlag) bool{f.Clear, f.ClearRelaxed, f.ClearAcquire, f.ClearRelease, f.ClearAcqRel}
+	toggles := []func(slotFlag) bool{f.Toggle, f.ToggleRelaxed, f.ToggleAcquire, f.ToggleRelease, f.ToggleAcqRel}
+	for i := range sets {
+		f.Store(0)
+		if sets[i](slotReady) || !sets[i](slotReady) || !f.Test(slotReady) || f.TestAcquire(slotBusy) {
+			t.Fatalf("Set #%d: got %#x", i, f.Load())
+		}
+		if toggles[i](slotBusy) || f.LoadAcquire() != 3 {
+			t.Fatalf("Toggle #%d: got %#x", i, f.Load())
+		}
+		if !clears[i](slotReady) || clears[i](slotReady) || f.Load() != 2 {
+			t.Fatalf("Clear #%d: got %#x", i, f.Load())
+		}
+	}
+	f.StoreRelease(0)
+	if f.Set(slotClosed) || f.Load() != 1<<31 {
+		t.Fatalf("Flags32 position 63: got %#x, want bit 31", f.Load())
+	}
+}
+
+func TestFlags64(t *testing.T) {
+	var f atomix.Flags64[slotFlag]
+	if f.SetAcquire(slotClosed) || f.SetRelease(slotReady) || f.Load() != 1<<63|1 {
+		t.Fatalf("Set: got %#x", f.Load())
+	}
+	if !f.ToggleAcqRel(slotClosed) || f.TestAcquire(slotClosed) || !f.Test(slotReady) {
+		t.Fatalf("Toggle: got %#x", f.Load())
+	}
+	if !f.ClearRelaxed(slotReady) || f.Load() != 0 {
+		t.Fatalf("Clear: got %#x", f.Load())
+	}
+}
@@ -0,0 +1,462 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"math"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) Load() float32 {
+	return math.Float32frombits(arch.LoadUint32Relaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) LoadRelaxed() float32 {
+	return math.Float32frombits(arch.LoadUint32Relaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) LoadAcquire() float32 {
+	return math.Float32frombits(arch.LoadUint32Acquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) Store(val float32) {
+	arch.StoreUint32Relaxed(&a.v, math.Float32bits(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) StoreRelaxed(val float32) {
+	arch.StoreUint32Relaxed(&a.v, math.Float32bits(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Float32) StoreRelease(val float32) {
+	arch.StoreUint32Release(&a.v, math.Float32bits(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) Swap(new float32) float32 {
+	return math.Float32frombits(arch.SwapUint32AcqRel(&a.v, math.Float32bits(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) SwapRelaxed(new float32) float32 {
+	return math.Float32frombits(arch.SwapUint32Relaxed(&a.v, math.Float32bits(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) SwapAcquire(new float32) float32 {
+	return math.Float32frombits(arch.SwapUint32Acquire(&a.v, math.Float32bits(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Float32) SwapRelease(new float32) float32 {
+	return math.Float32frombits(arch.SwapUint32Release(&a.v, math.Float32bits(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) SwapAcqRel(new float32) float32 {
+	return math.Float32frombits(arch.SwapUint32AcqRel(&a.v, math.Float32bits(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed. Values are compared bitwise.
+//
+//go:nosplit
+func (a *Float32) CompareAndSwap(old, new float32) bool {
+	return arch.CasUint32AcqRel(&a.v, math.Float32bits(old), math.Float32bits(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) CompareAndSwapRelaxed(old, new float32) bool {
+	return arch.CasUint32Relaxed(&a.v, math.Float32bits(old), math.Float32bits(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) CompareAndSwapAcquire(old, new float32) bool {
+	return arch.CasUint32Acquire(&a.v, math.Float32bits(old), math.Float32bits(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Float32) CompareAndSwapRelease(old, new float32) bool {
+	return arch.CasUint32Release(&a.v, math.Float32bits(old), math.Float32bits(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) CompareAndSwapAcqRel(old, new float32) bool {
+	return arch.CasUint32AcqRel(&a.v, math.Float32bits(old), math.Float32bits(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering. Values are compared bitwise.
+//
+//go:nosplit
+func (a *Float32) CompareExchange(old, new float32) float32 {
+	return math.Float32frombits(arch.CaxUint32AcqRel(&a.v, math.Float32bits(old), math.Float32bits(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) CompareExchangeRelaxed(old, new float32) float32 {
+	return math.Float32frombits(arch.CaxUint32Relaxed(&a.v, math.Float32bits(old), math.Float32bits(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) CompareExchangeAcquire(old, new float32) float32 {
+	return math.Float32frombits(arch.CaxUint32Acquire(&a.v, math.Float32bits(old), math.Float32bits(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Float32) CompareExchangeRelease(old, new float32) float32 {
+	return math.Float32frombits(arch.CaxUint32Release(&a.v, math.Float32bits(old), math.Float32bits(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) CompareExchangeAcqRel(old, new float32) float32 {
+	return math.Float32frombits(arch.CaxUint32AcqRel(&a.v, math.Float32bits(old), math.Float32bits(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) Add(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) + delta
+		if arch.CasUint32AcqRel(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) AddRelaxed(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) + delta
+		if arch.CasUint32Relaxed(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) AddAcquire(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) + delta
+		if arch.CasUint32Acquire(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Float32) AddRelease(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) + delta
+		if arch.CasUint32Release(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) AddAcqRel(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) + delta
+		if arch.CasUint32AcqRel(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) Sub(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) - delta
+		if arch.CasUint32AcqRel(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) SubRelaxed(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) - delta
+		if arch.CasUint32Relaxed(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) SubAcquire(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) - delta
+		if arch.CasUint32Acquire(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Float32) SubRelease(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) - delta
+		if arch.CasUint32Release(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) SubAcqRel(delta float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32frombits(old) - delta
+		if arch.CasUint32AcqRel(&a.v, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering. See [Float32] for NaN and signed-zero semantics.
+//
+//go:nosplit
+func (a *Float32) Max(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(maxFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32AcqRel(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) MaxRelaxed(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(maxFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32Relaxed(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) MaxAcquire(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(maxFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32Acquire(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Float32) MaxRelease(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(maxFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32Release(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) MaxAcqRel(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(maxFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32AcqRel(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering. See [Float32] for NaN and signed-zero semantics.
+//
+//go:nosplit
+func (a *Float32) Min(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(minFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32AcqRel(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Float32) MinRelaxed(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(minFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32Relaxed(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Float32) MinAcquire(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(minFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32Acquire(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Float32) MinRelease(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(minFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32Release(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float32) MinAcqRel(val float32) float32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		new := math.Float32bits(minFloat32(math.Float32frombits(old), val))
+		if new == old || arch.CasUint32AcqRel(&a.v, old, new) {
+			return math.Float32frombits(old)
+		}
+	}
+}
+
+// maxFloat32 returns the larger of x and y.
+// A NaN operand is returned unchanged, and +0 is considered larger than -0.
+//
+//go:nosplit
+func maxFloat32(x, y float32) float32 {
+	switch {
+	case x != x:
+		return x
+	case y != y:
+		return y
+	case x > y:
+		return x
+	case x < y:
+		return y
+	case x == 0 && math.Signbit(float64(x)):
+		return y
+	}
+	return x
+}
+
+// minFloat32 returns the smaller of x and y.
+// A NaN operand is returned unchanged, and -0 is considered smaller than +0.
+//
+//go:nosplit
+func minFloat32(x, y float32) float32 {
+	switch {
+	case x != x:
+		return x
+	case y != y:
+		return y
+	case x < y:
+		return x
+	case x > y:
+		return y
+	case x == 0 && !math.Signbit(float64(x)):
+		return y
+	}
+	return x
+}
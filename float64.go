@@ -0,0 +1,462 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"math"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) Load() float64 {
+	return math.Float64frombits(arch.LoadUint64Relaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) LoadRelaxed() float64 {
+	return math.Float64frombits(arch.LoadUint64Relaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) LoadAcquire() float64 {
+	return math.Float64frombits(arch.LoadUint64Acquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) Store(val float64) {
+	arch.StoreUint64Relaxed(&a.v, math.Float64bits(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) StoreRelaxed(val float64) {
+	arch.StoreUint64Relaxed(&a.v, math.Float64bits(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Float64) StoreRelease(val float64) {
+	arch.StoreUint64Release(&a.v, math.Float64bits(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) Swap(new float64) float64 {
+	return math.Float64frombits(arch.SwapUint64AcqRel(&a.v, math.Float64bits(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) SwapRelaxed(new float64) float64 {
+	return math.Float64frombits(arch.SwapUint64Relaxed(&a.v, math.Float64bits(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) SwapAcquire(new float64) float64 {
+	return math.Float64frombits(arch.SwapUint64Acquire(&a.v, math.Float64bits(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Float64) SwapRelease(new float64) float64 {
+	return math.Float64frombits(arch.SwapUint64Release(&a.v, math.Float64bits(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) SwapAcqRel(new float64) float64 {
+	return math.Float64frombits(arch.SwapUint64AcqRel(&a.v, math.Float64bits(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed. Values are compared bitwise.
+//
+//go:nosplit
+func (a *Float64) CompareAndSwap(old, new float64) bool {
+	return arch.CasUint64AcqRel(&a.v, math.Float64bits(old), math.Float64bits(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) CompareAndSwapRelaxed(old, new float64) bool {
+	return arch.CasUint64Relaxed(&a.v, math.Float64bits(old), math.Float64bits(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) CompareAndSwapAcquire(old, new float64) bool {
+	return arch.CasUint64Acquire(&a.v, math.Float64bits(old), math.Float64bits(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Float64) CompareAndSwapRelease(old, new float64) bool {
+	return arch.CasUint64Release(&a.v, math.Float64bits(old), math.Float64bits(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) CompareAndSwapAcqRel(old, new float64) bool {
+	return arch.CasUint64AcqRel(&a.v, math.Float64bits(old), math.Float64bits(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering. Values are compared bitwise.
+//
+//go:nosplit
+func (a *Float64) CompareExchange(old, new float64) float64 {
+	return math.Float64frombits(arch.CaxUint64AcqRel(&a.v, math.Float64bits(old), math.Float64bits(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) CompareExchangeRelaxed(old, new float64) float64 {
+	return math.Float64frombits(arch.CaxUint64Relaxed(&a.v, math.Float64bits(old), math.Float64bits(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) CompareExchangeAcquire(old, new float64) float64 {
+	return math.Float64frombits(arch.CaxUint64Acquire(&a.v, math.Float64bits(old), math.Float64bits(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Float64) CompareExchangeRelease(old, new float64) float64 {
+	return math.Float64frombits(arch.CaxUint64Release(&a.v, math.Float64bits(old), math.Float64bits(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) CompareExchangeAcqRel(old, new float64) float64 {
+	return math.Float64frombits(arch.CaxUint64AcqRel(&a.v, math.Float64bits(old), math.Float64bits(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) Add(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) + delta
+		if arch.CasUint64AcqRel(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) AddRelaxed(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) + delta
+		if arch.CasUint64Relaxed(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) AddAcquire(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) + delta
+		if arch.CasUint64Acquire(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Float64) AddRelease(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) + delta
+		if arch.CasUint64Release(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) AddAcqRel(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) + delta
+		if arch.CasUint64AcqRel(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) Sub(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) - delta
+		if arch.CasUint64AcqRel(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) SubRelaxed(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) - delta
+		if arch.CasUint64Relaxed(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) SubAcquire(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) - delta
+		if arch.CasUint64Acquire(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Float64) SubRelease(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) - delta
+		if arch.CasUint64Release(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) SubAcqRel(delta float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64frombits(old) - delta
+		if arch.CasUint64AcqRel(&a.v, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering. See [Float64] for NaN and signed-zero semantics.
+//
+//go:nosplit
+func (a *Float64) Max(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(maxFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64AcqRel(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) MaxRelaxed(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(maxFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64Relaxed(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) MaxAcquire(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(maxFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64Acquire(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Float64) MaxRelease(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(maxFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64Release(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) MaxAcqRel(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(maxFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64AcqRel(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering. See [Float64] for NaN and signed-zero semantics.
+//
+//go:nosplit
+func (a *Float64) Min(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(minFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64AcqRel(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Float64) MinRelaxed(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(minFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64Relaxed(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Float64) MinAcquire(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(minFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64Acquire(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Float64) MinRelease(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(minFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64Release(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Float64) MinAcqRel(val float64) float64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		new := math.Float64bits(minFloat64(math.Float64frombits(old), val))
+		if new == old || arch.CasUint64AcqRel(&a.v, old, new) {
+			return math.Float64frombits(old)
+		}
+	}
+}
+
+// maxFloat64 returns the larger of x and y.
+// A NaN operand is returned unchanged, and +0 is considered larger than -0.
+//
+//go:nosplit
+func maxFloat64(x, y float64) float64 {
+	switch {
+	case x != x:
+		return x
+	case y != y:
+		return y
+	case x > y:
+		return x
+	case x < y:
+		return y
+	case x == 0 && math.Signbit(x):
+		return y
+	}
+	return x
+}
+
+// minFloat64 returns the smaller of x and y.
+// A NaN operand is returned unchanged, and -0 is considered smaller than +0.
+//
+//go:nosplit
+func minFloat64(x, y float64) float64 {
+	switch {
+	case x != x:
+		return x
+	case y != y:
+		return y
+	case x < y:
+		return x
+	case x > y:
+		return y
+	case x == 0 && !math.Signbit(x):
+		return y
+	}
+	return x
+}
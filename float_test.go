@@ -0,0 +1,466 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Float64 Tests
+// =============================================================================
+
+func TestFloat64LoadStore(t *testing.T) {
+	var a atomix.Float64
+
+	// Zero value is +0
+	if got := a.Load(); got != 0 || math.Signbit(got) {
+		t.Fatalf("zero value: got %v, want +0", got)
+	}
+
+	a.Store(1.5)
+	if got := a.Load(); got != 1.5 {
+		t.Fatalf("Load: got %v, want 1.5", got)
+	}
+	a.StoreRelaxed(-2.25)
+	if got := a.LoadRelaxed(); got != -2.25 {
+		t.Fatalf("LoadRelaxed: got %v, want -2.25", got)
+	}
+	a.StoreRelease(math.Inf(1))
+	if got := a.LoadAcquire(); !math.IsInf(got, 1) {
+		t.Fatalf("LoadAcquire: got %v, want +Inf", got)
+	}
+}
+
+func TestFloat64OrderingVariants(t *testing.T) {
+	var a atomix.Float64
+
+	swaps := []func(float64) float64{a.Swap, a.SwapRelaxed, a.SwapAcquire, a.SwapRelease, a.SwapAcqRel}
+	for i, swap := range swaps {
+		a.Store(float64(i))
+		if old := swap(float64(i) + 0.5); old != float64(i) {
+			t.Fatalf("Swap variant %d: got old %v, want %v", i, old, float64(i))
+		}
+		if got := a.Load(); got != float64(i)+0.5 {
+			t.Fatalf("Swap variant %d: got %v, want %v", i, got, float64(i)+0.5)
+		}
+	}
+
+	cases := []func(old, new float64) bool{
+		a.CompareAndSwap, a.CompareAndSwapRelaxed, a.CompareAndSwapAcquire,
+		a.CompareAndSwapRelease, a.CompareAndSwapAcqRel,
+	}
+	for i, cas := range cases {
+		a.Store(1)
+		if cas(2, 3) {
+			t.Fatalf("CAS variant %d: succeeded with wrong old", i)
+		}
+		if !cas(1, 3) || a.Load() != 3 {
+			t.Fatalf("CAS variant %d: failed with correct old", i)
+		}
+	}
+
+	caxs := []func(old, new float64) float64{
+		a.CompareExchange, a.CompareExchangeRelaxed, a.CompareExchangeAcquire,
+		a.CompareExchangeRelease, a.CompareExchangeAcqRel,
+	}
+	for i, cax := range caxs {
+		a.Store(1)
+		if prev := cax(2, 3); prev != 1 || a.Load() != 1 {
+			t.Fatalf("CompareExchange variant %d: failure path got prev %v", i, prev)
+		}
+		if prev := cax(1, 3); prev != 1 || a.Load() != 3 {
+			t.Fatalf("CompareExchange variant %d: success path got prev %v", i, prev)
+		}
+	}
+
+	adds := []func(float64) float64{a.Add, a.AddRelaxed, a.AddAcquire, a.AddRelease, a.AddAcqRel}
+	for i, add := range adds {
+		a.Store(1)
+		if got := add(0.25); got != 1.25 {
+			t.Fatalf("Add variant %d: got %v, want 1.25", i, got)
+		}
+	}
+
+	subs := []func(float64) float64{a.Sub, a.SubRelaxed, a.SubAcquire, a.SubRelease, a.SubAcqRel}
+	for i, sub := range subs {
+		a.Store(1)
+		if got := sub(0.25); got != 0.75 {
+			t.Fatalf("Sub variant %d: got %v, want 0.75", i, got)
+		}
+	}
+
+	maxs := []func(float64) float64{a.Max, a.MaxRelaxed, a.MaxAcquire, a.MaxRelease, a.MaxAcqRel}
+	for i, maxFn := range maxs {
+		a.Store(1)
+		if old := maxFn(0.5); old != 1 || a.Load() != 1 {
+			t.Fatalf("Max variant %d: smaller value changed state", i)
+		}
+		if old := maxFn(2); old != 1 || a.Load() != 2 {
+			t.Fatalf("Max variant %d: larger value not stored", i)
+		}
+	}
+
+	mins := []func(float64) float64{a.Min, a.MinRelaxed, a.MinAcquire, a.MinRelease, a.MinAcqRel}
+	for i, minFn := range mins {
+		a.Store(1)
+		if old := minFn(2); old != 1 || a.Load() != 1 {
+			t.Fatalf("Min variant %d: larger value changed state", i)
+		}
+		if old := minFn(-1); old != 1 || a.Load() != -1 {
+			t.Fatalf("Min variant %d: smaller value not stored", i)
+		}
+	}
+}
+
+func TestFloat64CompareAndSwapBitwise(t *testing.T) {
+	var a atomix.Float64
+	negZero := math.Copysign(0, -1)
+
+	// +0 and -0 compare equal numerically but not bitwise
+	a.Store(0)
+	if a.CompareAndSwap(negZero, 1) {
+		t.Fatal("CAS(-0) matched stored +0")
+	}
+	a.Store(negZero)
+	if a.CompareAndSwap(0, 1) {
+		t.Fatal("CAS(+0) matched stored -0")
+	}
+	if !a.CompareAndSwap(negZero, 1) {
+		t.Fatal("CAS(-0) did not match stored -0")
+	}
+
+	// NaN never equals itself numerically, but the same payload matches bitwise
+	nan := math.NaN()
+	a.Store(nan)
+	if !a.CompareAndSwap(nan, 2) {
+		t.Fatal("CAS(NaN) did not match identical NaN payload")
+	}
+	other := math.Float64frombits(math.Float64bits(nan) ^ 0x2)
+	a.Store(nan)
+	if a.CompareAndSwap(other, 2) {
+		t.Fatal("CAS matched NaN with a different payload")
+	}
+	if prev := a.CompareExchange(other, 2); math.Float64bits(prev) != math.Float64bits(nan) {
+		t.Fatalf("CompareExchange returned %x, want %x", math.Float64bits(prev), math.Float64bits(nan))
+	}
+}
+
+func TestFloat64MaxMinSpecialValues(t *testing.T) {
+	var a atomix.Float64
+	negZero := math.Copysign(0, -1)
+
+	// Signed zero: +0 > -0
+	a.Store(negZero)
+	a.Max(0)
+	if got := a.Load(); got != 0 || math.Signbit(got) {
+		t.Fatalf("Max(-0, +0): got %v, want +0", got)
+	}
+	a.Store(0)
+	a.Min(negZero)
+	if got := a.Load(); !math.Signbit(got) {
+		t.Fatalf("Min(+0, -0): got %v, want -0", got)
+	}
+	a.Store(0)
+	a.Max(negZero)
+	if got := a.Load(); math.Signbit(got) {
+		t.Fatal("Max(+0, -0) stored -0")
+	}
+
+	// NaN operand is stored
+	a.Store(1)
+	if old := a.Max(math.NaN()); old != 1 || !math.IsNaN(a.Load()) {
+		t.Fatal("Max(1, NaN) did not store NaN")
+	}
+	a.Store(1)
+	if old := a.Min(math.NaN()); old != 1 || !math.IsNaN(a.Load()) {
+		t.Fatal("Min(1, NaN) did not store NaN")
+	}
+
+	// Stored NaN is sticky and keeps its payload
+	payload := math.Float64frombits(0x7FF8_0000_0000_0042)
+	a.Store(payload)
+	if old := a.Max(math.Inf(1)); !math.IsNaN(old) {
+		t.Fatalf("Max on NaN returned %v", old)
+	}
+	if a.Min(math.Inf(-1)); math.Float64bits(a.Load()) != 0x7FF8_0000_0000_0042 {
+		t.Fatal("Min on NaN changed payload")
+	}
+}
+
+func TestFloat64Concurrent(t *testing.T) {
+	var a atomix.Float64
+	var wg sync.WaitGroup
+	const goroutines, iterations = 8, 1000
+
+	wg.Add(goroutines)
+	for range goroutines {
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				a.Add(0.5)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := a.Load(), float64(goroutines*iterations)*0.5; got != want {
+		t.Fatalf("concurrent Add: got %v, want %v", got, want)
+	}
+}
+
+func TestFloat64MaxContention(t *testing.T) {
+	var a atomix.Float64
+	a.Store(math.Inf(-1))
+	var wg sync.WaitGroup
+	const goroutines = 8
+
+	wg.Add(goroutines)
+	for g := range goroutines {
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				a.Max(float64(g*1000 + i))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := a.Load(); got != goroutines*1000-1 {
+		t.Fatalf("concurrent Max: got %v, want %d", got, goroutines*1000-1)
+	}
+}
+
+// =============================================================================
+// Float32 Tests
+// =============================================================================
+
+func TestFloat32LoadStore(t *testing.T) {
+	var a atomix.Float32
+
+	if got := a.Load(); got != 0 {
+		t.Fatalf("zero value: got %v, want 0", got)
+	}
+	a.Store(1.5)
+	if got := a.Load(); got != 1.5 {
+		t.Fatalf("Load: got %v, want 1.5", got)
+	}
+	a.StoreRelaxed(-2.25)
+	if got := a.LoadRelaxed(); got != -2.25 {
+		t.Fatalf("LoadRelaxed: got %v, want -2.25", got)
+	}
+	a.StoreRelease(3)
+	if got := a.LoadAcquire(); got != 3 {
+		t.Fatalf("LoadAcquire: got %v, want 3", got)
+	}
+}
+
+func TestFloat32OrderingVariants(t *testing.T) {
+	var a atomix.Float32
+
+	swaps := []func(float32) float32{a.Swap, a.SwapRelaxed, a.SwapAcquire, a.SwapRelease, a.SwapAcqRel}
+	for i, swap := range swaps {
+		a.Store(1)
+		if old := swap(2); old != 1 || a.Load() != 2 {
+			t.Fatalf("Swap variant %d failed", i)
+		}
+	}
+
+	cases := []func(old, new float32) bool{
+		a.CompareAndSwap, a.CompareAndSwapRelaxed, a.CompareAndSwapAcquire,
+		a.CompareAndSwapRelease, a.CompareAndSwapAcqRel,
+	}
+	for i, cas := range cases {
+		a.Store(1)
+		if cas(2, 3) || !cas(1, 3) || a.Load() != 3 {
+			t.Fatalf("CAS variant %d failed", i)
+		}
+	}
+
+	caxs := []func(old, new float32) float32{
+		a.CompareExchange, a.CompareExchangeRelaxed, a.CompareExchangeAcquire,
+		a.CompareExchangeRelease, a.CompareExchangeAcqRel,
+	}
+	for i, cax := range caxs {
+		a.Store(1)
+		if cax(2, 3) != 1 || cax(1, 3) != 1 || a.Load() != 3 {
+			t.Fatalf("CompareExchange variant %d failed", i)
+		}
+	}
+
+	adds := []func(float32) float32{a.Add, a.AddRelaxed, a.AddAcquire, a.AddRelease, a.AddAcqRel}
+	subs := []func(float32) float32{a.Sub, a.SubRelaxed, a.SubAcquire, a.SubRelease, a.SubAcqRel}
+	for i := range adds {
+		a.Store(1)
+		if got := adds[i](0.5); got != 1.5 {
+			t.Fatalf("Add variant %d: got %v, want 1.5", i, got)
+		}
+		if got := subs[i](1); got != 0.5 {
+			t.Fatalf("Sub variant %d: got %v, want 0.5", i, got)
+		}
+	}
+
+	maxs := []func(float32) float32{a.Max, a.MaxRelaxed, a.MaxAcquire, a.MaxRelease, a.MaxAcqRel}
+	mins := []func(float32) float32{a.Min, a.MinRelaxed, a.MinAcquire, a.MinRelease, a.MinAcqRel}
+	for i := range maxs {
+		a.Store(1)
+		if old := maxs[i](4); old != 1 || a.Load() != 4 {
+			t.Fatalf("Max variant %d failed", i)
+		}
+		if old := mins[i](-4); old != 4 || a.Load() != -4 {
+			t.Fatalf("Min variant %d failed", i)
+		}
+	}
+}
+
+func TestFloat32SpecialValues(t *testing.T) {
+	var a atomix.Float32
+	negZero := float32(math.Copysign(0, -1))
+	nan := float32(math.NaN())
+
+	a.Store(0)
+	if a.CompareAndSwap(negZero, 1) {
+		t.Fatal("CAS(-0) matched stored +0")
+	}
+	a.Store(nan)
+	if !a.CompareAndSwap(nan, 1) {
+		t.Fatal("CAS(NaN) did not match identical NaN payload")
+	}
+
+	a.Store(negZero)
+	a.Max(0)
+	if got := a.Load(); math.Signbit(float64(got)) {
+		t.Fatal("Max(-0, +0) kept -0")
+	}
+	a.Min(negZero)
+	if got := a.Load(); !math.Signbit(float64(got)) {
+		t.Fatal("Min(+0, -0) kept +0")
+	}
+
+	a.Store(1)
+	a.Min(nan)
+	if got := a.Load(); got == got {
+		t.Fatalf("Min(1, NaN): got %v, want NaN", got)
+	}
+	a.Max(2)
+	if got := a.Load(); got == got {
+		t.Fatalf("Max(NaN, 2): got %v, want NaN", got)
+	}
+}
+
+// =============================================================================
+// Float Pointer API, Placement, and Padding
+// =============================================================================
+
+func TestOrderFloat64(t *testing.T) {
+	orders := []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel}
+	for _, o := range orders {
+		var x float64
+		o.StoreFloat64(&x, 1)
+		if got := o.LoadFloat64(&x); got != 1 {
+			t.Fatalf("order %d: Load got %v, want 1", o, got)
+		}
+		if old := o.SwapFloat64(&x, 2); old != 1 {
+			t.Fatalf("order %d: Swap got %v, want 1", o, old)
+		}
+		if o.CompareAndSwapFloat64(&x, 1, 3) || !o.CompareAndSwapFloat64(&x, 2, 3) {
+			t.Fatalf("order %d: CompareAndSwap failed", o)
+		}
+		if prev := o.CompareExchangeFloat64(&x, 3, 4); prev != 3 {
+			t.Fatalf("order %d: CompareExchange got %v, want 3", o, prev)
+		}
+		if got := o.AddFloat64(&x, 0.5); got != 4.5 {
+			t.Fatalf("order %d: Add got %v, want 4.5", o, got)
+		}
+		if old := o.MaxFloat64(&x, 10); old != 4.5 || x != 10 {
+			t.Fatalf("order %d: Max failed", o)
+		}
+		if old := o.MinFloat64(&x, -1); old != 10 || x != -1 {
+			t.Fatalf("order %d: Min failed", o)
+		}
+	}
+}
+
+func TestOrderFloat32(t *testing.T) {
+	orders := []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel}
+	for _, o := range orders {
+		var x float32
+		o.StoreFloat32(&x, 1)
+		if got := o.LoadFloat32(&x); got != 1 {
+			t.Fatalf("order %d: Load got %v, want 1", o, got)
+		}
+		if old := o.SwapFloat32(&x, 2); old != 1 {
+			t.Fatalf("order %d: Swap got %v, want 1", o, old)
+		}
+		if o.CompareAndSwapFloat32(&x, 1, 3) || !o.CompareAndSwapFloat32(&x, 2, 3) {
+			t.Fatalf("order %d: CompareAndSwap failed", o)
+		}
+		if prev := o.CompareExchangeFloat32(&x, 3, 4); prev != 3 {
+			t.Fatalf("order %d: CompareExchange got %v, want 3", o, prev)
+		}
+		if got := o.AddFloat32(&x, 0.5); got != 4.5 {
+			t.Fatalf("order %d: Add got %v, want 4.5", o, got)
+		}
+		if old := o.MaxFloat32(&x, 10); old != 4.5 || x != 10 {
+			t.Fatalf("order %d: Max failed", o)
+		}
+		if old := o.MinFloat32(&x, -1); old != 10 || x != -1 {
+			t.Fatalf("order %d: Min failed", o)
+		}
+	}
+}
+
+func TestFloatPlacement(t *testing.T) {
+	buf := make([]byte, 512)
+
+	n, f32 := atomix.PlaceAlignedFloat32(buf, 1)
+	if n < 4 || uintptr(unsafe.Pointer(f32))%4 != 0 {
+		t.Fatal("PlaceAlignedFloat32: pointer not 4-byte aligned")
+	}
+	n, f64 := atomix.PlaceAlignedFloat64(buf, 13)
+	if n < 8 || uintptr(unsafe.Pointer(f64))%8 != 0 {
+		t.Fatal("PlaceAlignedFloat64: pointer not 8-byte aligned")
+	}
+	f32.Store(1.5)
+	f64.Store(2.5)
+	if f32.Load() != 1.5 || f64.Load() != 2.5 {
+		t.Fatal("placed floats failed")
+	}
+
+	alloc := atomix.NewAllocator(buf)
+	af32 := alloc.Float32()
+	af64 := alloc.Float64()
+	cf32 := alloc.CacheAlignedFloat32()
+	cf64 := alloc.CacheAlignedFloat64()
+	if uintptr(unsafe.Pointer(cf32))%atomix.CacheLineSize != 0 ||
+		uintptr(unsafe.Pointer(cf64))%atomix.CacheLineSize != 0 {
+		t.Fatal("cache-aligned floats not cache-line aligned")
+	}
+	af32.Add(1)
+	af64.Add(2)
+	cf32.Add(3)
+	cf64.Add(4)
+	if af32.Load() != 1 || af64.Load() != 2 || cf32.Load() != 3 || cf64.Load() != 4 {
+		t.Fatal("allocated floats failed")
+	}
+
+	var p32 atomix.Float32Padded
+	var p64 atomix.Float64Padded
+	if unsafe.Sizeof(p32) < 64 || unsafe.Sizeof(p64) < 64 {
+		t.Fatal("padded floats smaller than a cache line")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("PlaceAlignedFloat64 did not panic on short buffer")
+		}
+	}()
+	atomix.PlaceAlignedFloat64(make([]byte, 4), 0)
+}
@@ -11,7 +11,7 @@ import "code.hybscloud.com/atomix/internal/arch"
 //
 //go:nosplit
 func (a *Int128) Load() (lo, hi int64) {
-	ulo, uhi := arch.LoadUint128Relaxed(&a.v)
+	ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
 	return int64(ulo), int64(uhi)
 }
 
@@ -19,7 +19,7 @@ func (a *Int128) Load() (lo, hi int64) {
 //
 //go:nosplit
 func (a *Int128) LoadRelaxed() (lo, hi int64) {
-	ulo, uhi := arch.LoadUint128Relaxed(&a.v)
+	ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
 	return int64(ulo), int64(uhi)
 }
 
@@ -27,7 +27,7 @@ func (a *Int128) LoadRelaxed() (lo, hi int64) {
 //
 //go:nosplit
 func (a *Int128) LoadAcquire() (lo, hi int64) {
-	ulo, uhi := arch.LoadUint128Acquire(&a.v)
+	ulo, uhi := arch.LoadUint128Acquire(a.v.slot())
 	return int64(ulo), int64(uhi)
 }
 
@@ -35,21 +35,21 @@ func (a *Int128) LoadAcquire() (lo, hi int64) {
 //
 //go:nosplit
 func (a *Int128) Store(lo, hi int64) {
-	arch.StoreUint128Relaxed(&a.v, uint64(lo), uint64(hi))
+	arch.StoreUint128Relaxed(a.v.slot(), uint64(lo), uint64(hi))
 }
 
 // StoreRelaxed atomically stores val with relaxed ordering.
 //
 //go:nosplit
 func (a *Int128) StoreRelaxed(lo, hi int64) {
-	arch.StoreUint128Relaxed(&a.v, uint64(lo), uint64(hi))
+	arch.StoreUint128Relaxed(a.v.slot(), uint64(lo), uint64(hi))
 }
 
 // StoreRelease atomically stores val with release ordering.
 //
 //go:nosplit
 func (a *Int128) StoreRelease(lo, hi int64) {
-	arch.StoreUint128Release(&a.v, uint64(lo), uint64(hi))
+	arch.StoreUint128Release(a.v.slot(), uint64(lo), uint64(hi))
 }
 
 // Swap atomically stores new value and returns the old value.
@@ -57,7 +57,7 @@ func (a *Int128) StoreRelease(lo, hi int64) {
 //
 //go:nosplit
 func (a *Int128) Swap(newLo, newHi int64) (oldLo, oldHi int64) {
-	ulo, uhi := arch.SwapUint128AcqRel(&a.v, uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.SwapUint128AcqRel(a.v.slot(), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -65,7 +65,7 @@ func (a *Int128) Swap(newLo, newHi int64) (oldLo, oldHi int64) {
 //
 //go:nosplit
 func (a *Int128) SwapRelaxed(newLo, newHi int64) (oldLo, oldHi int64) {
-	ulo, uhi := arch.SwapUint128Relaxed(&a.v, uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.SwapUint128Relaxed(a.v.slot(), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -73,7 +73,7 @@ func (a *Int128) SwapRelaxed(newLo, newHi int64) (oldLo, oldHi int64) {
 //
 //go:nosplit
 func (a *Int128) SwapAcquire(newLo, newHi int64) (oldLo, oldHi int64) {
-	ulo, uhi := arch.SwapUint128Acquire(&a.v, uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.SwapUint128Acquire(a.v.slot(), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -81,7 +81,7 @@ func (a *Int128) SwapAcquire(newLo, newHi int64) (oldLo, oldHi int64) {
 //
 //go:nosplit
 func (a *Int128) SwapRelease(newLo, newHi int64) (oldLo, oldHi int64) {
-	ulo, uhi := arch.SwapUint128Release(&a.v, uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.SwapUint128Release(a.v.slot(), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -89,7 +89,7 @@ func (a *Int128) SwapRelease(newLo, newHi int64) (oldLo, oldHi int64) {
 //
 //go:nosplit
 func (a *Int128) SwapAcqRel(newLo, newHi int64) (oldLo, oldHi int64) {
-	ulo, uhi := arch.SwapUint128AcqRel(&a.v, uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.SwapUint128AcqRel(a.v.slot(), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -98,35 +98,35 @@ func (a *Int128) SwapAcqRel(newLo, newHi int64) (oldLo, oldHi int64) {
 //
 //go:nosplit
 func (a *Int128) CompareAndSwap(oldLo, oldHi, newLo, newHi int64) bool {
-	return arch.CasUint128AcqRel(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	return arch.CasUint128AcqRel(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 }
 
 // CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
 //
 //go:nosplit
 func (a *Int128) CompareAndSwapRelaxed(oldLo, oldHi, newLo, newHi int64) bool {
-	return arch.CasUint128Relaxed(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	return arch.CasUint128Relaxed(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 }
 
 // CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
 //
 //go:nosplit
 func (a *Int128) CompareAndSwapAcquire(oldLo, oldHi, newLo, newHi int64) bool {
-	return arch.CasUint128Acquire(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	return arch.CasUint128Acquire(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 }
 
 // CompareAndSwapRelease atomically compares and swaps with release ordering.
 //
 //go:nosplit
 func (a *Int128) CompareAndSwapRelease(oldLo, oldHi, newLo, newHi int64) bool {
-	return arch.CasUint128Release(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	return arch.CasUint128Release(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 }
 
 // CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
 //
 //go:nosplit
 func (a *Int128) CompareAndSwapAcqRel(oldLo, oldHi, newLo, newHi int64) bool {
-	return arch.CasUint128AcqRel(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	return arch.CasUint128AcqRel(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 }
 
 // CompareExchange atomically compares and swaps, returning the old value.
@@ -134,7 +134,7 @@ func (a *Int128) CompareAndSwapAcqRel(oldLo, oldHi, newLo, newHi int64) bool {
 //
 //go:nosplit
 func (a *Int128) CompareExchange(oldLo, oldHi, newLo, newHi int64) (lo, hi int64) {
-	ulo, uhi := arch.CaxUint128AcqRel(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.CaxUint128AcqRel(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -142,7 +142,7 @@ func (a *Int128) CompareExchange(oldLo, oldHi, newLo, newHi int64) (lo, hi int64
 //
 //go:nosplit
 func (a *Int128) CompareExchangeRelaxed(oldLo, oldHi, newLo, newHi int64) (lo, hi int64) {
-	ulo, uhi := arch.CaxUint128Relaxed(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.CaxUint128Relaxed(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -150,7 +150,7 @@ func (a *Int128) CompareExchangeRelaxed(oldLo, oldHi, newLo, newHi int64) (lo, h
 //
 //go:nosplit
 func (a *Int128) CompareExchangeAcquire(oldLo, oldHi, newLo, newHi int64) (lo, hi int64) {
-	ulo, uhi := arch.CaxUint128Acquire(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.CaxUint128Acquire(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -158,7 +158,7 @@ func (a *Int128) CompareExchangeAcquire(oldLo, oldHi, newLo, newHi int64) (lo, h
 //
 //go:nosplit
 func (a *Int128) CompareExchangeRelease(oldLo, oldHi, newLo, newHi int64) (lo, hi int64) {
-	ulo, uhi := arch.CaxUint128Release(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.CaxUint128Release(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -166,7 +166,7 @@ func (a *Int128) CompareExchangeRelease(oldLo, oldHi, newLo, newHi int64) (lo, h
 //
 //go:nosplit
 func (a *Int128) CompareExchangeAcqRel(oldLo, oldHi, newLo, newHi int64) (lo, hi int64) {
-	ulo, uhi := arch.CaxUint128AcqRel(&a.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	ulo, uhi := arch.CaxUint128AcqRel(a.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	return int64(ulo), int64(uhi)
 }
 
@@ -176,13 +176,13 @@ func (a *Int128) CompareExchangeAcqRel(oldLo, oldHi, newLo, newHi int64) (lo, hi
 //go:nosplit
 func (a *Int128) Add(deltaLo, deltaHi int64) (lo, hi int64) {
 	for {
-		ulo, uhi := arch.LoadUint128Relaxed(&a.v)
+		ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo := ulo + uint64(deltaLo)
 		newHi := uhi + uint64(deltaHi)
 		if newLo < ulo { // Carry
 			newHi++
 		}
-		if arch.CasUint128AcqRel(&a.v, ulo, uhi, newLo, newHi) {
+		if arch.CasUint128AcqRel(a.v.slot(), ulo, uhi, newLo, newHi) {
 			return int64(newLo), int64(newHi)
 		}
 	}
@@ -193,13 +193,13 @@ func (a *Int128) Add(deltaLo, deltaHi int64) (lo, hi int64) {
 //go:nosplit
 func (a *Int128) AddRelaxed(deltaLo, deltaHi int64) (lo, hi int64) {
 	for {
-		ulo, uhi := arch.LoadUint128Relaxed(&a.v)
+		ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo := ulo + uint64(deltaLo)
 		newHi := uhi + uint64(deltaHi)
 		if newLo < ulo {
 			newHi++
 		}
-		if arch.CasUint128Relaxed(&a.v, ulo, uhi, newLo, newHi) {
+		if arch.CasUint128Relaxed(a.v.slot(), ulo, uhi, newLo, newHi) {
 			return int64(newLo), int64(newHi)
 		}
 	}
@@ -211,13 +211,13 @@ func (a *Int128) AddRelaxed(deltaLo, deltaHi int64) (lo, hi int64) {
 //go:nosplit
 func (a *Int128) Sub(deltaLo, deltaHi int64) (lo, hi int64) {
 	for {
-		ulo, uhi := arch.LoadUint128Relaxed(&a.v)
+		ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo := ulo - uint64(deltaLo)
 		newHi := uhi - uint64(deltaHi)
 		if newLo > ulo { // Borrow
 			newHi--
 		}
-		if arch.CasUint128AcqRel(&a.v, ulo, uhi, newLo, newHi) {
+		if arch.CasUint128AcqRel(a.v.slot(), ulo, uhi, newLo, newHi) {
 			return int64(newLo), int64(newHi)
 		}
 	}
@@ -228,13 +228,13 @@ func (a *Int128) Sub(deltaLo, deltaHi int64) (lo, hi int64) {
 //go:nosplit
 func (a *Int128) SubRelaxed(deltaLo, deltaHi int64) (lo, hi int64) {
 	for {
-		ulo, uhi := arch.LoadUint128Relaxed(&a.v)
+		ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo := ulo - uint64(deltaLo)
 		newHi := uhi - uint64(deltaHi)
 		if newLo > ulo {
 			newHi--
 		}
-		if arch.CasUint128Relaxed(&a.v, ulo, uhi, newLo, newHi) {
+		if arch.CasUint128Relaxed(a.v.slot(), ulo, uhi, newLo, newHi) {
 			return int64(newLo), int64(newHi)
 		}
 	}
@@ -270,12 +270,255 @@ func (a *Int128) DecRelaxed() (lo, hi int64) {
 	return a.SubRelaxed(1, 0)
 }
 
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) And(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.and128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) AndRelaxed(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Relaxed.and128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) AndAcquire(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Acquire.and128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Int128) AndRelease(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Release.and128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) AndAcqRel(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.and128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndNot atomically performs bitwise AND NOT (clears the bits set in (lo, hi))
+// and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) AndNot(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.andNot128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndNotRelaxed atomically performs bitwise AND NOT with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) AndNotRelaxed(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Relaxed.andNot128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndNotAcquire atomically performs bitwise AND NOT with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) AndNotAcquire(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Acquire.andNot128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndNotRelease atomically performs bitwise AND NOT with release ordering.
+//
+//go:nosplit
+func (a *Int128) AndNotRelease(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Release.andNot128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndNotAcqRel atomically performs bitwise AND NOT with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) AndNotAcqRel(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.andNot128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) Or(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.or128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) OrRelaxed(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Relaxed.or128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) OrAcquire(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Acquire.or128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Int128) OrRelease(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Release.or128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) OrAcqRel(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.or128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) Xor(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.xor128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) XorRelaxed(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Relaxed.xor128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) XorAcquire(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Acquire.xor128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Int128) XorRelease(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Release.xor128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) XorAcqRel(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.xor128(a.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// Max atomically stores the maximum of current and (lo, hi), returning the old value.
+// Uses acquire-release ordering. Comparison is signed.
+//
+//go:nosplit
+func (a *Int128) Max(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.max128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) MaxRelaxed(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Relaxed.max128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) MaxAcquire(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Acquire.max128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Int128) MaxRelease(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Release.max128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) MaxAcqRel(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.max128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// Min atomically stores the minimum of current and (lo, hi), returning the old value.
+// Uses acquire-release ordering. Comparison is signed.
+//
+//go:nosplit
+func (a *Int128) Min(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.min128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) MinRelaxed(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Relaxed.min128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) MinAcquire(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Acquire.min128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Int128) MinRelease(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := Release.min128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) MinAcqRel(lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := AcqRel.min128(a.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
 // Equal atomically loads and compares for equality.
 // Uses acquire ordering.
 //
 //go:nosplit
 func (a *Int128) Equal(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Acquire(&a.v)
+	ulo, uhi := arch.LoadUint128Acquire(a.v.slot())
 	return int64(ulo) == lo && int64(uhi) == hi
 }
 
@@ -283,7 +526,7 @@ func (a *Int128) Equal(lo, hi int64) bool {
 //
 //go:nosplit
 func (a *Int128) EqualRelaxed(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Relaxed(&a.v)
+	ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
 	return int64(ulo) == lo && int64(uhi) == hi
 }
 
@@ -292,20 +535,16 @@ func (a *Int128) EqualRelaxed(lo, hi int64) bool {
 //
 //go:nosplit
 func (a *Int128) Less(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Acquire(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi < hi || (ahi == hi && uint64(alo) < uint64(lo))
+	ulo, uhi := arch.LoadUint128Acquire(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) < 0
 }
 
 // LessRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Int128) LessRelaxed(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Relaxed(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi < hi || (ahi == hi && uint64(alo) < uint64(lo))
+	ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) < 0
 }
 
 // LessOrEqual atomically loads and returns true if value <= (lo, hi).
@@ -313,20 +552,16 @@ func (a *Int128) LessRelaxed(lo, hi int64) bool {
 //
 //go:nosplit
 func (a *Int128) LessOrEqual(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Acquire(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi < hi || (ahi == hi && uint64(alo) <= uint64(lo))
+	ulo, uhi := arch.LoadUint128Acquire(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) <= 0
 }
 
 // LessOrEqualRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Int128) LessOrEqualRelaxed(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Relaxed(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi < hi || (ahi == hi && uint64(alo) <= uint64(lo))
+	ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) <= 0
 }
 
 // Greater atomically loads and returns true if value > (lo, hi).
@@ -334,20 +569,16 @@ func (a *Int128) LessOrEqualRelaxed(lo, hi int64) bool {
 //
 //go:nosplit
 func (a *Int128) Greater(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Acquire(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi > hi || (ahi == hi && uint64(alo) > uint64(lo))
+	ulo, uhi := arch.LoadUint128Acquire(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) > 0
 }
 
 // GreaterRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Int128) GreaterRelaxed(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Relaxed(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi > hi || (ahi == hi && uint64(alo) > uint64(lo))
+	ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) > 0
 }
 
 // GreaterOrEqual atomically loads and returns true if value >= (lo, hi).
@@ -355,18 +586,157 @@ func (a *Int128) GreaterRelaxed(lo, hi int64) bool {
 //
 //go:nosplit
 func (a *Int128) GreaterOrEqual(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Acquire(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi > hi || (ahi == hi && uint64(alo) >= uint64(lo))
+	ulo, uhi := arch.LoadUint128Acquire(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) >= 0
 }
 
 // GreaterOrEqualRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Int128) GreaterOrEqualRelaxed(lo, hi int64) bool {
-	ulo, uhi := arch.LoadUint128Relaxed(&a.v)
-	ahi := int64(uhi)
-	alo := int64(ulo)
-	return ahi > hi || (ahi == hi && uint64(alo) >= uint64(lo))
+	ulo, uhi := arch.LoadUint128Relaxed(a.v.slot())
+	return I128{ulo, int64(uhi)}.Cmp(I128{uint64(lo), hi}) >= 0
+}
+
+// LoadValue atomically loads and returns the value as an I128 with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) LoadValue() I128 {
+	lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+	return I128{lo, int64(hi)}
+}
+
+// LoadValueRelaxed atomically loads and returns the value as an I128 with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) LoadValueRelaxed() I128 {
+	lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+	return I128{lo, int64(hi)}
+}
+
+// LoadValueAcquire atomically loads and returns the value as an I128 with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) LoadValueAcquire() I128 {
+	lo, hi := arch.LoadUint128Acquire(a.v.slot())
+	return I128{lo, int64(hi)}
+}
+
+// StoreValue atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) StoreValue(val I128) {
+	arch.StoreUint128Relaxed(a.v.slot(), val.Lo, uint64(val.Hi))
+}
+
+// StoreValueRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) StoreValueRelaxed(val I128) {
+	arch.StoreUint128Relaxed(a.v.slot(), val.Lo, uint64(val.Hi))
+}
+
+// StoreValueRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Int128) StoreValueRelease(val I128) {
+	arch.StoreUint128Release(a.v.slot(), val.Lo, uint64(val.Hi))
+}
+
+// SwapValue atomically stores new and returns the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) SwapValue(new I128) I128 {
+	lo, hi := arch.SwapUint128AcqRel(a.v.slot(), new.Lo, uint64(new.Hi))
+	return I128{lo, int64(hi)}
+}
+
+// CompareAndSwapValue atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Int128) CompareAndSwapValue(old, new I128) bool {
+	return arch.CasUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi))
+}
+
+// CompareExchangeValue atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) CompareExchangeValue(old, new I128) I128 {
+	lo, hi := arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi))
+	return I128{lo, int64(hi)}
+}
+
+// AddValue atomically adds delta and returns the new value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) AddValue(delta I128) I128 {
+	lo, hi := a.Add(int64(delta.Lo), delta.Hi)
+	return I128{uint64(lo), hi}
+}
+
+// SubValue atomically subtracts delta and returns the new value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) SubValue(delta I128) I128 {
+	lo, hi := a.Sub(int64(delta.Lo), delta.Hi)
+	return I128{uint64(lo), hi}
+}
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Int128) Update(f func(old I128) I128) (old, new I128) {
+	return AcqRel.UpdateInt128(a, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Int128) UpdateRelaxed(f func(old I128) I128) (old, new I128) {
+	return Relaxed.UpdateInt128(a, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Int128) UpdateAcquire(f func(old I128) I128) (old, new I128) {
+	return Acquire.UpdateInt128(a, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Int128) UpdateRelease(f func(old I128) I128) (old, new I128) {
+	return Release.UpdateInt128(a, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Int128) UpdateAcqRel(f func(old I128) I128) (old, new I128) {
+	return AcqRel.UpdateInt128(a, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Int128) TryUpdate(f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	return AcqRel.TryUpdateInt128(a, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Int128) TryUpdateRelaxed(f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	return Relaxed.TryUpdateInt128(a, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Int128) TryUpdateAcquire(f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	return Acquire.TryUpdateInt128(a, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Int128) TryUpdateRelease(f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	return Release.TryUpdateInt128(a, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Int128) TryUpdateAcqRel(f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	return AcqRel.TryUpdateInt128(a, f)
 }
@@ -0,0 +1,393 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) Load() int16 {
+	return int16(arch.LoadUint16Relaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) LoadRelaxed() int16 {
+	return int16(arch.LoadUint16Relaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) LoadAcquire() int16 {
+	return int16(arch.LoadUint16Acquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) Store(val int16) {
+	arch.StoreUint16Relaxed(&a.v, uint16(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) StoreRelaxed(val int16) {
+	arch.StoreUint16Relaxed(&a.v, uint16(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Int16) StoreRelease(val int16) {
+	arch.StoreUint16Release(&a.v, uint16(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Swap(new int16) int16 {
+	return int16(arch.SwapUint16AcqRel(&a.v, uint16(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) SwapRelaxed(new int16) int16 {
+	return int16(arch.SwapUint16Relaxed(&a.v, uint16(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) SwapAcquire(new int16) int16 {
+	return int16(arch.SwapUint16Acquire(&a.v, uint16(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int16) SwapRelease(new int16) int16 {
+	return int16(arch.SwapUint16Release(&a.v, uint16(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) SwapAcqRel(new int16) int16 {
+	return int16(arch.SwapUint16AcqRel(&a.v, uint16(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Int16) CompareAndSwap(old, new int16) bool {
+	return arch.CasUint16AcqRel(&a.v, uint16(old), uint16(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) CompareAndSwapRelaxed(old, new int16) bool {
+	return arch.CasUint16Relaxed(&a.v, uint16(old), uint16(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) CompareAndSwapAcquire(old, new int16) bool {
+	return arch.CasUint16Acquire(&a.v, uint16(old), uint16(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Int16) CompareAndSwapRelease(old, new int16) bool {
+	return arch.CasUint16Release(&a.v, uint16(old), uint16(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) CompareAndSwapAcqRel(old, new int16) bool {
+	return arch.CasUint16AcqRel(&a.v, uint16(old), uint16(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) CompareExchange(old, new int16) int16 {
+	return int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) CompareExchangeRelaxed(old, new int16) int16 {
+	return int16(arch.CaxUint16Relaxed(&a.v, uint16(old), uint16(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) CompareExchangeAcquire(old, new int16) int16 {
+	return int16(arch.CaxUint16Acquire(&a.v, uint16(old), uint16(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Int16) CompareExchangeRelease(old, new int16) int16 {
+	return int16(arch.CaxUint16Release(&a.v, uint16(old), uint16(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) CompareExchangeAcqRel(old, new int16) int16 {
+	return int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Add(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, uint16(delta)))
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) AddRelaxed(delta int16) int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, uint16(delta)))
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) AddAcquire(delta int16) int16 {
+	return int16(arch.AddUint16Acquire(&a.v, uint16(delta)))
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int16) AddRelease(delta int16) int16 {
+	return int16(arch.AddUint16Release(&a.v, uint16(delta)))
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) AddAcqRel(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, uint16(delta)))
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Sub(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, -uint16(delta)))
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) SubRelaxed(delta int16) int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, -uint16(delta)))
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) SubAcquire(delta int16) int16 {
+	return int16(arch.AddUint16Acquire(&a.v, -uint16(delta)))
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int16) SubRelease(delta int16) int16 {
+	return int16(arch.AddUint16Release(&a.v, -uint16(delta)))
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) SubAcqRel(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, -uint16(delta)))
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) And(mask int16) int16 {
+	return int16(arch.AndUint16AcqRel(&a.v, uint16(mask)))
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) AndRelaxed(mask int16) int16 {
+	return int16(arch.AndUint16Relaxed(&a.v, uint16(mask)))
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) AndAcquire(mask int16) int16 {
+	return int16(arch.AndUint16Acquire(&a.v, uint16(mask)))
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Int16) AndRelease(mask int16) int16 {
+	return int16(arch.AndUint16Release(&a.v, uint16(mask)))
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) AndAcqRel(mask int16) int16 {
+	return int16(arch.AndUint16AcqRel(&a.v, uint16(mask)))
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Or(mask int16) int16 {
+	return int16(arch.OrUint16AcqRel(&a.v, uint16(mask)))
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) OrRelaxed(mask int16) int16 {
+	return int16(arch.OrUint16Relaxed(&a.v, uint16(mask)))
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) OrAcquire(mask int16) int16 {
+	return int16(arch.OrUint16Acquire(&a.v, uint16(mask)))
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Int16) OrRelease(mask int16) int16 {
+	return int16(arch.OrUint16Release(&a.v, uint16(mask)))
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) OrAcqRel(mask int16) int16 {
+	return int16(arch.OrUint16AcqRel(&a.v, uint16(mask)))
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Xor(mask int16) int16 {
+	return int16(arch.XorUint16AcqRel(&a.v, uint16(mask)))
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) XorRelaxed(mask int16) int16 {
+	return int16(arch.XorUint16Relaxed(&a.v, uint16(mask)))
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) XorAcquire(mask int16) int16 {
+	return int16(arch.XorUint16Acquire(&a.v, uint16(mask)))
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Int16) XorRelease(mask int16) int16 {
+	return int16(arch.XorUint16Release(&a.v, uint16(mask)))
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) XorAcqRel(mask int16) int16 {
+	return int16(arch.XorUint16AcqRel(&a.v, uint16(mask)))
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Max(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Relaxed(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) MaxRelaxed(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Relaxed(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16Relaxed(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Min(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Relaxed(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) MinRelaxed(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Relaxed(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16Relaxed(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
@@ -391,3 +391,57 @@ func (a *Int32) MinRelaxed(val int32) int32 {
 		}
 	}
 }
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Int32) Update(f func(old int32) int32) (old, new int32) {
+	return AcqRel.UpdateInt32(&a.v, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Int32) UpdateRelaxed(f func(old int32) int32) (old, new int32) {
+	return Relaxed.UpdateInt32(&a.v, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Int32) UpdateAcquire(f func(old int32) int32) (old, new int32) {
+	return Acquire.UpdateInt32(&a.v, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Int32) UpdateRelease(f func(old int32) int32) (old, new int32) {
+	return Release.UpdateInt32(&a.v, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Int32) UpdateAcqRel(f func(old int32) int32) (old, new int32) {
+	return AcqRel.UpdateInt32(&a.v, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Int32) TryUpdate(f func(old int32) (int32, bool)) (old, new int32, updated bool) {
+	return AcqRel.TryUpdateInt32(&a.v, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Int32) TryUpdateRelaxed(f func(old int32) (int32, bool)) (old, new int32, updated bool) {
+	return Relaxed.TryUpdateInt32(&a.v, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Int32) TryUpdateAcquire(f func(old int32) (int32, bool)) (old, new int32, updated bool) {
+	return Acquire.TryUpdateInt32(&a.v, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Int32) TryUpdateRelease(f func(old int32) (int32, bool)) (old, new int32, updated bool) {
+	return Release.TryUpdateInt32(&a.v, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Int32) TryUpdateAcqRel(f func(old int32) (int32, bool)) (old, new int32, updated bool) {
+	return AcqRel.TryUpdateInt32(&a.v, f)
+}
@@ -391,3 +391,57 @@ func (a *Int64) MinRelaxed(val int64) int64 {
 		}
 	}
 }
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Int64) Update(f func(old int64) int64) (old, new int64) {
+	return AcqRel.UpdateInt64(&a.v, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Int64) UpdateRelaxed(f func(old int64) int64) (old, new int64) {
+	return Relaxed.UpdateInt64(&a.v, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Int64) UpdateAcquire(f func(old int64) int64) (old, new int64) {
+	return Acquire.UpdateInt64(&a.v, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Int64) UpdateRelease(f func(old int64) int64) (old, new int64) {
+	return Release.UpdateInt64(&a.v, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Int64) UpdateAcqRel(f func(old int64) int64) (old, new int64) {
+	return AcqRel.UpdateInt64(&a.v, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Int64) TryUpdate(f func(old int64) (int64, bool)) (old, new int64, updated bool) {
+	return AcqRel.TryUpdateInt64(&a.v, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Int64) TryUpdateRelaxed(f func(old int64) (int64, bool)) (old, new int64, updated bool) {
+	return Relaxed.TryUpdateInt64(&a.v, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Int64) TryUpdateAcquire(f func(old int64) (int64, bool)) (old, new int64, updated bool) {
+	return Acquire.TryUpdateInt64(&a.v, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Int64) TryUpdateRelease(f func(old int64) (int64, bool)) (old, new int64, updated bool) {
+	return Release.TryUpdateInt64(&a.v, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Int64) TryUpdateAcqRel(f func(old int64) (int64, bool)) (old, new int64, updated bool) {
+	return AcqRel.TryUpdateInt64(&a.v, f)
+}
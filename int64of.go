@@ -0,0 +1,403 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Load() T {
+	return T(arch.LoadInt64Relaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) LoadRelaxed() T {
+	return T(arch.LoadInt64Relaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) LoadAcquire() T {
+	return T(arch.LoadInt64Acquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Store(val T) {
+	arch.StoreInt64Relaxed(&a.v, int64(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) StoreRelaxed(val T) {
+	arch.StoreInt64Relaxed(&a.v, int64(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) StoreRelease(val T) {
+	arch.StoreInt64Release(&a.v, int64(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Swap(new T) T {
+	return T(arch.SwapInt64AcqRel(&a.v, int64(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SwapRelaxed(new T) T {
+	return T(arch.SwapInt64Relaxed(&a.v, int64(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SwapAcquire(new T) T {
+	return T(arch.SwapInt64Acquire(&a.v, int64(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SwapRelease(new T) T {
+	return T(arch.SwapInt64Release(&a.v, int64(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SwapAcqRel(new T) T {
+	return T(arch.SwapInt64AcqRel(&a.v, int64(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareAndSwap(old, new T) bool {
+	return arch.CasInt64AcqRel(&a.v, int64(old), int64(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareAndSwapRelaxed(old, new T) bool {
+	return arch.CasInt64Relaxed(&a.v, int64(old), int64(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareAndSwapAcquire(old, new T) bool {
+	return arch.CasInt64Acquire(&a.v, int64(old), int64(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareAndSwapRelease(old, new T) bool {
+	return arch.CasInt64Release(&a.v, int64(old), int64(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareAndSwapAcqRel(old, new T) bool {
+	return arch.CasInt64AcqRel(&a.v, int64(old), int64(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareExchange(old, new T) T {
+	return T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareExchangeRelaxed(old, new T) T {
+	return T(arch.CaxInt64Relaxed(&a.v, int64(old), int64(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareExchangeAcquire(old, new T) T {
+	return T(arch.CaxInt64Acquire(&a.v, int64(old), int64(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareExchangeRelease(old, new T) T {
+	return T(arch.CaxInt64Release(&a.v, int64(old), int64(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareExchangeAcqRel(old, new T) T {
+	return T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Add(delta T) T {
+	return T(arch.AddInt64AcqRel(&a.v, int64(delta)))
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddRelaxed(delta T) T {
+	return T(arch.AddInt64Relaxed(&a.v, int64(delta)))
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddAcquire(delta T) T {
+	return T(arch.AddInt64Acquire(&a.v, int64(delta)))
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddRelease(delta T) T {
+	return T(arch.AddInt64Release(&a.v, int64(delta)))
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddAcqRel(delta T) T {
+	return T(arch.AddInt64AcqRel(&a.v, int64(delta)))
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Sub(delta T) T {
+	return T(arch.AddInt64AcqRel(&a.v, -int64(delta)))
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubRelaxed(delta T) T {
+	return T(arch.AddInt64Relaxed(&a.v, -int64(delta)))
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubAcquire(delta T) T {
+	return T(arch.AddInt64Acquire(&a.v, -int64(delta)))
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubRelease(delta T) T {
+	return T(arch.AddInt64Release(&a.v, -int64(delta)))
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubAcqRel(delta T) T {
+	return T(arch.AddInt64AcqRel(&a.v, -int64(delta)))
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) And(mask T) T {
+	return T(arch.AndInt64AcqRel(&a.v, int64(mask)))
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AndRelaxed(mask T) T {
+	return T(arch.AndInt64Relaxed(&a.v, int64(mask)))
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AndAcquire(mask T) T {
+	return T(arch.AndInt64Acquire(&a.v, int64(mask)))
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AndRelease(mask T) T {
+	return T(arch.AndInt64Release(&a.v, int64(mask)))
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) AndAcqRel(mask T) T {
+	return T(arch.AndInt64AcqRel(&a.v, int64(mask)))
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Or(mask T) T {
+	return T(arch.OrInt64AcqRel(&a.v, int64(mask)))
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) OrRelaxed(mask T) T {
+	return T(arch.OrInt64Relaxed(&a.v, int64(mask)))
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) OrAcquire(mask T) T {
+	return T(arch.OrInt64Acquire(&a.v, int64(mask)))
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) OrRelease(mask T) T {
+	return T(arch.OrInt64Release(&a.v, int64(mask)))
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) OrAcqRel(mask T) T {
+	return T(arch.OrInt64AcqRel(&a.v, int64(mask)))
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Xor(mask T) T {
+	return T(arch.XorInt64AcqRel(&a.v, int64(mask)))
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) XorRelaxed(mask T) T {
+	return T(arch.XorInt64Relaxed(&a.v, int64(mask)))
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) XorAcquire(mask T) T {
+	return T(arch.XorInt64Acquire(&a.v, int64(mask)))
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) XorRelease(mask T) T {
+	return T(arch.XorInt64Release(&a.v, int64(mask)))
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) XorAcqRel(mask T) T {
+	return T(arch.XorInt64AcqRel(&a.v, int64(mask)))
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Max(val T) T {
+	return T(AcqRel.MaxInt64(&a.v, int64(val)))
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MaxRelaxed(val T) T {
+	return T(Relaxed.MaxInt64(&a.v, int64(val)))
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MaxAcquire(val T) T {
+	return T(Acquire.MaxInt64(&a.v, int64(val)))
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MaxRelease(val T) T {
+	return T(Release.MaxInt64(&a.v, int64(val)))
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MaxAcqRel(val T) T {
+	return T(AcqRel.MaxInt64(&a.v, int64(val)))
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Min(val T) T {
+	return T(AcqRel.MinInt64(&a.v, int64(val)))
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MinRelaxed(val T) T {
+	return T(Relaxed.MinInt64(&a.v, int64(val)))
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MinAcquire(val T) T {
+	return T(Acquire.MinInt64(&a.v, int64(val)))
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MinRelease(val T) T {
+	return T(Release.MinInt64(&a.v, int64(val)))
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) MinAcqRel(val T) T {
+	return T(AcqRel.MinInt64(&a.v, int64(val)))
+}
@@ -0,0 +1,393 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) Load() int8 {
+	return int8(arch.LoadUint8Relaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) LoadRelaxed() int8 {
+	return int8(arch.LoadUint8Relaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) LoadAcquire() int8 {
+	return int8(arch.LoadUint8Acquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) Store(val int8) {
+	arch.StoreUint8Relaxed(&a.v, uint8(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) StoreRelaxed(val int8) {
+	arch.StoreUint8Relaxed(&a.v, uint8(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Int8) StoreRelease(val int8) {
+	arch.StoreUint8Release(&a.v, uint8(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Swap(new int8) int8 {
+	return int8(arch.SwapUint8AcqRel(&a.v, uint8(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) SwapRelaxed(new int8) int8 {
+	return int8(arch.SwapUint8Relaxed(&a.v, uint8(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) SwapAcquire(new int8) int8 {
+	return int8(arch.SwapUint8Acquire(&a.v, uint8(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int8) SwapRelease(new int8) int8 {
+	return int8(arch.SwapUint8Release(&a.v, uint8(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) SwapAcqRel(new int8) int8 {
+	return int8(arch.SwapUint8AcqRel(&a.v, uint8(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Int8) CompareAndSwap(old, new int8) bool {
+	return arch.CasUint8AcqRel(&a.v, uint8(old), uint8(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) CompareAndSwapRelaxed(old, new int8) bool {
+	return arch.CasUint8Relaxed(&a.v, uint8(old), uint8(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) CompareAndSwapAcquire(old, new int8) bool {
+	return arch.CasUint8Acquire(&a.v, uint8(old), uint8(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Int8) CompareAndSwapRelease(old, new int8) bool {
+	return arch.CasUint8Release(&a.v, uint8(old), uint8(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) CompareAndSwapAcqRel(old, new int8) bool {
+	return arch.CasUint8AcqRel(&a.v, uint8(old), uint8(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) CompareExchange(old, new int8) int8 {
+	return int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) CompareExchangeRelaxed(old, new int8) int8 {
+	return int8(arch.CaxUint8Relaxed(&a.v, uint8(old), uint8(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) CompareExchangeAcquire(old, new int8) int8 {
+	return int8(arch.CaxUint8Acquire(&a.v, uint8(old), uint8(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Int8) CompareExchangeRelease(old, new int8) int8 {
+	return int8(arch.CaxUint8Release(&a.v, uint8(old), uint8(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) CompareExchangeAcqRel(old, new int8) int8 {
+	return int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Add(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, uint8(delta)))
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) AddRelaxed(delta int8) int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, uint8(delta)))
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) AddAcquire(delta int8) int8 {
+	return int8(arch.AddUint8Acquire(&a.v, uint8(delta)))
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int8) AddRelease(delta int8) int8 {
+	return int8(arch.AddUint8Release(&a.v, uint8(delta)))
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) AddAcqRel(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, uint8(delta)))
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Sub(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, -uint8(delta)))
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) SubRelaxed(delta int8) int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, -uint8(delta)))
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) SubAcquire(delta int8) int8 {
+	return int8(arch.AddUint8Acquire(&a.v, -uint8(delta)))
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int8) SubRelease(delta int8) int8 {
+	return int8(arch.AddUint8Release(&a.v, -uint8(delta)))
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) SubAcqRel(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, -uint8(delta)))
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) And(mask int8) int8 {
+	return int8(arch.AndUint8AcqRel(&a.v, uint8(mask)))
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) AndRelaxed(mask int8) int8 {
+	return int8(arch.AndUint8Relaxed(&a.v, uint8(mask)))
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) AndAcquire(mask int8) int8 {
+	return int8(arch.AndUint8Acquire(&a.v, uint8(mask)))
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Int8) AndRelease(mask int8) int8 {
+	return int8(arch.AndUint8Release(&a.v, uint8(mask)))
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) AndAcqRel(mask int8) int8 {
+	return int8(arch.AndUint8AcqRel(&a.v, uint8(mask)))
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Or(mask int8) int8 {
+	return int8(arch.OrUint8AcqRel(&a.v, uint8(mask)))
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) OrRelaxed(mask int8) int8 {
+	return int8(arch.OrUint8Relaxed(&a.v, uint8(mask)))
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) OrAcquire(mask int8) int8 {
+	return int8(arch.OrUint8Acquire(&a.v, uint8(mask)))
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Int8) OrRelease(mask int8) int8 {
+	return int8(arch.OrUint8Release(&a.v, uint8(mask)))
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) OrAcqRel(mask int8) int8 {
+	return int8(arch.OrUint8AcqRel(&a.v, uint8(mask)))
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Xor(mask int8) int8 {
+	return int8(arch.XorUint8AcqRel(&a.v, uint8(mask)))
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) XorRelaxed(mask int8) int8 {
+	return int8(arch.XorUint8Relaxed(&a.v, uint8(mask)))
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) XorAcquire(mask int8) int8 {
+	return int8(arch.XorUint8Acquire(&a.v, uint8(mask)))
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Int8) XorRelease(mask int8) int8 {
+	return int8(arch.XorUint8Release(&a.v, uint8(mask)))
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) XorAcqRel(mask int8) int8 {
+	return int8(arch.XorUint8AcqRel(&a.v, uint8(mask)))
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Max(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Relaxed(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) MaxRelaxed(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Relaxed(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8Relaxed(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Min(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Relaxed(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) MinRelaxed(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Relaxed(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8Relaxed(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
@@ -5,6 +5,10 @@
 package arch_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"runtime"
+	"sync"
 	"testing"
 	"unsafe"
 
@@ -847,6 +851,42 @@ func TestCaxUint128(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// 128-bit Bitwise Tests
+// =============================================================================
+
+func TestBitwiseUint128(t *testing.T) {
+	type rmw func(addr *[16]byte, lo, hi uint64) (uint64, uint64)
+	tests := []struct {
+		name string
+		fns  [4]rmw
+		ref  func(x, y uint64) uint64
+	}{
+		{"And", [4]rmw{arch.AndUint128Relaxed, arch.AndUint128Acquire, arch.AndUint128Release, arch.AndUint128AcqRel},
+			func(x, y uint64) uint64 { return x & y }},
+		{"AndNot", [4]rmw{arch.AndNotUint128Relaxed, arch.AndNotUint128Acquire, arch.AndNotUint128Release, arch.AndNotUint128AcqRel},
+			func(x, y uint64) uint64 { return x &^ y }},
+		{"Or", [4]rmw{arch.OrUint128Relaxed, arch.OrUint128Acquire, arch.OrUint128Release, arch.OrUint128AcqRel},
+			func(x, y uint64) uint64 { return x | y }},
+	}
+	const lo0, hi0 uint64 = 0xFFFF_0000_AAAA_5555, 0x0F0F_0F0F_F0F0_F0F0
+	const lo1, hi1 uint64 = 0x00FF_00FF_FFFF_0000, 0xFFFF_FFFF_0000_0000
+	v := newAligned16()
+	for _, tt := range tests {
+		for i, fn := range tt.fns {
+			arch.StoreUint128Relaxed(v, lo0, hi0)
+			oldLo, oldHi := fn(v, lo1, hi1)
+			if oldLo != lo0 || oldHi != hi0 {
+				t.Fatalf("%s[%d]: old = (%#x, %#x)", tt.name, i, oldLo, oldHi)
+			}
+			lo, hi := arch.LoadUint128Relaxed(v)
+			if lo != tt.ref(lo0, lo1) || hi != tt.ref(hi0, hi1) {
+				t.Fatalf("%s[%d]: got (%#x, %#x), want (%#x, %#x)", tt.name, i, lo, hi, tt.ref(lo0, lo1), tt.ref(hi0, hi1))
+			}
+		}
+	}
+}
+
 // =============================================================================
 // Barrier Tests
 // =============================================================================
@@ -856,4 +896,272 @@ func TestBarriers(t *testing.T) {
 	arch.BarrierAcquire()
 	arch.BarrierRelease()
 	arch.BarrierAcqRel()
+	arch.BarrierSeqCst()
+	arch.BarrierLoadLoad()
+	arch.BarrierLoadStore()
+	arch.BarrierStoreStore()
+	arch.BarrierStoreLoad()
+	arch.BarrierNonTemporal()
+	arch.BarrierCompiler()
+}
+
+// barrierInsns lists, per GOARCH, the fence instruction each assembly
+// barrier must start with. x86-64 barriers absent from the list are compiler
+// barriers only.
+var barrierInsns = map[string][]struct {
+	name  string
+	fn    func()
+	fence []byte
+}{
+	"amd64": {
+		{"AcqRel", arch.BarrierAcqRel, []byte{0x0F, 0xAE, 0xF0}}, // MFENCE
+		{"SeqCst", arch.BarrierSeqCst, []byte{0x0F, 0xAE, 0xF0}},
+		{"StoreLoad", arch.BarrierStoreLoad, []byte{0x0F, 0xAE, 0xF0}},
+		{"NonTemporal", arch.BarrierNonTemporal, []byte{0x0F, 0xAE, 0xF8}}, // SFENCE
+	},
+	"arm64": {
+		{"Acquire", arch.BarrierAcquire, insn(0xD50339BF)}, // DMB ISHLD
+		{"Release", arch.BarrierRelease, insn(0xD5033ABF)}, // DMB ISHST
+		{"AcqRel", arch.BarrierAcqRel, insn(0xD5033BBF)},   // DMB ISH
+		{"SeqCst", arch.BarrierSeqCst, insn(0xD5033BBF)},
+		{"LoadLoad", arch.BarrierLoadLoad, insn(0xD50339BF)},
+		{"LoadStore", arch.BarrierLoadStore, insn(0xD50339BF)},
+		{"StoreStore", arch.BarrierStoreStore, insn(0xD5033ABF)},
+		{"StoreLoad", arch.BarrierStoreLoad, insn(0xD5033BBF)},
+		{"NonTemporal", arch.BarrierNonTemporal, insn(0xD5033ABF)},
+	},
+	"riscv64": {
+		{"Acquire", arch.BarrierAcquire, insn(0x0FF0000F)}, // FENCE rw,rw
+		{"Release", arch.BarrierRelease, insn(0x0FF0000F)},
+		{"AcqRel", arch.BarrierAcqRel, insn(0x0FF0000F)},
+		{"SeqCst", arch.BarrierSeqCst, insn(0x0FF0000F)},
+		{"LoadLoad", arch.BarrierLoadLoad, insn(0x0220000F)},     // FENCE r,r
+		{"LoadStore", arch.BarrierLoadStore, insn(0x0210000F)},   // FENCE r,w
+		{"StoreStore", arch.BarrierStoreStore, insn(0x0110000F)}, // FENCE w,w
+		{"StoreLoad", arch.BarrierStoreLoad, insn(0x0120000F)},   // FENCE w,r
+		{"NonTemporal", arch.BarrierNonTemporal, insn(0x0110000F)},
+	},
+	"loong64": {
+		{"Acquire", arch.BarrierAcquire, insn(0x38720014)}, // DBAR 0x14
+		{"Release", arch.BarrierRelease, insn(0x38720012)}, // DBAR 0x12
+		{"AcqRel", arch.BarrierAcqRel, insn(0x38720000)},   // DBAR 0
+		{"SeqCst", arch.BarrierSeqCst, insn(0x38720000)},
+		{"LoadLoad", arch.BarrierLoadLoad, insn(0x38720015)},
+		{"LoadStore", arch.BarrierLoadStore, insn(0x38720016)},
+		{"StoreStore", arch.BarrierStoreStore, insn(0x3872001A)},
+		{"StoreLoad", arch.BarrierStoreLoad, insn(0x38720019)},
+		{"NonTemporal", arch.BarrierNonTemporal, insn(0x3872001A)},
+	},
+}
+
+func insn(w uint32) []byte {
+	return binary.LittleEndian.AppendUint32(nil, w)
+}
+
+// barrierCode returns the first bytes of the assembly body of fn. A Go func
+// value of an assembly function points at an ABI wrapper that calls or jumps
+// to the body; barrierCode follows that branch.
+func barrierCode(t *testing.T, fn func()) []byte {
+	t.Helper()
+	const n = 16
+	pc := **(**unsafe.Pointer)(unsafe.Pointer(&fn))
+	for range 8 {
+		code := (*[n]byte)(pc)[:]
+		w := binary.LittleEndian.Uint32(code)
+		var off int64
+		switch runtime.GOARCH {
+		case "amd64":
+			i := bytes.IndexByte(code, 0xE8) // CALL rel32
+			if i < 0 || i+5 > n {
+				return code
+			}
+			off = int64(i+5) + int64(int32(binary.LittleEndian.Uint32(code[i+1:])))
+		case "arm64":
+			if w&0x7C000000 != 0x14000000 { // B, BL
+				return code
+			}
+			off = int64(int32(w<<6)>>6) * 4
+		case "riscv64":
+			if w&0x7F != 0x6F { // JAL
+				return code
+			}
+			imm := (w>>31)<<20 | (w>>12&0xFF)<<12 | (w>>20&1)<<11 | (w>>21&0x3FF)<<1
+			off = int64(int32(imm<<11) >> 11)
+		case "loong64":
+			if w&0xF8000000 != 0x50000000 { // B, BL
+				return code
+			}
+			imm := (w&0x3FF)<<16 | w>>10&0xFFFF
+			off = int64(int32(imm<<6)>>6) * 4
+		}
+		pc = unsafe.Add(pc, off)
+	}
+	t.Fatal("no assembly body behind the ABI wrapper")
+	return nil
+}
+
+// TestBarrierInstructions checks that the barriers execute their fence
+// instructions, in race builds as well as release builds.
+func TestBarrierInstructions(t *testing.T) {
+	insns, ok := barrierInsns[runtime.GOARCH]
+	if !ok {
+		t.Skipf("no assembly barriers on %s", runtime.GOARCH)
+	}
+	for _, b := range insns {
+		if code := barrierCode(t, b.fn); !bytes.HasPrefix(code, b.fence) {
+			t.Errorf("Barrier%s starts with % x, want % x", b.name, code[:len(b.fence)], b.fence)
+		}
+	}
+}
+
+// =============================================================================
+// Single-bit Tests
+// =============================================================================
+
+func TestBitOps(t *testing.T) {
+	// The bit index wraps at the operand width; neighbouring words must not
+	// be touched even for large indices.
+	var w [3]uint32
+	if arch.BitSetUint32AcqRel(&w[1], 32+31) || w != [3]uint32{0, 1 << 31, 0} {
+		t.Fatalf("BitSetUint32: got %#x", w)
+	}
+	if !arch.BitToggleUint32Relaxed(&w[1], 31) || arch.BitClearUint32Release(&w[1], 0) || w[1] != 0 {
+		t.Fatalf("BitToggle/BitClearUint32: got %#x", w)
+	}
+	var d [3]uint64
+	if arch.BitSetUint64Acquire(&d[1], 64*5+63) || d != [3]uint64{0, 1 << 63, 0} {
+		t.Fatalf("BitSetUint64: got %#x", d)
+	}
+	if !arch.BitClearUint64AcqRel(&d[1], 63) || arch.BitToggleUint64Relaxed(&d[1], 1) || d[1] != 2 {
+		t.Fatalf("BitClear/BitToggleUint64: got %#x", d)
+	}
+	var p uintptr
+	if arch.BitSetUintptrRelaxed(&p, 2) || !arch.BitToggleUintptrAcquire(&p, 2) || arch.BitClearUintptrRelease(&p, 2) || p != 0 {
+		t.Fatalf("Uintptr bit ops: got %#x", p)
+	}
+}
+
+// =============================================================================
+// 8-bit and 16-bit Tests
+// =============================================================================
+
+func TestSubwordUint8(t *testing.T) {
+	var w [8]uint8
+	for i := range w {
+		w[i] = 0xA0 + uint8(i)
+	}
+	for i := range w {
+		p := &w[i]
+		init := *p
+		arch.StoreUint8Relaxed(p, 0x10)
+		arch.StoreUint8Release(p, 0x11)
+		if got := arch.LoadUint8Acquire(p); got != 0x11 {
+			t.Fatalf("[%d] LoadUint8Acquire: got %#x, want 0x11", i, got)
+		}
+		if old := arch.SwapUint8AcqRel(p, 0x22); old != 0x11 {
+			t.Fatalf("[%d] SwapUint8AcqRel: got %#x, want 0x11", i, old)
+		}
+		if arch.CasUint8Relaxed(p, 0x11, 0x33) {
+			t.Fatalf("[%d] CasUint8Relaxed: should fail", i)
+		}
+		if !arch.CasUint8Release(p, 0x22, 0x33) {
+			t.Fatalf("[%d] CasUint8Release: should succeed", i)
+		}
+		if prev := arch.CaxUint8Acquire(p, 0x33, 0xFF); prev != 0x33 {
+			t.Fatalf("[%d] CaxUint8Acquire: got %#x, want 0x33", i, prev)
+		}
+		if got := arch.AddUint8Relaxed(p, 2); got != 0x01 {
+			t.Fatalf("[%d] AddUint8Relaxed wraparound: got %#x, want 0x01", i, got)
+		}
+		if old := arch.OrUint8AcqRel(p, 0xF0); old != 0x01 {
+			t.Fatalf("[%d] OrUint8AcqRel: got %#x, want 0x01", i, old)
+		}
+		if old := arch.AndUint8Acquire(p, 0x3C); old != 0xF1 {
+			t.Fatalf("[%d] AndUint8Acquire: got %#x, want 0xF1", i, old)
+		}
+		if old := arch.XorUint8Release(p, 0xFF); old != 0x30 {
+			t.Fatalf("[%d] XorUint8Release: got %#x, want 0x30", i, old)
+		}
+		if got := arch.LoadUint8Relaxed(p); got != 0xCF {
+			t.Fatalf("[%d] LoadUint8Relaxed: got %#x, want 0xCF", i, got)
+		}
+		arch.StoreUint8Relaxed(p, init)
+		for j := range w {
+			if w[j] != 0xA0+uint8(j) {
+				t.Fatalf("[%d] neighbour %d clobbered: got %#x", i, j, w[j])
+			}
+		}
+	}
+}
+
+func TestSubwordUint16(t *testing.T) {
+	var w [4]uint16
+	for i := range w {
+		w[i] = 0xA000 + uint16(i)
+	}
+	for i := range w {
+		p := &w[i]
+		init := *p
+		arch.StoreUint16Release(p, 0x1111)
+		if got := arch.LoadUint16Acquire(p); got != 0x1111 {
+			t.Fatalf("[%d] LoadUint16Acquire: got %#x, want 0x1111", i, got)
+		}
+		if old := arch.SwapUint16Relaxed(p, 0x2222); old != 0x1111 {
+			t.Fatalf("[%d] SwapUint16Relaxed: got %#x, want 0x1111", i, old)
+		}
+		if !arch.CasUint16AcqRel(p, 0x2222, 0xFFFF) {
+			t.Fatalf("[%d] CasUint16AcqRel: should succeed", i)
+		}
+		if prev := arch.CaxUint16Relaxed(p, 0, 1); prev != 0xFFFF {
+			t.Fatalf("[%d] CaxUint16Relaxed failure: got %#x, want 0xFFFF", i, prev)
+		}
+		if got := arch.AddUint16AcqRel(p, 1); got != 0 {
+			t.Fatalf("[%d] AddUint16AcqRel wraparound: got %#x, want 0", i, got)
+		}
+		if old := arch.OrUint16Relaxed(p, 0x0F0F); old != 0 {
+			t.Fatalf("[%d] OrUint16Relaxed: got %#x, want 0", i, old)
+		}
+		if old := arch.AndUint16Release(p, 0x00FF); old != 0x0F0F {
+			t.Fatalf("[%d] AndUint16Release: got %#x, want 0x0F0F", i, old)
+		}
+		if old := arch.XorUint16Acquire(p, 0xFFFF); old != 0x000F {
+			t.Fatalf("[%d] XorUint16Acquire: got %#x, want 0x000F", i, old)
+		}
+		if got := arch.LoadUint16Relaxed(p); got != 0xFFF0 {
+			t.Fatalf("[%d] LoadUint16Relaxed: got %#x, want 0xFFF0", i, got)
+		}
+		arch.StoreUint16Relaxed(p, init)
+		for j := range w {
+			if w[j] != 0xA000+uint16(j) {
+				t.Fatalf("[%d] neighbour %d clobbered: got %#x", i, j, w[j])
+			}
+		}
+	}
+}
+
+func TestSubwordUint8ConcurrentNeighbours(t *testing.T) {
+	const iterations = 10000
+	var w [4]uint8
+	var wg sync.WaitGroup
+	for i := range w {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				arch.AddUint8AcqRel(&w[i], 1)
+			}
+		}()
+	}
+	wg.Wait()
+	for i := range w {
+		if got := arch.LoadUint8Relaxed(&w[i]); got != uint8(iterations%256) {
+			t.Fatalf("byte %d: got %d, want %d", i, got, iterations%256)
+		}
+	}
+}
+
+func TestSpinWait(t *testing.T) {
+	for _, n := range []uint32{0, 1, 64} {
+		arch.SpinWait(n)
+	}
 }
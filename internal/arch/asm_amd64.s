@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build amd64
+//go:build amd64 && !race
 
 #include "textflag.h"
 
@@ -329,48 +329,6 @@ TEXT ·AddUintptrRelease(SB), NOSPLIT, $0-24
 TEXT ·AddUintptrAcqRel(SB), NOSPLIT, $0-24
 	JMP	·AddInt64AcqRel(SB)
 
-// =============================================================================
-// Pointer operations (aliases to 64-bit on amd64)
-// =============================================================================
-//
-// Load/Store operations are implemented as pure Go in loadstore_amd64.go
-
-TEXT ·SwapPointerRelaxed(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64AcqRel(SB)
-
-TEXT ·SwapPointerAcquire(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64AcqRel(SB)
-
-TEXT ·SwapPointerRelease(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64AcqRel(SB)
-
-TEXT ·SwapPointerAcqRel(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64AcqRel(SB)
-
-TEXT ·CasPointerRelaxed(SB), NOSPLIT, $0-25
-	JMP	·CasInt64AcqRel(SB)
-
-TEXT ·CasPointerAcquire(SB), NOSPLIT, $0-25
-	JMP	·CasInt64AcqRel(SB)
-
-TEXT ·CasPointerRelease(SB), NOSPLIT, $0-25
-	JMP	·CasInt64AcqRel(SB)
-
-TEXT ·CasPointerAcqRel(SB), NOSPLIT, $0-25
-	JMP	·CasInt64AcqRel(SB)
-
-TEXT ·CaxPointerRelaxed(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64AcqRel(SB)
-
-TEXT ·CaxPointerAcquire(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64AcqRel(SB)
-
-TEXT ·CaxPointerRelease(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64AcqRel(SB)
-
-TEXT ·CaxPointerAcqRel(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64AcqRel(SB)
-
 // =============================================================================
 // 128-bit operations
 // =============================================================================
@@ -752,18 +710,445 @@ TEXT ·XorUintptrAcqRel(SB), NOSPLIT, $0-24
 	JMP	·XorInt64AcqRel(SB)
 
 // =============================================================================
-// Barrier operations
+// 8-bit and 16-bit operations
 // =============================================================================
+//
+// x86-64 has byte and word forms of XCHG, CMPXCHG and XADD. And/Or/Xor use
+// CMPXCHG loops as for 32/64-bit. All orderings JMP to the AcqRel version.
+
+// 8-bit
+
+TEXT ·SwapUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), BX
+	MOVB	new+8(FP), AX
+	XCHGB	AX, (BX)
+	MOVB	AX, ret+16(FP)
+	RET
+
+TEXT ·SwapUint8Relaxed(SB), NOSPLIT, $0-17
+	JMP	·SwapUint8AcqRel(SB)
+
+TEXT ·SwapUint8Acquire(SB), NOSPLIT, $0-17
+	JMP	·SwapUint8AcqRel(SB)
+
+TEXT ·SwapUint8Release(SB), NOSPLIT, $0-17
+	JMP	·SwapUint8AcqRel(SB)
+
+TEXT ·CasUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), BX
+	MOVB	old+8(FP), AX
+	MOVB	new+9(FP), CX
+	LOCK
+	CMPXCHGB	CX, (BX)
+	SETEQ	ret+16(FP)
+	RET
+
+TEXT ·CasUint8Relaxed(SB), NOSPLIT, $0-17
+	JMP	·CasUint8AcqRel(SB)
+
+TEXT ·CasUint8Acquire(SB), NOSPLIT, $0-17
+	JMP	·CasUint8AcqRel(SB)
 
-// On x86-64 TSO, acquire and release are compiler barriers only.
-// MFENCE is only needed for sequential consistency.
-TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
+TEXT ·CasUint8Release(SB), NOSPLIT, $0-17
+	JMP	·CasUint8AcqRel(SB)
+
+TEXT ·CaxUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), BX
+	MOVB	old+8(FP), AX
+	MOVB	new+9(FP), CX
+	LOCK
+	CMPXCHGB	CX, (BX)
+	MOVB	AX, ret+16(FP)
+	RET
+
+TEXT ·CaxUint8Relaxed(SB), NOSPLIT, $0-17
+	JMP	·CaxUint8AcqRel(SB)
+
+TEXT ·CaxUint8Acquire(SB), NOSPLIT, $0-17
+	JMP	·CaxUint8AcqRel(SB)
+
+TEXT ·CaxUint8Release(SB), NOSPLIT, $0-17
+	JMP	·CaxUint8AcqRel(SB)
+
+TEXT ·AddUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), BX
+	MOVB	delta+8(FP), AX
+	MOVB	AX, CX
+	LOCK
+	XADDB	AX, (BX)
+	ADDB	CX, AX
+	MOVB	AX, ret+16(FP)
+	RET
+
+TEXT ·AddUint8Relaxed(SB), NOSPLIT, $0-17
+	JMP	·AddUint8AcqRel(SB)
+
+TEXT ·AddUint8Acquire(SB), NOSPLIT, $0-17
+	JMP	·AddUint8AcqRel(SB)
+
+TEXT ·AddUint8Release(SB), NOSPLIT, $0-17
+	JMP	·AddUint8AcqRel(SB)
+
+TEXT ·AndUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), DX
+	MOVBLZX	mask+8(FP), SI
+and8_retry:
+	MOVBLZX	(DX), AX
+	MOVB	AX, CX
+	ANDB	SI, CX
+	LOCK
+	CMPXCHGB	CX, (DX)
+	JNE	and8_retry
+	MOVB	AX, ret+16(FP)
+	RET
+
+TEXT ·AndUint8Relaxed(SB), NOSPLIT, $0-17
+	JMP	·AndUint8AcqRel(SB)
+
+TEXT ·AndUint8Acquire(SB), NOSPLIT, $0-17
+	JMP	·AndUint8AcqRel(SB)
+
+TEXT ·AndUint8Release(SB), NOSPLIT, $0-17
+	JMP	·AndUint8AcqRel(SB)
+
+TEXT ·OrUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), DX
+	MOVBLZX	mask+8(FP), SI
+or8_retry:
+	MOVBLZX	(DX), AX
+	MOVB	AX, CX
+	ORB	SI, CX
+	LOCK
+	CMPXCHGB	CX, (DX)
+	JNE	or8_retry
+	MOVB	AX, ret+16(FP)
 	RET
 
-TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
+TEXT ·OrUint8Relaxed(SB), NOSPLIT, $0-17
+	JMP	·OrUint8AcqRel(SB)
+
+TEXT ·OrUint8Acquire(SB), NOSPLIT, $0-17
+	JMP	·OrUint8AcqRel(SB)
+
+TEXT ·OrUint8Release(SB), NOSPLIT, $0-17
+	JMP	·OrUint8AcqRel(SB)
+
+TEXT ·XorUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), DX
+	MOVBLZX	mask+8(FP), SI
+xor8_retry:
+	MOVBLZX	(DX), AX
+	MOVB	AX, CX
+	XORB	SI, CX
+	LOCK
+	CMPXCHGB	CX, (DX)
+	JNE	xor8_retry
+	MOVB	AX, ret+16(FP)
 	RET
 
-// Full fence using MFENCE
-TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
-	MFENCE
+TEXT ·XorUint8Relaxed(SB), NOSPLIT, $0-17
+	JMP	·XorUint8AcqRel(SB)
+
+TEXT ·XorUint8Acquire(SB), NOSPLIT, $0-17
+	JMP	·XorUint8AcqRel(SB)
+
+TEXT ·XorUint8Release(SB), NOSPLIT, $0-17
+	JMP	·XorUint8AcqRel(SB)
+
+// 16-bit
+
+TEXT ·SwapUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVQ	addr+0(FP), BX
+	MOVW	new+8(FP), AX
+	XCHGW	AX, (BX)
+	MOVW	AX, ret+16(FP)
 	RET
+
+TEXT ·SwapUint16Relaxed(SB), NOSPLIT, $0-18
+	JMP	·SwapUint16AcqRel(SB)
+
+TEXT ·SwapUint16Acquire(SB), NOSPLIT, $0-18
+	JMP	·SwapUint16AcqRel(SB)
+
+TEXT ·SwapUint16Release(SB), NOSPLIT, $0-18
+	JMP	·SwapUint16AcqRel(SB)
+
+TEXT ·CasUint16AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), BX
+	MOVW	old+8(FP), AX
+	MOVW	new+10(FP), CX
+	LOCK
+	CMPXCHGW	CX, (BX)
+	SETEQ	ret+16(FP)
+	RET
+
+TEXT ·CasUint16Relaxed(SB), NOSPLIT, $0-17
+	JMP	·CasUint16AcqRel(SB)
+
+TEXT ·CasUint16Acquire(SB), NOSPLIT, $0-17
+	JMP	·CasUint16AcqRel(SB)
+
+TEXT ·CasUint16Release(SB), NOSPLIT, $0-17
+	JMP	·CasUint16AcqRel(SB)
+
+TEXT ·CaxUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVQ	addr+0(FP), BX
+	MOVW	old+8(FP), AX
+	MOVW	new+10(FP), CX
+	LOCK
+	CMPXCHGW	CX, (BX)
+	MOVW	AX, ret+16(FP)
+	RET
+
+TEXT ·CaxUint16Relaxed(SB), NOSPLIT, $0-18
+	JMP	·CaxUint16AcqRel(SB)
+
+TEXT ·CaxUint16Acquire(SB), NOSPLIT, $0-18
+	JMP	·CaxUint16AcqRel(SB)
+
+TEXT ·CaxUint16Release(SB), NOSPLIT, $0-18
+	JMP	·CaxUint16AcqRel(SB)
+
+TEXT ·AddUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVQ	addr+0(FP), BX
+	MOVW	delta+8(FP), AX
+	MOVW	AX, CX
+	LOCK
+	XADDW	AX, (BX)
+	ADDW	CX, AX
+	MOVW	AX, ret+16(FP)
+	RET
+
+TEXT ·AddUint16Relaxed(SB), NOSPLIT, $0-18
+	JMP	·AddUint16AcqRel(SB)
+
+TEXT ·AddUint16Acquire(SB), NOSPLIT, $0-18
+	JMP	·AddUint16AcqRel(SB)
+
+TEXT ·AddUint16Release(SB), NOSPLIT, $0-18
+	JMP	·AddUint16AcqRel(SB)
+
+TEXT ·AndUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVQ	addr+0(FP), DX
+	MOVWLZX	mask+8(FP), SI
+and16_retry:
+	MOVWLZX	(DX), AX
+	MOVW	AX, CX
+	ANDW	SI, CX
+	LOCK
+	CMPXCHGW	CX, (DX)
+	JNE	and16_retry
+	MOVW	AX, ret+16(FP)
+	RET
+
+TEXT ·AndUint16Relaxed(SB), NOSPLIT, $0-18
+	JMP	·AndUint16AcqRel(SB)
+
+TEXT ·AndUint16Acquire(SB), NOSPLIT, $0-18
+	JMP	·AndUint16AcqRel(SB)
+
+TEXT ·AndUint16Release(SB), NOSPLIT, $0-18
+	JMP	·AndUint16AcqRel(SB)
+
+TEXT ·OrUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVQ	addr+0(FP), DX
+	MOVWLZX	mask+8(FP), SI
+or16_retry:
+	MOVWLZX	(DX), AX
+	MOVW	AX, CX
+	ORW	SI, CX
+	LOCK
+	CMPXCHGW	CX, (DX)
+	JNE	or16_retry
+	MOVW	AX, ret+16(FP)
+	RET
+
+TEXT ·OrUint16Relaxed(SB), NOSPLIT, $0-18
+	JMP	·OrUint16AcqRel(SB)
+
+TEXT ·OrUint16Acquire(SB), NOSPLIT, $0-18
+	JMP	·OrUint16AcqRel(SB)
+
+TEXT ·OrUint16Release(SB), NOSPLIT, $0-18
+	JMP	·OrUint16AcqRel(SB)
+
+TEXT ·XorUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVQ	addr+0(FP), DX
+	MOVWLZX	mask+8(FP), SI
+xor16_retry:
+	MOVWLZX	(DX), AX
+	MOVW	AX, CX
+	XORW	SI, CX
+	LOCK
+	CMPXCHGW	CX, (DX)
+	JNE	xor16_retry
+	MOVW	AX, ret+16(FP)
+	RET
+
+TEXT ·XorUint16Relaxed(SB), NOSPLIT, $0-18
+	JMP	·XorUint16AcqRel(SB)
+
+TEXT ·XorUint16Acquire(SB), NOSPLIT, $0-18
+	JMP	·XorUint16AcqRel(SB)
+
+TEXT ·XorUint16Release(SB), NOSPLIT, $0-18
+	JMP	·XorUint16AcqRel(SB)
+
+// =============================================================================
+// Single-bit operations
+// =============================================================================
+//
+// LOCK BTS/BTR/BTC set, clear or flip one bit and leave its old value in CF,
+// which SETCS returns; no CAS loop is needed. The bit index is reduced modulo
+// the operand width first, since a register bit offset on a memory operand
+// may otherwise address bytes outside it. All orderings JMP to AcqRel.
+
+// func BitSetUint32AcqRel(addr *uint32, bit uint) bool
+TEXT ·BitSetUint32AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), AX
+	MOVQ	bit+8(FP), BX
+	ANDQ	$31, BX
+	LOCK
+	BTSL	BX, (AX)
+	SETCS	ret+16(FP)
+	RET
+
+TEXT ·BitSetUint32Relaxed(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint32AcqRel(SB)
+
+TEXT ·BitSetUint32Acquire(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint32AcqRel(SB)
+
+TEXT ·BitSetUint32Release(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint32AcqRel(SB)
+
+// func BitSetUint64AcqRel(addr *uint64, bit uint) bool
+TEXT ·BitSetUint64AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), AX
+	MOVQ	bit+8(FP), BX
+	ANDQ	$63, BX
+	LOCK
+	BTSQ	BX, (AX)
+	SETCS	ret+16(FP)
+	RET
+
+TEXT ·BitSetUint64Relaxed(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint64AcqRel(SB)
+
+TEXT ·BitSetUint64Acquire(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint64AcqRel(SB)
+
+TEXT ·BitSetUint64Release(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint64AcqRel(SB)
+
+TEXT ·BitSetUintptrRelaxed(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint64AcqRel(SB)
+
+TEXT ·BitSetUintptrAcquire(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint64AcqRel(SB)
+
+TEXT ·BitSetUintptrRelease(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint64AcqRel(SB)
+
+TEXT ·BitSetUintptrAcqRel(SB), NOSPLIT, $0-17
+	JMP	·BitSetUint64AcqRel(SB)
+
+// func BitClearUint32AcqRel(addr *uint32, bit uint) bool
+TEXT ·BitClearUint32AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), AX
+	MOVQ	bit+8(FP), BX
+	ANDQ	$31, BX
+	LOCK
+	BTRL	BX, (AX)
+	SETCS	ret+16(FP)
+	RET
+
+TEXT ·BitClearUint32Relaxed(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint32AcqRel(SB)
+
+TEXT ·BitClearUint32Acquire(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint32AcqRel(SB)
+
+TEXT ·BitClearUint32Release(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint32AcqRel(SB)
+
+// func BitClearUint64AcqRel(addr *uint64, bit uint) bool
+TEXT ·BitClearUint64AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), AX
+	MOVQ	bit+8(FP), BX
+	ANDQ	$63, BX
+	LOCK
+	BTRQ	BX, (AX)
+	SETCS	ret+16(FP)
+	RET
+
+TEXT ·BitClearUint64Relaxed(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint64AcqRel(SB)
+
+TEXT ·BitClearUint64Acquire(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint64AcqRel(SB)
+
+TEXT ·BitClearUint64Release(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint64AcqRel(SB)
+
+TEXT ·BitClearUintptrRelaxed(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint64AcqRel(SB)
+
+TEXT ·BitClearUintptrAcquire(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint64AcqRel(SB)
+
+TEXT ·BitClearUintptrRelease(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint64AcqRel(SB)
+
+TEXT ·BitClearUintptrAcqRel(SB), NOSPLIT, $0-17
+	JMP	·BitClearUint64AcqRel(SB)
+
+// func BitToggleUint32AcqRel(addr *uint32, bit uint) bool
+TEXT ·BitToggleUint32AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), AX
+	MOVQ	bit+8(FP), BX
+	ANDQ	$31, BX
+	LOCK
+	BTCL	BX, (AX)
+	SETCS	ret+16(FP)
+	RET
+
+TEXT ·BitToggleUint32Relaxed(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint32AcqRel(SB)
+
+TEXT ·BitToggleUint32Acquire(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint32AcqRel(SB)
+
+TEXT ·BitToggleUint32Release(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint32AcqRel(SB)
+
+// func BitToggleUint64AcqRel(addr *uint64, bit uint) bool
+TEXT ·BitToggleUint64AcqRel(SB), NOSPLIT, $0-17
+	MOVQ	addr+0(FP), AX
+	MOVQ	bit+8(FP), BX
+	ANDQ	$63, BX
+	LOCK
+	BTCQ	BX, (AX)
+	SETCS	ret+16(FP)
+	RET
+
+TEXT ·BitToggleUint64Relaxed(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint64AcqRel(SB)
+
+TEXT ·BitToggleUint64Acquire(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint64AcqRel(SB)
+
+TEXT ·BitToggleUint64Release(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint64AcqRel(SB)
+
+TEXT ·BitToggleUintptrRelaxed(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint64AcqRel(SB)
+
+TEXT ·BitToggleUintptrAcquire(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint64AcqRel(SB)
+
+TEXT ·BitToggleUintptrRelease(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint64AcqRel(SB)
+
+TEXT ·BitToggleUintptrAcqRel(SB), NOSPLIT, $0-17
+	JMP	·BitToggleUint64AcqRel(SB)
@@ -2,18 +2,25 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build arm64
+//go:build arm64 && !race
 
 #include "textflag.h"
 
-// ARM64 32/64-bit atomic operations using LSE (ARMv8.1+).
+// ARM64 8/16/32/64-bit atomic operations using LSE (ARMv8.1+).
 //
 // Go 1.25 supports ordered LSE variants for 32/64-bit operations:
 //   SWPAD/SWPLD/SWPALD, LDADDAD/LDADDLD/LDADDALD, CASAD/CASLD/CASALD
 //
-// 128-bit operations are in separate files with build tags:
-//   asm_arm64_128.s      - LL/SC (LDXP/STXP), default, faster on Graviton4
-//   asm_arm64_128_lse2.s - CASP (LSE2), use -tags=lse2
+// ARMv8.0 cores (Cortex-A53/A72) lack LSE and raise SIGILL on these
+// instructions. Every read-modify-write therefore tests ·hasLSE (cpu_arm64.go)
+// first and branches to an exclusive load/store loop at llsc when it is
+// false. The loop uses LDAXR for acquire and STLXR for release, so it has the
+// ordering of the LSE instruction it replaces, and it leaves its result in
+// the same register before jumping back to done.
+//
+// 128-bit operations are in separate files:
+//   asm_arm64_128.s    - CASP with LSE2, else LL/SC (LDXP/STXP)
+//   asm_arm64_lse128.s - LDCLRP/LDSETP, use -tags=lse128
 //
 // DMB barrier codes:
 //   $0x9 = ISHLD (acquire barrier)
@@ -45,33 +52,65 @@ TEXT ·StoreInt32Release(SB), NOSPLIT, $0-12
 TEXT ·SwapInt32Relaxed(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	STXRW	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Swap acquire: SWPAW
 TEXT ·SwapInt32Acquire(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPAW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	STXRW	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Swap release: SWPLW
 TEXT ·SwapInt32Release(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPLW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	STLXRW	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Swap acqrel: SWPALW
 TEXT ·SwapInt32AcqRel(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPALW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	STLXRW	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // CAS relaxed
 // ARM64 CASW: R1 (expected) is overwritten with loaded value
@@ -81,11 +120,23 @@ TEXT ·CasInt32Relaxed(SB), NOSPLIT, $0-21
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
 	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASW	R1, (R0), R2	// R1 = loaded value from memory
+done:
 	CMPW	R1, R3		// Compare loaded with expected (32-bit)
 	CSET	EQ, R4
 	MOVB	R4, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // CAS acquire: CASAW
 TEXT ·CasInt32Acquire(SB), NOSPLIT, $0-21
@@ -93,11 +144,23 @@ TEXT ·CasInt32Acquire(SB), NOSPLIT, $0-21
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
 	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASAW	R1, (R0), R2	// R1 = loaded value
+done:
 	CMPW	R1, R3		// Compare loaded with expected (32-bit)
 	CSET	EQ, R4
 	MOVB	R4, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // CAS release: CASLW
 TEXT ·CasInt32Release(SB), NOSPLIT, $0-21
@@ -105,11 +168,23 @@ TEXT ·CasInt32Release(SB), NOSPLIT, $0-21
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
 	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASLW	R1, (R0), R2	// R1 = loaded value
+done:
 	CMPW	R1, R3		// Compare loaded with expected (32-bit)
 	CSET	EQ, R4
 	MOVB	R4, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // CAS acqrel: CASALW
 TEXT ·CasInt32AcqRel(SB), NOSPLIT, $0-21
@@ -117,11 +192,23 @@ TEXT ·CasInt32AcqRel(SB), NOSPLIT, $0-21
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
 	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASALW	R1, (R0), R2	// R1 = loaded value
+done:
 	CMPW	R1, R3		// Compare loaded with expected (32-bit)
 	CSET	EQ, R4
 	MOVB	R4, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // CompareExchange relaxed (returns old value)
 // ARM64 CAS: R1 is overwritten with loaded value from memory
@@ -129,78 +216,163 @@ TEXT ·CaxInt32Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASW	R1, (R0), R2	// R1 = loaded value (old)
+done:
 	MOVW	R1, ret+16(FP)	// Return loaded value
 	RET
+llsc:
+	LDXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // CompareExchange acquire: CASAW
 TEXT ·CaxInt32Acquire(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASAW	R1, (R0), R2	// R1 = loaded value
+done:
 	MOVW	R1, ret+16(FP)	// Return loaded value
 	RET
+llsc:
+	LDAXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // CompareExchange release: CASLW
 TEXT ·CaxInt32Release(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASLW	R1, (R0), R2	// R1 = loaded value
+done:
 	MOVW	R1, ret+16(FP)	// Return loaded value
 	RET
+llsc:
+	LDXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // CompareExchange acqrel: CASALW
 TEXT ·CaxInt32AcqRel(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVW	old+8(FP), R1
 	MOVW	new+12(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASALW	R1, (R0), R2	// R1 = loaded value
+done:
 	MOVW	R1, ret+16(FP)	// Return loaded value
 	RET
+llsc:
+	LDAXRW	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRW	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // Add relaxed: LDADD + ADD (returns new value)
 TEXT ·AddInt32Relaxed(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDW	R1, (R0), R2
+done:
 	ADDW	R1, R2, R2
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	ADDW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Add acquire: LDADDAW + ADD (returns new value)
 TEXT ·AddInt32Acquire(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDAW	R1, (R0), R2
+done:
 	ADDW	R1, R2, R2
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	ADDW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Add release: LDADDLW + ADD (returns new value)
 TEXT ·AddInt32Release(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDLW	R1, (R0), R2
+done:
 	ADDW	R1, R2, R2
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	ADDW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Add acqrel: LDADDALW + ADD (returns new value)
 TEXT ·AddInt32AcqRel(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDALW	R1, (R0), R2
+done:
 	ADDW	R1, R2, R2
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	ADDW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // =============================================================================
 // 32-bit unsigned integer operations (aliases)
 // =============================================================================
 //
-// Relaxed Load/Store are in loadstore_arm64.go
+// Relaxed Load/Store and Release Store are in loadstore_arm64.go;
+// read-modify-write operations are in pointer.go
 
 TEXT ·LoadUint32Acquire(SB), NOSPLIT, $0-12
 	JMP	·LoadInt32Acquire(SB)
@@ -260,7 +432,8 @@ TEXT ·AddUint32AcqRel(SB), NOSPLIT, $0-20
 // 64-bit signed integer operations
 // =============================================================================
 //
-// Relaxed Load/Store are in loadstore_arm64.go
+// Relaxed Load/Store and Release Store are in loadstore_arm64.go;
+// read-modify-write operations are in pointer.go
 
 TEXT ·LoadInt64Acquire(SB), NOSPLIT, $0-16
 	MOVD	addr+0(FP), R0
@@ -277,148 +450,313 @@ TEXT ·StoreInt64Release(SB), NOSPLIT, $0-16
 TEXT ·SwapInt64Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	STXR	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 TEXT ·SwapInt64Acquire(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPAD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	STXR	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 TEXT ·SwapInt64Release(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPLD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	STLXR	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 TEXT ·SwapInt64AcqRel(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	SWPALD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	STLXR	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 TEXT ·CasInt64Relaxed(SB), NOSPLIT, $0-25
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
 	MOVD	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASD	R1, (R0), R2	// R1 = loaded value
+done:
 	CMP	R1, R3		// Compare loaded with expected
 	CSET	EQ, R4
 	MOVB	R4, ret+24(FP)
 	RET
+llsc:
+	LDXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 TEXT ·CasInt64Acquire(SB), NOSPLIT, $0-25
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
 	MOVD	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASAD	R1, (R0), R2	// R1 = loaded value
+done:
 	CMP	R1, R3		// Compare loaded with expected
 	CSET	EQ, R4
 	MOVB	R4, ret+24(FP)
 	RET
+llsc:
+	LDAXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 TEXT ·CasInt64Release(SB), NOSPLIT, $0-25
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
 	MOVD	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASLD	R1, (R0), R2	// R1 = loaded value
+done:
 	CMP	R1, R3		// Compare loaded with expected
 	CSET	EQ, R4
 	MOVB	R4, ret+24(FP)
 	RET
+llsc:
+	LDXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STLXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 TEXT ·CasInt64AcqRel(SB), NOSPLIT, $0-25
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
 	MOVD	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASALD	R1, (R0), R2	// R1 = loaded value
+done:
 	CMP	R1, R3		// Compare loaded with expected
 	CSET	EQ, R4
 	MOVB	R4, ret+24(FP)
 	RET
+llsc:
+	LDAXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STLXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 TEXT ·CaxInt64Relaxed(SB), NOSPLIT, $0-32
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASD	R1, (R0), R2	// R1 = loaded value (old)
+done:
 	MOVD	R1, ret+24(FP)	// Return loaded value
 	RET
+llsc:
+	LDXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 TEXT ·CaxInt64Acquire(SB), NOSPLIT, $0-32
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASAD	R1, (R0), R2	// R1 = loaded value
+done:
 	MOVD	R1, ret+24(FP)
 	RET
+llsc:
+	LDAXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 TEXT ·CaxInt64Release(SB), NOSPLIT, $0-32
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASLD	R1, (R0), R2	// R1 = loaded value
+done:
 	MOVD	R1, ret+24(FP)	// Return loaded value
 	RET
+llsc:
+	LDXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STLXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 TEXT ·CaxInt64AcqRel(SB), NOSPLIT, $0-32
 	MOVD	addr+0(FP), R0
 	MOVD	old+8(FP), R1
 	MOVD	new+16(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	CASALD	R1, (R0), R2	// R1 = loaded value
+done:
 	MOVD	R1, ret+24(FP)	// Return loaded value
 	RET
+llsc:
+	LDAXR	(R0), R6
+	CMP	R1, R6
+	BNE	llsc_done
+	STLXR	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
 
 // Add relaxed: LDADDD + ADD (returns new value)
 TEXT ·AddInt64Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDD	R1, (R0), R2
+done:
 	ADD	R1, R2, R2
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	ADD	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Add acquire: LDADDAD + ADD (returns new value)
 TEXT ·AddInt64Acquire(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDAD	R1, (R0), R2
+done:
 	ADD	R1, R2, R2
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	ADD	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Add release: LDADDLD + ADD (returns new value)
 TEXT ·AddInt64Release(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDLD	R1, (R0), R2
+done:
 	ADD	R1, R2, R2
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	ADD	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Add acqrel: LDADDALD + ADD (returns new value)
 TEXT ·AddInt64AcqRel(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDADDALD	R1, (R0), R2
+done:
 	ADD	R1, R2, R2
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	ADD	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // =============================================================================
 // 64-bit unsigned integer operations (aliases)
 // =============================================================================
 //
-// Relaxed Load/Store are in loadstore_arm64.go
+// Relaxed Load/Store and Release Store are in loadstore_arm64.go;
+// read-modify-write operations are in pointer.go
 
 TEXT ·LoadUint64Acquire(SB), NOSPLIT, $0-16
 	JMP	·LoadInt64Acquire(SB)
@@ -478,7 +816,8 @@ TEXT ·AddUint64AcqRel(SB), NOSPLIT, $0-24
 // Uintptr operations (aliases to 64-bit on arm64)
 // =============================================================================
 //
-// Relaxed Load/Store are in loadstore_arm64.go
+// Relaxed Load/Store and Release Store are in loadstore_arm64.go;
+// read-modify-write operations are in pointer.go
 
 TEXT ·LoadUintptrAcquire(SB), NOSPLIT, $0-16
 	JMP	·LoadInt64Acquire(SB)
@@ -538,72 +877,18 @@ TEXT ·AddUintptrAcqRel(SB), NOSPLIT, $0-24
 // Pointer operations (aliases to 64-bit on arm64)
 // =============================================================================
 //
-// Relaxed Load/Store are in loadstore_arm64.go
+// Relaxed Load/Store and Release Store are in loadstore_arm64.go;
+// read-modify-write operations are in pointer.go
 
 TEXT ·LoadPointerAcquire(SB), NOSPLIT, $0-16
 	JMP	·LoadInt64Acquire(SB)
 
-TEXT ·StorePointerRelease(SB), NOSPLIT, $0-16
-	JMP	·StoreInt64Release(SB)
-
-TEXT ·SwapPointerRelaxed(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Relaxed(SB)
-
-TEXT ·SwapPointerAcquire(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Acquire(SB)
-
-TEXT ·SwapPointerRelease(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Release(SB)
-
-TEXT ·SwapPointerAcqRel(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64AcqRel(SB)
-
-TEXT ·CasPointerRelaxed(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Relaxed(SB)
-
-TEXT ·CasPointerAcquire(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Acquire(SB)
-
-TEXT ·CasPointerRelease(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Release(SB)
-
-TEXT ·CasPointerAcqRel(SB), NOSPLIT, $0-25
-	JMP	·CasInt64AcqRel(SB)
-
-TEXT ·CaxPointerRelaxed(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Relaxed(SB)
-
-TEXT ·CaxPointerAcquire(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Acquire(SB)
-
-TEXT ·CaxPointerRelease(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Release(SB)
-
-TEXT ·CaxPointerAcqRel(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64AcqRel(SB)
-
 // =============================================================================
 // 128-bit operations are in separate files:
-//   asm_arm64_128.s      - LL/SC implementation (default, faster on most CPUs)
-//   asm_arm64_128_lse2.s - CASP implementation (use -tags=lse2)
+//   asm_arm64_128.s    - CASP with LSE2, else LL/SC, chosen at startup
+//   asm_arm64_lse128.s - LDCLRP/LDSETP bitwise operations (use -tags=lse128)
 // =============================================================================
 
-// =============================================================================
-// Barrier operations
-// =============================================================================
-
-TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
-	DMB	$0x9
-	RET
-
-TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
-	DMB	$0xA
-	RET
-
-TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
-	DMB	$0xB
-	RET
-
 // =============================================================================
 // Bitwise OR operations using LDOR (LSE)
 // =============================================================================
@@ -615,65 +900,137 @@ TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
 TEXT ·OrInt32Relaxed(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	ORRW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Or32 acquire: LDORAW
 TEXT ·OrInt32Acquire(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORAW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	ORRW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Or32 release: LDORLW
 TEXT ·OrInt32Release(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORLW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	ORRW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Or32 acqrel: LDORALW
 TEXT ·OrInt32AcqRel(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORALW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	ORRW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Or64 relaxed: LDORD
 TEXT ·OrInt64Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	ORR	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Or64 acquire: LDORAD
 TEXT ·OrInt64Acquire(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORAD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	ORR	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Or64 release: LDORLD
 TEXT ·OrInt64Release(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORLD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	ORR	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Or64 acqrel: LDORALD
 TEXT ·OrInt64AcqRel(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDORALD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	ORR	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Unsigned Or operations (aliases to signed)
 TEXT ·OrUint32Relaxed(SB), NOSPLIT, $0-20
@@ -726,72 +1083,144 @@ TEXT ·AndInt32Relaxed(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
 	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	BICW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // And32 acquire: MVN + LDCLRAW
 TEXT ·AndInt32Acquire(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
 	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRAW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	BICW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // And32 release: MVN + LDCLRLW
 TEXT ·AndInt32Release(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
 	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRLW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	BICW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // And32 acqrel: MVN + LDCLRALW
 TEXT ·AndInt32AcqRel(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
 	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRALW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	BICW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // And64 relaxed: MVN + LDCLRD
 TEXT ·AndInt64Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
 	MVN	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	BIC	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // And64 acquire: MVN + LDCLRAD
 TEXT ·AndInt64Acquire(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
 	MVN	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRAD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	BIC	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // And64 release: MVN + LDCLRLD
 TEXT ·AndInt64Release(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
 	MVN	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRLD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	BIC	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // And64 acqrel: MVN + LDCLRALD
 TEXT ·AndInt64AcqRel(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
 	MVN	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDCLRALD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	BIC	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Unsigned And operations (aliases to signed)
 TEXT ·AndUint32Relaxed(SB), NOSPLIT, $0-20
@@ -841,65 +1270,137 @@ TEXT ·AndUintptrAcqRel(SB), NOSPLIT, $0-24
 TEXT ·XorInt32Relaxed(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	EORW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Xor32 acquire: LDEORAW
 TEXT ·XorInt32Acquire(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORAW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	EORW	R1, R2, R6
+	STXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Xor32 release: LDEORLW
 TEXT ·XorInt32Release(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORLW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDXRW	(R0), R2
+	EORW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Xor32 acqrel: LDEORALW
 TEXT ·XorInt32AcqRel(SB), NOSPLIT, $0-20
 	MOVD	addr+0(FP), R0
 	MOVW	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORALW	R1, (R0), R2
+done:
 	MOVW	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXRW	(R0), R2
+	EORW	R1, R2, R6
+	STLXRW	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Xor64 relaxed: LDEORD
 TEXT ·XorInt64Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	EOR	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Xor64 acquire: LDEORAD
 TEXT ·XorInt64Acquire(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORAD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	EOR	R1, R2, R6
+	STXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Xor64 release: LDEORLD
 TEXT ·XorInt64Release(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORLD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDXR	(R0), R2
+	EOR	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Xor64 acqrel: LDEORALD
 TEXT ·XorInt64AcqRel(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R0
 	MOVD	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
 	LDEORALD	R1, (R0), R2
+done:
 	MOVD	R2, ret+16(FP)
 	RET
+llsc:
+	LDAXR	(R0), R2
+	EOR	R1, R2, R6
+	STLXR	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
 
 // Unsigned Xor operations (aliases to signed)
 TEXT ·XorUint32Relaxed(SB), NOSPLIT, $0-20
@@ -937,3 +1438,1027 @@ TEXT ·XorUintptrRelease(SB), NOSPLIT, $0-24
 
 TEXT ·XorUintptrAcqRel(SB), NOSPLIT, $0-24
 	JMP	·XorInt64AcqRel(SB)
+
+// =============================================================================
+// 8-bit and 16-bit operations (LSE B/H forms, LDXRB/LDXRH fallback)
+// =============================================================================
+
+// 8-bit
+
+TEXT ·LoadUint8Acquire(SB), NOSPLIT, $0-9
+	MOVD	addr+0(FP), R0
+	LDARB	(R0), R0
+	MOVB	R0, ret+8(FP)
+	RET
+
+TEXT ·StoreUint8Release(SB), NOSPLIT, $0-9
+	MOVD	addr+0(FP), R0
+	MOVBU	val+8(FP), R1
+	STLRB	R1, (R0)
+	RET
+
+TEXT ·SwapUint8Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	STXRB	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·SwapUint8Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPAB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	STXRB	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·SwapUint8Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPLB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	STLXRB	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·SwapUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPALB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	STLXRB	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·CasUint8Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASB	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CasUint8Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALB	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CasUint8Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALB	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CasUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALB	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint8Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASB	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVB	R1, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint8Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALB	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVB	R1, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint8Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALB	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVB	R1, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	old+8(FP), R1
+	MOVBU	new+9(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALB	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVB	R1, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRB	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·AddUint8Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDB	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	ADDW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AddUint8Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDAB	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	ADDW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AddUint8Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDLB	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	ADDW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AddUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDALB	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	ADDW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint8Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	BICW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint8Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRAB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	BICW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint8Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRLB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	BICW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRALB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	BICW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint8Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	ORRW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint8Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORAB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	ORRW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint8Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORLB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	ORRW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORALB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	ORRW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint8Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	EORW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint8Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORAB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	EORW	R1, R2, R6
+	STXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint8Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORLB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRB	(R0), R2
+	EORW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint8AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVBU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORALB	R1, (R0), R2
+done:
+	MOVB	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRB	(R0), R2
+	EORW	R1, R2, R6
+	STLXRB	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+// 16-bit
+
+TEXT ·LoadUint16Acquire(SB), NOSPLIT, $0-10
+	MOVD	addr+0(FP), R0
+	LDARH	(R0), R0
+	MOVH	R0, ret+8(FP)
+	RET
+
+TEXT ·StoreUint16Release(SB), NOSPLIT, $0-10
+	MOVD	addr+0(FP), R0
+	MOVHU	val+8(FP), R1
+	STLRH	R1, (R0)
+	RET
+
+TEXT ·SwapUint16Relaxed(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	STXRH	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·SwapUint16Acquire(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPAH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	STXRH	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·SwapUint16Release(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPLH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	STLXRH	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·SwapUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	new+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	SWPALH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	STLXRH	R1, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·CasUint16Relaxed(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASH	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CasUint16Acquire(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALH	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CasUint16Release(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALH	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CasUint16AcqRel(SB), NOSPLIT, $0-17
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVW	R1, R3		// Save expected value
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALH	R1, (R0), R2	// R1 = loaded value
+done:
+	CMPW	R1, R3
+	CSET	EQ, R4
+	MOVB	R4, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint16Relaxed(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASH	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVH	R1, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint16Acquire(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALH	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVH	R1, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint16Release(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALH	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVH	R1, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·CaxUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	old+8(FP), R1
+	MOVHU	new+10(FP), R2
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	CASALH	R1, (R0), R2	// R1 = loaded value
+done:
+	MOVH	R1, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R6
+	CMPW	R1, R6
+	BNE	llsc_done
+	STLXRH	R2, (R0), R5
+	CBNZ	R5, llsc
+llsc_done:
+	MOVD	R6, R1
+	JMP	done
+
+TEXT ·AddUint16Relaxed(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDH	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	ADDW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AddUint16Acquire(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDAH	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	ADDW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AddUint16Release(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDLH	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	ADDW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AddUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	delta+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDADDALH	R1, (R0), R2
+done:
+	ADDW	R1, R2
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	ADDW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint16Relaxed(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	BICW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint16Acquire(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRAH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	BICW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint16Release(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRLH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	BICW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·AndUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MVNW	R1, R1		// R1 = ~mask
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDCLRALH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	BICW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint16Relaxed(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	ORRW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint16Acquire(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORAH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	ORRW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint16Release(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORLH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	ORRW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·OrUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDORALH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	ORRW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint16Relaxed(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	EORW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint16Acquire(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORAH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	EORW	R1, R2, R6
+	STXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint16Release(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORLH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDXRH	(R0), R2
+	EORW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
+
+TEXT ·XorUint16AcqRel(SB), NOSPLIT, $0-18
+	MOVD	addr+0(FP), R0
+	MOVHU	mask+8(FP), R1
+	MOVBU	·hasLSE(SB), R4
+	CBZ	R4, llsc
+	LDEORALH	R1, (R0), R2
+done:
+	MOVH	R2, ret+16(FP)
+	RET
+llsc:
+	LDAXRH	(R0), R2
+	EORW	R1, R2, R6
+	STLXRH	R6, (R0), R5
+	CBNZ	R5, llsc
+	JMP	done
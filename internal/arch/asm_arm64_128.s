@@ -2,29 +2,38 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build arm64 && !lse2
+//go:build arm64 && !race
 
 #include "textflag.h"
 
-// ARM64 128-bit operations using LL/SC (Load-Link/Store-Conditional).
+// ARM64 128-bit operations using LL/SC (LDXP/STXP) or CASP.
 //
-// This is the default implementation using LDXP/STXP instructions.
-// LL/SC is 15-18% faster than CASP on Graviton4 due to lower
-// microarchitectural overhead in uncontended cases.
+// Each function tests ·hasLSE2 (cpu128_arm64.go) and runs one of two
+// implementations:
 //
-// For LSE2/CASP implementation, build with -tags=lse2.
-//
-// Instructions used:
+// LL/SC, for every ARMv8 core. The acquire and release forms of the
+// exclusives carry the ordering, so no barrier is needed.
 //   LDXP  - Load exclusive pair (relaxed)
 //   LDAXP - Load acquire exclusive pair
 //   STXP  - Store exclusive pair (relaxed)
 //   STLXP - Store release exclusive pair
 //   CLREX - Clear exclusive monitor
 //
+// CASP, at label lse2, for cores with FEAT_LSE and FEAT_LSE2 (ARMv8.4+).
+// LSE2 makes LDP/STP single-copy atomic for 16-byte aligned addresses, and
+// CASP does not fail spuriously, which pays off under contention. The
+// assembler has no acquire or release form of CASP, so barriers order it.
+// Uncontended, the barriers can make this path slower than LL/SC; which one
+// wins depends on the core (BenchmarkLSE2Paths measures both), and
+// -tags=nolse2 keeps LL/SC everywhere.
+//   LDP/STP - Load/store pair, with DMB for acquire/release
+//   CASPD   - Compare and swap pair
+//
 // DMB codes:
-//   $0x9 = ISHLD (acquire barrier)
-//   $0xA = ISHST (release barrier)
-//   $0xB = ISH   (full barrier)
+//   $0x9 = ISHLD (acquire barrier: orders the earlier loads before the
+//          later loads and stores)
+//   $0xB = ISH   (release barrier: orders the earlier loads and stores
+//          before the later stores; ISHST would leave loads unordered)
 
 // =============================================================================
 // 128-bit Load operations
@@ -34,20 +43,37 @@
 // Load relaxed: LDXP + CLREX
 TEXT ·LoadUint128Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	LDXP	(R8), (R0, R1)
 	CLREX
 	MOVD	R0, lo+8(FP)
 	MOVD	R1, hi+16(FP)
 	RET
+lse2:
+	// Load relaxed: LDP is atomic with LSE2
+	LDP	(R8), (R0, R1)
+	MOVD	R0, lo+8(FP)
+	MOVD	R1, hi+16(FP)
+	RET
 
 // Load acquire: LDAXP + CLREX
 TEXT ·LoadUint128Acquire(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	LDAXP	(R8), (R0, R1)
 	CLREX
 	MOVD	R0, lo+8(FP)
 	MOVD	R1, hi+16(FP)
 	RET
+lse2:
+	// Load acquire: LDP + acquire barrier
+	LDP	(R8), (R0, R1)
+	DMB	$0x9
+	MOVD	R0, lo+8(FP)
+	MOVD	R1, hi+16(FP)
+	RET
 
 // =============================================================================
 // 128-bit Store operations
@@ -57,6 +83,8 @@ TEXT ·LoadUint128Acquire(SB), NOSPLIT, $0-24
 // Store relaxed: LDXP + STXP loop
 TEXT ·StoreUint128Relaxed(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	lo+8(FP), R2
 	MOVD	hi+16(FP), R3
 store128_rel_retry:
@@ -64,10 +92,18 @@ store128_rel_retry:
 	STXP	(R2, R3), (R8), R4
 	CBNZ	R4, store128_rel_retry
 	RET
+lse2:
+	// Store relaxed: STP is atomic with LSE2
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	STP	(R0, R1), (R8)
+	RET
 
 // Store release: LDXP + STLXP loop
 TEXT ·StoreUint128Release(SB), NOSPLIT, $0-24
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	lo+8(FP), R2
 	MOVD	hi+16(FP), R3
 store128_release_retry:
@@ -75,6 +111,13 @@ store128_release_retry:
 	STLXP	(R2, R3), (R8), R4
 	CBNZ	R4, store128_release_retry
 	RET
+lse2:
+	// Store release: full barrier + STP
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	DMB	$0xB
+	STP	(R0, R1), (R8)
+	RET
 
 // =============================================================================
 // 128-bit Swap operations (LL/SC loop)
@@ -83,6 +126,8 @@ store128_release_retry:
 // Swap 128-bit relaxed: LDXP + STXP loop
 TEXT ·SwapUint128Relaxed(SB), NOSPLIT, $0-40
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	newLo+8(FP), R2
 	MOVD	newHi+16(FP), R3
 swap128_llsc_retry:
@@ -92,10 +137,28 @@ swap128_llsc_retry:
 	MOVD	R0, oldLo+24(FP)
 	MOVD	R1, oldHi+32(FP)
 	RET
+lse2:
+	// Swap 128-bit: CAS loop until success, returns old value
+	MOVD	newLo+8(FP), R2
+	MOVD	newHi+16(FP), R3
+	// Read current value (LDP is atomic with LSE2)
+	LDP	(R8), (R0, R1)
+swap128_retry:
+	MOVD	R0, R4
+	MOVD	R1, R5
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	swap128_retry
+	MOVD	R4, oldLo+24(FP)
+	MOVD	R5, oldHi+32(FP)
+	RET
 
 // Swap 128-bit acquire: LDAXP + STXP loop
 TEXT ·SwapUint128Acquire(SB), NOSPLIT, $0-40
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	newLo+8(FP), R2
 	MOVD	newHi+16(FP), R3
 swap128_acq_llsc_retry:
@@ -105,10 +168,28 @@ swap128_acq_llsc_retry:
 	MOVD	R0, oldLo+24(FP)
 	MOVD	R1, oldHi+32(FP)
 	RET
+lse2:
+	MOVD	newLo+8(FP), R2
+	MOVD	newHi+16(FP), R3
+	// Read current value (LDP is atomic with LSE2)
+	LDP	(R8), (R0, R1)
+swap128_acq_retry:
+	MOVD	R0, R4
+	MOVD	R1, R5
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	swap128_acq_retry
+	DMB	$0x9
+	MOVD	R4, oldLo+24(FP)
+	MOVD	R5, oldHi+32(FP)
+	RET
 
 // Swap 128-bit release: LDXP + STLXP loop
 TEXT ·SwapUint128Release(SB), NOSPLIT, $0-40
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	newLo+8(FP), R2
 	MOVD	newHi+16(FP), R3
 swap128_rel_llsc_retry:
@@ -118,10 +199,31 @@ swap128_rel_llsc_retry:
 	MOVD	R0, oldLo+24(FP)
 	MOVD	R1, oldHi+32(FP)
 	RET
+lse2:
+	MOVD	newLo+8(FP), R2
+	MOVD	newHi+16(FP), R3
+	// Read current value (LDP is atomic with LSE2)
+	LDP	(R8), (R0, R1)
+	// Release barrier placed outside the CASPD loop: a failed CASPD only
+	// performs a load (no store occurred), so release ordering is not needed
+	// for failed attempts. One barrier before the first attempt suffices.
+	DMB	$0xB
+swap128_rel_retry:
+	MOVD	R0, R4
+	MOVD	R1, R5
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	swap128_rel_retry
+	MOVD	R4, oldLo+24(FP)
+	MOVD	R5, oldHi+32(FP)
+	RET
 
 // Swap 128-bit acqrel: LDAXP + STLXP loop
 TEXT ·SwapUint128AcqRel(SB), NOSPLIT, $0-40
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	newLo+8(FP), R2
 	MOVD	newHi+16(FP), R3
 swap128_ar_llsc_retry:
@@ -131,6 +233,28 @@ swap128_ar_llsc_retry:
 	MOVD	R0, oldLo+24(FP)
 	MOVD	R1, oldHi+32(FP)
 	RET
+lse2:
+	MOVD	newLo+8(FP), R2
+	MOVD	newHi+16(FP), R3
+	// Read current value (LDP is atomic with LSE2)
+	LDP	(R8), (R0, R1)
+	// Release barrier placed outside the CASPD loop: a failed CASPD only
+	// performs a load (no store occurred), so release ordering is not needed
+	// for failed attempts. One barrier before the first attempt suffices.
+	DMB	$0xB
+swap128_aqrl_retry:
+	MOVD	R0, R4
+	MOVD	R1, R5
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	swap128_aqrl_retry
+	// Full barrier after the successful swap: acquire, and it keeps the
+	// CASPD store ahead of later loads, so AcqRel also serves as SeqCst.
+	DMB	$0xB
+	MOVD	R4, oldLo+24(FP)
+	MOVD	R5, oldHi+32(FP)
+	RET
 
 // =============================================================================
 // 128-bit CAS operations (LL/SC)
@@ -139,6 +263,8 @@ swap128_ar_llsc_retry:
 // CAS 128-bit relaxed: LDXP + compare + STXP
 TEXT ·CasUint128Relaxed(SB), NOSPLIT, $0-41
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -158,10 +284,25 @@ cas128_llsc_fail:
 	CLREX
 	MOVB	ZR, ret+40(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	MOVD	R0, R4
+	MOVD	R1, R5
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	CSET	EQ, R6
+	MOVB	R6, ret+40(FP)
+	RET
 
 // CAS 128-bit acquire: LDAXP + compare + STXP
 TEXT ·CasUint128Acquire(SB), NOSPLIT, $0-41
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -181,10 +322,30 @@ cas128_acq_llsc_fail:
 	CLREX
 	MOVB	ZR, ret+40(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	MOVD	R0, R4
+	MOVD	R1, R5
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	cas128_acq_fail
+	DMB	$0x9
+	MOVD	$1, R3
+	MOVB	R3, ret+40(FP)
+	RET
+cas128_acq_fail:
+	MOVB	ZR, ret+40(FP)
+	RET
 
 // CAS 128-bit release: LDXP + compare + STLXP
 TEXT ·CasUint128Release(SB), NOSPLIT, $0-41
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -204,10 +365,26 @@ cas128_rel_llsc_fail:
 	CLREX
 	MOVB	ZR, ret+40(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	MOVD	R0, R4
+	MOVD	R1, R5
+	DMB	$0xB
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	CSET	EQ, R6
+	MOVB	R6, ret+40(FP)
+	RET
 
 // CAS 128-bit acqrel: LDAXP + compare + STLXP
 TEXT ·CasUint128AcqRel(SB), NOSPLIT, $0-41
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -227,6 +404,25 @@ cas128_ar_llsc_fail:
 	CLREX
 	MOVB	ZR, ret+40(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	MOVD	R0, R4
+	MOVD	R1, R5
+	DMB	$0xB
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	cas128_ar_fail
+	DMB	$0xB
+	MOVD	$1, R3
+	MOVB	R3, ret+40(FP)
+	RET
+cas128_ar_fail:
+	MOVB	ZR, ret+40(FP)
+	RET
 
 // =============================================================================
 // 128-bit CAX (Compare-And-Exchange returning old value) operations
@@ -235,6 +431,8 @@ cas128_ar_llsc_fail:
 // CAX 128-bit relaxed: LDXP + compare + STXP
 TEXT ·CaxUint128Relaxed(SB), NOSPLIT, $0-56
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -252,10 +450,21 @@ cax128_llsc_done:
 	MOVD	R0, lo+40(FP)
 	MOVD	R1, hi+48(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	CASPD	(R0, R1), (R8), (R2, R3)
+	MOVD	R0, lo+40(FP)
+	MOVD	R1, hi+48(FP)
+	RET
 
 // CAX 128-bit acquire: LDAXP + compare + STXP
 TEXT ·CaxUint128Acquire(SB), NOSPLIT, $0-56
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -273,10 +482,28 @@ cax128_acq_llsc_done:
 	MOVD	R0, lo+40(FP)
 	MOVD	R1, hi+48(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	MOVD	R0, R4
+	MOVD	R1, R5
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	cax128_acq_done
+	DMB	$0x9
+cax128_acq_done:
+	MOVD	R0, lo+40(FP)
+	MOVD	R1, hi+48(FP)
+	RET
 
 // CAX 128-bit release: LDXP + compare + STLXP
 TEXT ·CaxUint128Release(SB), NOSPLIT, $0-56
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -294,10 +521,22 @@ cax128_rel_llsc_done:
 	MOVD	R0, lo+40(FP)
 	MOVD	R1, hi+48(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	DMB	$0xB
+	CASPD	(R0, R1), (R8), (R2, R3)
+	MOVD	R0, lo+40(FP)
+	MOVD	R1, hi+48(FP)
+	RET
 
 // CAX 128-bit acqrel: LDAXP + compare + STLXP
 TEXT ·CaxUint128AcqRel(SB), NOSPLIT, $0-56
 	MOVD	addr+0(FP), R8
+	MOVBU	·hasLSE2(SB), R7
+	CBNZ	R7, lse2
 	MOVD	oldLo+8(FP), R4
 	MOVD	oldHi+16(FP), R5
 	MOVD	newLo+24(FP), R2
@@ -315,3 +554,20 @@ cax128_ar_llsc_done:
 	MOVD	R0, lo+40(FP)
 	MOVD	R1, hi+48(FP)
 	RET
+lse2:
+	MOVD	oldLo+8(FP), R0
+	MOVD	oldHi+16(FP), R1
+	MOVD	newLo+24(FP), R2
+	MOVD	newHi+32(FP), R3
+	MOVD	R0, R4
+	MOVD	R1, R5
+	DMB	$0xB
+	CASPD	(R0, R1), (R8), (R2, R3)
+	CMP	R0, R4
+	CCMP	EQ, R1, R5, $0
+	BNE	cax128_ar_done
+	DMB	$0xB
+cax128_ar_done:
+	MOVD	R0, lo+40(FP)
+	MOVD	R1, hi+48(FP)
+	RET
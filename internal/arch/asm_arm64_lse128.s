@@ -0,0 +1,147 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && lse128 && !race
+
+#include "textflag.h"
+
+// ARM64 128-bit bitwise RMW using FEAT_LSE128 (ARMv9.4+).
+//
+// The Go assembler has no mnemonics for the LSE128 instructions, so they
+// are emitted as WORD encodings, all with Rt=R0 (lo), Rt2=R1 (hi), Rn=R8:
+//   LDCLRP{A,L,AL} - atomically clear the bits set in (R0, R1): *addr &^= v
+//   LDSETP{A,L,AL} - atomically set the bits set in (R0, R1):   *addr |= v
+// Both leave the previous value in (R0, R1).
+//
+// And is LDCLRP with the complemented operand.
+//
+// Frame layout: addr+0, lo+8, hi+16, oldLo+24, oldHi+32 = 40 bytes
+
+// =============================================================================
+// AndUint128
+// =============================================================================
+
+TEXT ·AndUint128Relaxed(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	MVN	R0, R0
+	MVN	R1, R1
+	WORD	$0x19211100	// LDCLRP R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·AndUint128Acquire(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	MVN	R0, R0
+	MVN	R1, R1
+	WORD	$0x19A11100	// LDCLRPA R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·AndUint128Release(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	MVN	R0, R0
+	MVN	R1, R1
+	WORD	$0x19611100	// LDCLRPL R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·AndUint128AcqRel(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	MVN	R0, R0
+	MVN	R1, R1
+	WORD	$0x19E11100	// LDCLRPAL R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+// =============================================================================
+// AndNotUint128
+// =============================================================================
+
+TEXT ·AndNotUint128Relaxed(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19211100	// LDCLRP R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·AndNotUint128Acquire(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19A11100	// LDCLRPA R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·AndNotUint128Release(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19611100	// LDCLRPL R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·AndNotUint128AcqRel(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19E11100	// LDCLRPAL R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+// =============================================================================
+// OrUint128
+// =============================================================================
+
+TEXT ·OrUint128Relaxed(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19213100	// LDSETP R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·OrUint128Acquire(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19A13100	// LDSETPA R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·OrUint128Release(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19613100	// LDSETPL R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
+
+TEXT ·OrUint128AcqRel(SB), NOSPLIT, $0-40
+	MOVD	addr+0(FP), R8
+	MOVD	lo+8(FP), R0
+	MOVD	hi+16(FP), R1
+	WORD	$0x19E13100	// LDSETPAL R0, R1, (R8)
+	MOVD	R0, oldLo+24(FP)
+	MOVD	R1, oldHi+32(FP)
+	RET
@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build loong64
+//go:build loong64 && !race
 
 #include "textflag.h"
 
@@ -717,305 +717,6 @@ TEXT ·LoadPointerRelaxed(SB), NOSPLIT, $0-16
 TEXT ·LoadPointerAcquire(SB), NOSPLIT, $0-16
 	JMP	·LoadInt64Acquire(SB)
 
-TEXT ·StorePointerRelaxed(SB), NOSPLIT, $0-16
-	JMP	·StoreInt64Relaxed(SB)
-
-TEXT ·StorePointerRelease(SB), NOSPLIT, $0-16
-	JMP	·StoreInt64Release(SB)
-
-TEXT ·SwapPointerRelaxed(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Relaxed(SB)
-
-TEXT ·SwapPointerAcquire(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Acquire(SB)
-
-TEXT ·SwapPointerRelease(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Release(SB)
-
-TEXT ·SwapPointerAcqRel(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64AcqRel(SB)
-
-TEXT ·CasPointerRelaxed(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Relaxed(SB)
-
-TEXT ·CasPointerAcquire(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Acquire(SB)
-
-TEXT ·CasPointerRelease(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Release(SB)
-
-TEXT ·CasPointerAcqRel(SB), NOSPLIT, $0-25
-	JMP	·CasInt64AcqRel(SB)
-
-TEXT ·CaxPointerRelaxed(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Relaxed(SB)
-
-TEXT ·CaxPointerAcquire(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Acquire(SB)
-
-TEXT ·CaxPointerRelease(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Release(SB)
-
-TEXT ·CaxPointerAcqRel(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64AcqRel(SB)
-
-// ============================================================================
-// 128-bit Operations (emulated via LL/SC on low word)
-// ============================================================================
-
-// func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64)
-TEXT ·LoadUint128Relaxed(SB), NOSPLIT, $0-24
-	MOVV	addr+0(FP), R4
-load128_relaxed_loop:
-	MOVV	(R4), R5
-	MOVV	8(R4), R6
-	MOVV	(R4), R7
-	BNE	R5, R7, load128_relaxed_loop
-	MOVV	R5, lo+8(FP)
-	MOVV	R6, hi+16(FP)
-	RET
-
-// func LoadUint128Acquire(addr *[16]byte) (lo, hi uint64)
-TEXT ·LoadUint128Acquire(SB), NOSPLIT, $0-24
-	MOVV	addr+0(FP), R4
-load128_acq_loop:
-	MOVV	(R4), R5
-	MOVV	8(R4), R6
-	MOVV	(R4), R7
-	BNE	R5, R7, load128_acq_loop
-	DBAR	$0x14
-	MOVV	R5, lo+8(FP)
-	MOVV	R6, hi+16(FP)
-	RET
-
-// func StoreUint128Relaxed(addr *[16]byte, lo, hi uint64)
-TEXT ·StoreUint128Relaxed(SB), NOSPLIT, $0-24
-	MOVV	addr+0(FP), R4
-	MOVV	lo+8(FP), R5
-	MOVV	hi+16(FP), R6
-store128_relaxed_loop:
-	LLV	(R4), R7
-	SCV	R5, (R4)
-	BEQ	R7, store128_relaxed_loop
-	MOVV	R6, 8(R4)
-	RET
-
-// func StoreUint128Release(addr *[16]byte, lo, hi uint64)
-TEXT ·StoreUint128Release(SB), NOSPLIT, $0-24
-	MOVV	addr+0(FP), R4
-	MOVV	lo+8(FP), R5
-	MOVV	hi+16(FP), R6
-	DBAR	$0x12
-store128_rel_loop:
-	LLV	(R4), R7
-	SCV	R5, (R4)
-	BEQ	R7, store128_rel_loop
-	MOVV	R6, 8(R4)
-	RET
-
-// func SwapUint128Relaxed(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-TEXT ·SwapUint128Relaxed(SB), NOSPLIT, $0-40
-	MOVV	addr+0(FP), R4
-	MOVV	newLo+8(FP), R5
-	MOVV	newHi+16(FP), R6
-swap128_relaxed_loop:
-	LLV	(R4), R7		// load-link old lo
-	MOVV	8(R4), R8		// load old hi
-	SCV	R5, (R4)		// store-cond new lo
-	BEQ	R7, swap128_relaxed_loop
-	MOVV	R6, 8(R4)		// store new hi
-	MOVV	R7, oldLo+24(FP)
-	MOVV	R8, oldHi+32(FP)
-	RET
-
-// func SwapUint128Acquire(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-TEXT ·SwapUint128Acquire(SB), NOSPLIT, $0-40
-	JMP	·SwapUint128Relaxed(SB)
-
-// func SwapUint128Release(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-TEXT ·SwapUint128Release(SB), NOSPLIT, $0-40
-	JMP	·SwapUint128Relaxed(SB)
-
-// func SwapUint128AcqRel(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-TEXT ·SwapUint128AcqRel(SB), NOSPLIT, $0-40
-	JMP	·SwapUint128Relaxed(SB)
-
-// func CasUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128Relaxed(SB), NOSPLIT, $0-41
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-cas128_relaxed_loop:
-	LLV	(R4), R9
-	BNE	R9, R5, cas128_relaxed_fail
-	MOVV	8(R4), R10
-	BNE	R10, R6, cas128_relaxed_fail
-	SCV	R7, (R4)
-	BEQ	R7, cas128_relaxed_loop
-	MOVV	R8, 8(R4)
-	MOVV	$1, R4
-	MOVB	R4, ret+40(FP)
-	RET
-cas128_relaxed_fail:
-	MOVB	R0, ret+40(FP)
-	RET
-
-// func CasUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128Acquire(SB), NOSPLIT, $0-41
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-cas128_acq_loop:
-	LLV	(R4), R9
-	BNE	R9, R5, cas128_acq_fail
-	MOVV	8(R4), R10
-	BNE	R10, R6, cas128_acq_fail
-	SCV	R7, (R4)
-	BEQ	R7, cas128_acq_loop
-	MOVV	R8, 8(R4)
-	DBAR	$0x14
-	MOVV	$1, R4
-	MOVB	R4, ret+40(FP)
-	RET
-cas128_acq_fail:
-	MOVB	R0, ret+40(FP)
-	RET
-
-// func CasUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128Release(SB), NOSPLIT, $0-41
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-	DBAR	$0x12
-cas128_rel_loop:
-	LLV	(R4), R9
-	BNE	R9, R5, cas128_rel_fail
-	MOVV	8(R4), R10
-	BNE	R10, R6, cas128_rel_fail
-	SCV	R7, (R4)
-	BEQ	R7, cas128_rel_loop
-	MOVV	R8, 8(R4)
-	MOVV	$1, R4
-	MOVB	R4, ret+40(FP)
-	RET
-cas128_rel_fail:
-	MOVB	R0, ret+40(FP)
-	RET
-
-// func CasUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128AcqRel(SB), NOSPLIT, $0-41
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-	DBAR	$0x12
-cas128_aqrl_loop:
-	LLV	(R4), R9
-	BNE	R9, R5, cas128_aqrl_fail
-	MOVV	8(R4), R10
-	BNE	R10, R6, cas128_aqrl_fail
-	SCV	R7, (R4)
-	BEQ	R7, cas128_aqrl_loop
-	MOVV	R8, 8(R4)
-	DBAR	$0x14
-	MOVV	$1, R4
-	MOVB	R4, ret+40(FP)
-	RET
-cas128_aqrl_fail:
-	MOVB	R0, ret+40(FP)
-	RET
-
-// func CaxUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128Relaxed(SB), NOSPLIT, $0-56
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-cax128_relaxed_loop:
-	LLV	(R4), R9
-	MOVV	8(R4), R10
-	BNE	R9, R5, cax128_relaxed_done
-	BNE	R10, R6, cax128_relaxed_done
-	SCV	R7, (R4)
-	BEQ	R7, cax128_relaxed_loop
-	MOVV	R8, 8(R4)
-cax128_relaxed_done:
-	MOVV	R9, lo+40(FP)
-	MOVV	R10, hi+48(FP)
-	RET
-
-// func CaxUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128Acquire(SB), NOSPLIT, $0-56
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-cax128_acq_loop:
-	LLV	(R4), R9
-	MOVV	8(R4), R10
-	BNE	R9, R5, cax128_acq_done
-	BNE	R10, R6, cax128_acq_done
-	SCV	R7, (R4)
-	BEQ	R7, cax128_acq_loop
-	MOVV	R8, 8(R4)
-cax128_acq_done:
-	DBAR	$0x14
-	MOVV	R9, lo+40(FP)
-	MOVV	R10, hi+48(FP)
-	RET
-
-// func CaxUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128Release(SB), NOSPLIT, $0-56
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-	DBAR	$0x12
-cax128_rel_loop:
-	LLV	(R4), R9
-	MOVV	8(R4), R10
-	BNE	R9, R5, cax128_rel_done
-	BNE	R10, R6, cax128_rel_done
-	SCV	R7, (R4)
-	BEQ	R7, cax128_rel_loop
-	MOVV	R8, 8(R4)
-cax128_rel_done:
-	MOVV	R9, lo+40(FP)
-	MOVV	R10, hi+48(FP)
-	RET
-
-// func CaxUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128AcqRel(SB), NOSPLIT, $0-56
-	MOVV	addr+0(FP), R4
-	MOVV	oldLo+8(FP), R5
-	MOVV	oldHi+16(FP), R6
-	MOVV	newLo+24(FP), R7
-	MOVV	newHi+32(FP), R8
-	DBAR	$0x12
-cax128_aqrl_loop:
-	LLV	(R4), R9
-	MOVV	8(R4), R10
-	BNE	R9, R5, cax128_aqrl_done
-	BNE	R10, R6, cax128_aqrl_done
-	SCV	R7, (R4)
-	BEQ	R7, cax128_aqrl_loop
-	MOVV	R8, 8(R4)
-cax128_aqrl_done:
-	DBAR	$0x14
-	MOVV	R9, lo+40(FP)
-	MOVV	R10, hi+48(FP)
-	RET
-
 // ============================================================================
 // Bitwise Operations (And, Or, Xor)
 // ============================================================================
@@ -1304,22 +1005,3 @@ TEXT ·XorUintptrRelease(SB), NOSPLIT, $0-24
 
 TEXT ·XorUintptrAcqRel(SB), NOSPLIT, $0-24
 	JMP	·XorInt64AcqRel(SB)
-
-// ============================================================================
-// Barrier Operations
-// ============================================================================
-
-// func BarrierAcquire()
-TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
-	DBAR	$0x14
-	RET
-
-// func BarrierRelease()
-TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
-	DBAR	$0x12
-	RET
-
-// func BarrierAcqRel()
-TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
-	DBAR
-	RET
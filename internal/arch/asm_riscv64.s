@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build riscv64
+//go:build riscv64 && !race
 
 #include "textflag.h"
 
@@ -570,326 +570,6 @@ TEXT ·LoadPointerRelaxed(SB), NOSPLIT, $0-16
 TEXT ·LoadPointerAcquire(SB), NOSPLIT, $0-16
 	JMP	·LoadInt64Acquire(SB)
 
-// func StorePointerRelaxed(addr *unsafe.Pointer, val unsafe.Pointer)
-TEXT ·StorePointerRelaxed(SB), NOSPLIT, $0-16
-	JMP	·StoreInt64Relaxed(SB)
-
-// func StorePointerRelease(addr *unsafe.Pointer, val unsafe.Pointer)
-TEXT ·StorePointerRelease(SB), NOSPLIT, $0-16
-	JMP	·StoreInt64Release(SB)
-
-// func SwapPointerRelaxed(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-TEXT ·SwapPointerRelaxed(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Relaxed(SB)
-
-// func SwapPointerAcquire(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-TEXT ·SwapPointerAcquire(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Acquire(SB)
-
-// func SwapPointerRelease(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-TEXT ·SwapPointerRelease(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64Release(SB)
-
-// func SwapPointerAcqRel(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-TEXT ·SwapPointerAcqRel(SB), NOSPLIT, $0-24
-	JMP	·SwapInt64AcqRel(SB)
-
-// func CasPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-TEXT ·CasPointerRelaxed(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Relaxed(SB)
-
-// func CasPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-TEXT ·CasPointerAcquire(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Acquire(SB)
-
-// func CasPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-TEXT ·CasPointerRelease(SB), NOSPLIT, $0-25
-	JMP	·CasInt64Release(SB)
-
-// func CasPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-TEXT ·CasPointerAcqRel(SB), NOSPLIT, $0-25
-	JMP	·CasInt64AcqRel(SB)
-
-// func CaxPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-TEXT ·CaxPointerRelaxed(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Relaxed(SB)
-
-// func CaxPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-TEXT ·CaxPointerAcquire(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Acquire(SB)
-
-// func CaxPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-TEXT ·CaxPointerRelease(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64Release(SB)
-
-// func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-TEXT ·CaxPointerAcqRel(SB), NOSPLIT, $0-32
-	JMP	·CaxInt64AcqRel(SB)
-
-// ============================================================================
-// 128-bit Operations
-// RISC-V does not have native 128-bit atomics.
-// We emulate using a spinlock on the low word via LR/SC.
-// This is NOT truly atomic 128-bit but provides mutual exclusion.
-// The address MUST be 16-byte aligned.
-// ============================================================================
-
-// func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64)
-// Emulated: retry-load until consistent
-TEXT ·LoadUint128Relaxed(SB), NOSPLIT, $0-24
-	MOV	addr+0(FP), A0
-load128_relaxed_loop:
-	MOV	(A0), A1		// load lo
-	MOV	8(A0), A2		// load hi
-	MOV	(A0), A3		// reload lo
-	BNE	A1, A3, load128_relaxed_loop
-	MOV	A1, lo+8(FP)
-	MOV	A2, hi+16(FP)
-	RET
-
-// func LoadUint128Acquire(addr *[16]byte) (lo, hi uint64)
-TEXT ·LoadUint128Acquire(SB), NOSPLIT, $0-24
-	MOV	addr+0(FP), A0
-load128_acq_loop:
-	MOV	(A0), A1
-	MOV	8(A0), A2
-	MOV	(A0), A3
-	BNE	A1, A3, load128_acq_loop
-	FENCE
-	MOV	A1, lo+8(FP)
-	MOV	A2, hi+16(FP)
-	RET
-
-// func StoreUint128Relaxed(addr *[16]byte, lo, hi uint64)
-// Use LR/SC on lo word as a lock
-TEXT ·StoreUint128Relaxed(SB), NOSPLIT, $0-24
-	MOV	addr+0(FP), A0
-	MOV	lo+8(FP), A1
-	MOV	hi+16(FP), A2
-store128_relaxed_loop:
-	LRD	(A0), A3
-	SCD	A1, (A0), A4
-	BNE	A4, ZERO, store128_relaxed_loop
-	MOV	A2, 8(A0)
-	RET
-
-// func StoreUint128Release(addr *[16]byte, lo, hi uint64)
-TEXT ·StoreUint128Release(SB), NOSPLIT, $0-24
-	MOV	addr+0(FP), A0
-	MOV	lo+8(FP), A1
-	MOV	hi+16(FP), A2
-	FENCE
-store128_rel_loop:
-	LRD	(A0), A3
-	SCD	A1, (A0), A4
-	BNE	A4, ZERO, store128_rel_loop
-	MOV	A2, 8(A0)
-	RET
-
-// func SwapUint128Relaxed(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-// Use LR/SC on lo word, returns old value
-TEXT ·SwapUint128Relaxed(SB), NOSPLIT, $0-40
-	MOV	addr+0(FP), A0
-	MOV	newLo+8(FP), A1
-	MOV	newHi+16(FP), A2
-swap128_relaxed_loop:
-	LRD	(A0), A3		// load-reserve old lo
-	MOV	8(A0), A4		// load old hi
-	SCD	A1, (A0), A5		// store-conditional new lo
-	BNE	A5, ZERO, swap128_relaxed_loop
-	MOV	A2, 8(A0)		// store new hi
-	MOV	A3, oldLo+24(FP)
-	MOV	A4, oldHi+32(FP)
-	RET
-
-// func SwapUint128Acquire(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-TEXT ·SwapUint128Acquire(SB), NOSPLIT, $0-40
-	JMP	·SwapUint128Relaxed(SB)
-
-// func SwapUint128Release(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-TEXT ·SwapUint128Release(SB), NOSPLIT, $0-40
-	JMP	·SwapUint128Relaxed(SB)
-
-// func SwapUint128AcqRel(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-TEXT ·SwapUint128AcqRel(SB), NOSPLIT, $0-40
-	JMP	·SwapUint128Relaxed(SB)
-
-// func CasUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128Relaxed(SB), NOSPLIT, $0-41
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-cas128_relaxed_loop:
-	LRD	(A0), A5		// load-reserve lo
-	BNE	A5, A1, cas128_relaxed_fail
-	MOV	8(A0), A6		// load hi
-	BNE	A6, A2, cas128_relaxed_fail
-	SCD	A3, (A0), A7		// store-conditional new lo
-	BNE	A7, ZERO, cas128_relaxed_loop
-	MOV	A4, 8(A0)		// store new hi
-	MOV	$1, A0
-	MOVB	A0, ret+40(FP)
-	RET
-cas128_relaxed_fail:
-	MOVB	ZERO, ret+40(FP)
-	RET
-
-// func CasUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128Acquire(SB), NOSPLIT, $0-41
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-cas128_acq_loop:
-	LRD	(A0), A5
-	BNE	A5, A1, cas128_acq_fail
-	MOV	8(A0), A6
-	BNE	A6, A2, cas128_acq_fail
-	SCD	A3, (A0), A7
-	BNE	A7, ZERO, cas128_acq_loop
-	MOV	A4, 8(A0)
-	FENCE
-	MOV	$1, A0
-	MOVB	A0, ret+40(FP)
-	RET
-cas128_acq_fail:
-	MOVB	ZERO, ret+40(FP)
-	RET
-
-// func CasUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128Release(SB), NOSPLIT, $0-41
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-	FENCE
-cas128_rel_loop:
-	LRD	(A0), A5
-	BNE	A5, A1, cas128_rel_fail
-	MOV	8(A0), A6
-	BNE	A6, A2, cas128_rel_fail
-	SCD	A3, (A0), A7
-	BNE	A7, ZERO, cas128_rel_loop
-	MOV	A4, 8(A0)
-	MOV	$1, A0
-	MOVB	A0, ret+40(FP)
-	RET
-cas128_rel_fail:
-	MOVB	ZERO, ret+40(FP)
-	RET
-
-// func CasUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-TEXT ·CasUint128AcqRel(SB), NOSPLIT, $0-41
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-	FENCE
-cas128_aqrl_loop:
-	LRD	(A0), A5
-	BNE	A5, A1, cas128_aqrl_fail
-	MOV	8(A0), A6
-	BNE	A6, A2, cas128_aqrl_fail
-	SCD	A3, (A0), A7
-	BNE	A7, ZERO, cas128_aqrl_loop
-	MOV	A4, 8(A0)
-	FENCE
-	MOV	$1, A0
-	MOVB	A0, ret+40(FP)
-	RET
-cas128_aqrl_fail:
-	MOVB	ZERO, ret+40(FP)
-	RET
-
-// func CaxUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128Relaxed(SB), NOSPLIT, $0-56
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-cax128_relaxed_loop:
-	LRD	(A0), A5
-	MOV	8(A0), A6
-	BNE	A5, A1, cax128_relaxed_done
-	BNE	A6, A2, cax128_relaxed_done
-	SCD	A3, (A0), A7
-	BNE	A7, ZERO, cax128_relaxed_loop
-	MOV	A4, 8(A0)
-cax128_relaxed_done:
-	MOV	A5, lo+40(FP)
-	MOV	A6, hi+48(FP)
-	RET
-
-// func CaxUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128Acquire(SB), NOSPLIT, $0-56
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-cax128_acq_loop:
-	LRD	(A0), A5
-	MOV	8(A0), A6
-	BNE	A5, A1, cax128_acq_done
-	BNE	A6, A2, cax128_acq_done
-	SCD	A3, (A0), A7
-	BNE	A7, ZERO, cax128_acq_loop
-	MOV	A4, 8(A0)
-cax128_acq_done:
-	FENCE
-	MOV	A5, lo+40(FP)
-	MOV	A6, hi+48(FP)
-	RET
-
-// func CaxUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128Release(SB), NOSPLIT, $0-56
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-	FENCE
-cax128_rel_loop:
-	LRD	(A0), A5
-	MOV	8(A0), A6
-	BNE	A5, A1, cax128_rel_done
-	BNE	A6, A2, cax128_rel_done
-	SCD	A3, (A0), A7
-	BNE	A7, ZERO, cax128_rel_loop
-	MOV	A4, 8(A0)
-cax128_rel_done:
-	MOV	A5, lo+40(FP)
-	MOV	A6, hi+48(FP)
-	RET
-
-// func CaxUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-TEXT ·CaxUint128AcqRel(SB), NOSPLIT, $0-56
-	MOV	addr+0(FP), A0
-	MOV	oldLo+8(FP), A1
-	MOV	oldHi+16(FP), A2
-	MOV	newLo+24(FP), A3
-	MOV	newHi+32(FP), A4
-	FENCE
-cax128_aqrl_loop:
-	LRD	(A0), A5
-	MOV	8(A0), A6
-	BNE	A5, A1, cax128_aqrl_done
-	BNE	A6, A2, cax128_aqrl_done
-	SCD	A3, (A0), A7
-	BNE	A7, ZERO, cax128_aqrl_loop
-	MOV	A4, 8(A0)
-cax128_aqrl_done:
-	FENCE
-	MOV	A5, lo+40(FP)
-	MOV	A6, hi+48(FP)
-	RET
-
 // ============================================================================
 // Bitwise Operations (And, Or, Xor)
 // ============================================================================
@@ -1106,22 +786,3 @@ TEXT ·XorUintptrRelease(SB), NOSPLIT, $0-24
 
 TEXT ·XorUintptrAcqRel(SB), NOSPLIT, $0-24
 	JMP	·XorInt64Relaxed(SB)
-
-// ============================================================================
-// Barrier Operations
-// ============================================================================
-
-// func BarrierAcquire()
-TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
-	FENCE
-	RET
-
-// func BarrierRelease()
-TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
-	FENCE
-	RET
-
-// func BarrierAcqRel()
-TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
-	FENCE
-	RET
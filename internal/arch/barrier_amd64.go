@@ -0,0 +1,44 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+package arch
+
+// =============================================================================
+// Memory Barriers
+// =============================================================================
+
+// Barriers are no-ops on x86-64 TSO for most purposes.
+// MFENCE is used for sequential consistency requirements.
+
+//go:noescape
+func BarrierAcquire()
+
+//go:noescape
+func BarrierRelease()
+
+//go:noescape
+func BarrierAcqRel()
+
+//go:noescape
+func BarrierSeqCst()
+
+//go:noescape
+func BarrierLoadLoad()
+
+//go:noescape
+func BarrierLoadStore()
+
+//go:noescape
+func BarrierStoreStore()
+
+//go:noescape
+func BarrierStoreLoad()
+
+//go:noescape
+func BarrierNonTemporal()
+
+//go:noescape
+func BarrierCompiler()
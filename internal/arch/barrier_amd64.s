@@ -0,0 +1,55 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+#include "textflag.h"
+
+// =============================================================================
+// Barrier operations
+// =============================================================================
+
+// On x86-64 TSO, acquire and release are compiler barriers only.
+// MFENCE is only needed for sequential consistency.
+TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
+	RET
+
+TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
+	RET
+
+// Full fence using MFENCE
+TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
+	MFENCE
+	RET
+
+// Sequentially consistent fence: MFENCE orders prior stores before later loads
+TEXT ·BarrierSeqCst(SB), NOSPLIT, $0-0
+	MFENCE
+	RET
+
+// TSO already orders load→load, load→store and store→store for ordinary
+// accesses, so these are compiler barriers: the opaque call stops the
+// compiler from moving memory accesses across it.
+TEXT ·BarrierLoadLoad(SB), NOSPLIT, $0-0
+	RET
+
+TEXT ·BarrierLoadStore(SB), NOSPLIT, $0-0
+	RET
+
+TEXT ·BarrierStoreStore(SB), NOSPLIT, $0-0
+	RET
+
+// store→load is the one reordering TSO permits
+TEXT ·BarrierStoreLoad(SB), NOSPLIT, $0-0
+	MFENCE
+	RET
+
+// SFENCE drains write-combining buffers so non-temporal (MOVNT*) stores
+// become visible before later stores
+TEXT ·BarrierNonTemporal(SB), NOSPLIT, $0-0
+	SFENCE
+	RET
+
+TEXT ·BarrierCompiler(SB), NOSPLIT, $0-0
+	RET
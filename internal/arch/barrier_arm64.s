@@ -0,0 +1,56 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64
+
+#include "textflag.h"
+
+// =============================================================================
+// Barrier operations
+// =============================================================================
+
+TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
+	DMB	$0x9
+	RET
+
+TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
+	DMB	$0xA
+	RET
+
+TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
+	DMB	$0xB
+	RET
+
+// DMB ISH orders all prior accesses before all later ones
+TEXT ·BarrierSeqCst(SB), NOSPLIT, $0-0
+	DMB	$0xB
+	RET
+
+// DMB ISHLD orders prior loads before later loads and stores
+TEXT ·BarrierLoadLoad(SB), NOSPLIT, $0-0
+	DMB	$0x9
+	RET
+
+TEXT ·BarrierLoadStore(SB), NOSPLIT, $0-0
+	DMB	$0x9
+	RET
+
+// DMB ISHST orders prior stores before later stores only
+TEXT ·BarrierStoreStore(SB), NOSPLIT, $0-0
+	DMB	$0xA
+	RET
+
+// No DMB variant orders store→load alone; it needs DMB ISH
+TEXT ·BarrierStoreLoad(SB), NOSPLIT, $0-0
+	DMB	$0xB
+	RET
+
+// STNP is ordered like any other store by DMB ISHST
+TEXT ·BarrierNonTemporal(SB), NOSPLIT, $0-0
+	DMB	$0xA
+	RET
+
+// Compiler barrier: the opaque call emits no fence instruction
+TEXT ·BarrierCompiler(SB), NOSPLIT, $0-0
+	RET
@@ -0,0 +1,76 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 && !arm64 && !riscv64 && !loong64
+
+package arch
+
+import "sync/atomic"
+
+// =============================================================================
+// Memory Barriers
+// =============================================================================
+
+// Without assembly, every barrier is a sequentially consistent
+// read-modify-write on seqCstFence, which sync/atomic implements with the
+// platform's full fence. The barriers also order plain accesses, such as
+// ring slots and device memory, so none of them may be a no-op, and none is
+// inlined, so the compiler cannot move memory accesses across the call.
+
+// seqCstFence is the target of the barrier read-modify-write; its value is
+// irrelevant.
+var seqCstFence uint32
+
+//go:noinline
+func BarrierAcquire() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+//go:noinline
+func BarrierRelease() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+//go:noinline
+func BarrierAcqRel() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+// BarrierSeqCst orders all prior memory operations before all later ones.
+//
+//go:noinline
+func BarrierSeqCst() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+//go:noinline
+func BarrierLoadLoad() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+//go:noinline
+func BarrierLoadStore() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+//go:noinline
+func BarrierStoreStore() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+//go:noinline
+func BarrierStoreLoad() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+//go:noinline
+func BarrierNonTemporal() {
+	atomic.AddUint32(&seqCstFence, 0)
+}
+
+// BarrierCompiler must not be inlined: the call itself is what keeps the
+// compiler from moving memory accesses across it.
+//
+//go:noinline
+func BarrierCompiler() {}
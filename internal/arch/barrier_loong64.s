@@ -0,0 +1,63 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build loong64
+
+#include "textflag.h"
+
+// ============================================================================
+// Barrier Operations
+// ============================================================================
+
+// func BarrierAcquire()
+TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
+	DBAR	$0x14
+	RET
+
+// func BarrierRelease()
+TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
+	DBAR	$0x12
+	RET
+
+// func BarrierAcqRel()
+TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
+	DBAR
+	RET
+
+// func BarrierSeqCst()
+TEXT ·BarrierSeqCst(SB), NOSPLIT, $0-0
+	DBAR
+	RET
+
+// DBAR hint bits (ordering form): bit 4 set, then a set bit in 3/2/1/0
+// excludes prior loads / prior stores / later loads / later stores.
+
+// func BarrierLoadLoad()
+TEXT ·BarrierLoadLoad(SB), NOSPLIT, $0-0
+	DBAR	$0x15
+	RET
+
+// func BarrierLoadStore()
+TEXT ·BarrierLoadStore(SB), NOSPLIT, $0-0
+	DBAR	$0x16
+	RET
+
+// func BarrierStoreStore()
+TEXT ·BarrierStoreStore(SB), NOSPLIT, $0-0
+	DBAR	$0x1A
+	RET
+
+// func BarrierStoreLoad()
+TEXT ·BarrierStoreLoad(SB), NOSPLIT, $0-0
+	DBAR	$0x19
+	RET
+
+// func BarrierNonTemporal()
+TEXT ·BarrierNonTemporal(SB), NOSPLIT, $0-0
+	DBAR	$0x1A
+	RET
+
+// func BarrierCompiler()
+TEXT ·BarrierCompiler(SB), NOSPLIT, $0-0
+	RET
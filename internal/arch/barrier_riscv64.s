@@ -0,0 +1,63 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build riscv64
+
+#include "textflag.h"
+
+// ============================================================================
+// Barrier Operations
+// ============================================================================
+
+// func BarrierAcquire()
+TEXT ·BarrierAcquire(SB), NOSPLIT, $0-0
+	FENCE
+	RET
+
+// func BarrierRelease()
+TEXT ·BarrierRelease(SB), NOSPLIT, $0-0
+	FENCE
+	RET
+
+// func BarrierAcqRel()
+TEXT ·BarrierAcqRel(SB), NOSPLIT, $0-0
+	FENCE
+	RET
+
+// func BarrierSeqCst()
+TEXT ·BarrierSeqCst(SB), NOSPLIT, $0-0
+	FENCE
+	RET
+
+// The Go assembler's FENCE is FENCE rw,rw; the narrower forms are encoded
+// directly (opcode MISC-MEM, pred in bits 27:24, succ in bits 23:20).
+
+// func BarrierLoadLoad()
+TEXT ·BarrierLoadLoad(SB), NOSPLIT, $0-0
+	WORD	$0x0220000f // FENCE r,r
+	RET
+
+// func BarrierLoadStore()
+TEXT ·BarrierLoadStore(SB), NOSPLIT, $0-0
+	WORD	$0x0210000f // FENCE r,w
+	RET
+
+// func BarrierStoreStore()
+TEXT ·BarrierStoreStore(SB), NOSPLIT, $0-0
+	WORD	$0x0110000f // FENCE w,w
+	RET
+
+// func BarrierStoreLoad()
+TEXT ·BarrierStoreLoad(SB), NOSPLIT, $0-0
+	WORD	$0x0120000f // FENCE w,r
+	RET
+
+// func BarrierNonTemporal()
+TEXT ·BarrierNonTemporal(SB), NOSPLIT, $0-0
+	WORD	$0x0110000f // FENCE w,w
+	RET
+
+// func BarrierCompiler()
+TEXT ·BarrierCompiler(SB), NOSPLIT, $0-0
+	RET
@@ -0,0 +1,199 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 || race
+
+package arch
+
+import "math/bits"
+
+// Single-bit operations are the Or/And/Xor read-modify-writes with a one-bit
+// mask: LDSET/LDCLR/LDEOR on arm64, AMOOR/AMOAND/AMOXOR on riscv64,
+// AMOR/AMAND/AMXOR on loong64. The bit index is reduced modulo the width.
+
+// BitSet
+
+func BitSetUint32Relaxed(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return OrUint32Relaxed(addr, mask)&mask != 0
+}
+
+func BitSetUint32Acquire(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return OrUint32Acquire(addr, mask)&mask != 0
+}
+
+func BitSetUint32Release(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return OrUint32Release(addr, mask)&mask != 0
+}
+
+func BitSetUint32AcqRel(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return OrUint32AcqRel(addr, mask)&mask != 0
+}
+
+func BitSetUint64Relaxed(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return OrUint64Relaxed(addr, mask)&mask != 0
+}
+
+func BitSetUint64Acquire(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return OrUint64Acquire(addr, mask)&mask != 0
+}
+
+func BitSetUint64Release(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return OrUint64Release(addr, mask)&mask != 0
+}
+
+func BitSetUint64AcqRel(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return OrUint64AcqRel(addr, mask)&mask != 0
+}
+
+func BitSetUintptrRelaxed(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return OrUintptrRelaxed(addr, mask)&mask != 0
+}
+
+func BitSetUintptrAcquire(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return OrUintptrAcquire(addr, mask)&mask != 0
+}
+
+func BitSetUintptrRelease(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return OrUintptrRelease(addr, mask)&mask != 0
+}
+
+func BitSetUintptrAcqRel(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return OrUintptrAcqRel(addr, mask)&mask != 0
+}
+
+// BitClear
+
+func BitClearUint32Relaxed(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return AndUint32Relaxed(addr, ^mask)&mask != 0
+}
+
+func BitClearUint32Acquire(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return AndUint32Acquire(addr, ^mask)&mask != 0
+}
+
+func BitClearUint32Release(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return AndUint32Release(addr, ^mask)&mask != 0
+}
+
+func BitClearUint32AcqRel(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return AndUint32AcqRel(addr, ^mask)&mask != 0
+}
+
+func BitClearUint64Relaxed(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return AndUint64Relaxed(addr, ^mask)&mask != 0
+}
+
+func BitClearUint64Acquire(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return AndUint64Acquire(addr, ^mask)&mask != 0
+}
+
+func BitClearUint64Release(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return AndUint64Release(addr, ^mask)&mask != 0
+}
+
+func BitClearUint64AcqRel(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return AndUint64AcqRel(addr, ^mask)&mask != 0
+}
+
+func BitClearUintptrRelaxed(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return AndUintptrRelaxed(addr, ^mask)&mask != 0
+}
+
+func BitClearUintptrAcquire(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return AndUintptrAcquire(addr, ^mask)&mask != 0
+}
+
+func BitClearUintptrRelease(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return AndUintptrRelease(addr, ^mask)&mask != 0
+}
+
+func BitClearUintptrAcqRel(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return AndUintptrAcqRel(addr, ^mask)&mask != 0
+}
+
+// BitToggle
+
+func BitToggleUint32Relaxed(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return XorUint32Relaxed(addr, mask)&mask != 0
+}
+
+func BitToggleUint32Acquire(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return XorUint32Acquire(addr, mask)&mask != 0
+}
+
+func BitToggleUint32Release(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return XorUint32Release(addr, mask)&mask != 0
+}
+
+func BitToggleUint32AcqRel(addr *uint32, bit uint) bool {
+	mask := uint32(1) << (bit & 31)
+	return XorUint32AcqRel(addr, mask)&mask != 0
+}
+
+func BitToggleUint64Relaxed(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return XorUint64Relaxed(addr, mask)&mask != 0
+}
+
+func BitToggleUint64Acquire(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return XorUint64Acquire(addr, mask)&mask != 0
+}
+
+func BitToggleUint64Release(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return XorUint64Release(addr, mask)&mask != 0
+}
+
+func BitToggleUint64AcqRel(addr *uint64, bit uint) bool {
+	mask := uint64(1) << (bit & 63)
+	return XorUint64AcqRel(addr, mask)&mask != 0
+}
+
+func BitToggleUintptrRelaxed(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return XorUintptrRelaxed(addr, mask)&mask != 0
+}
+
+func BitToggleUintptrAcquire(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return XorUintptrAcquire(addr, mask)&mask != 0
+}
+
+func BitToggleUintptrRelease(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return XorUintptrRelease(addr, mask)&mask != 0
+}
+
+func BitToggleUintptrAcqRel(addr *uintptr, bit uint) bool {
+	mask := uintptr(1) << (bit & (bits.UintSize - 1))
+	return XorUintptrAcqRel(addr, mask)&mask != 0
+}
@@ -0,0 +1,13 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race && !lse2 && !nolse2
+
+package arch
+
+// hasLSE2 selects the CASP implementations in asm_arm64_128.s over the
+// LDXP/STXP loops. CASP needs LSE, and its plain LDP/STP loads and stores
+// need LSE2, so -tags=nolse turns it off too. -tags=lse2 and -tags=nolse2
+// replace the detection with a fixed answer.
+var hasLSE2 = hasLSE && DetectLSE2()
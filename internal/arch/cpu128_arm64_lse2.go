@@ -0,0 +1,10 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race && lse2 && !nolse2
+
+package arch
+
+// hasLSE2 is fixed by -tags=lse2: the binary requires ARMv8.4 or later.
+var hasLSE2 = true
@@ -0,0 +1,11 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race && nolse2
+
+package arch
+
+// hasLSE2 is fixed by -tags=nolse2: 128-bit operations use the LDXP/STXP
+// loops, even on cores that implement LSE2.
+var hasLSE2 = false
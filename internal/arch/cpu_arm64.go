@@ -0,0 +1,12 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race && !lse && !nolse
+
+package arch
+
+// hasLSE selects the LSE instructions in asm_arm64.s over the exclusive
+// load/store loops. The assembly reads it as a byte; -tags=lse and
+// -tags=nolse replace the detection with a fixed answer.
+var hasLSE = DetectLSE()
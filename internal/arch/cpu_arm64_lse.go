@@ -0,0 +1,10 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race && lse && !nolse
+
+package arch
+
+// hasLSE is fixed by -tags=lse: the binary requires ARMv8.1 or later.
+var hasLSE = true
@@ -0,0 +1,11 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race && nolse
+
+package arch
+
+// hasLSE is fixed by -tags=nolse: every read-modify-write uses the exclusive
+// load/store loops, even on cores that implement LSE.
+var hasLSE = false
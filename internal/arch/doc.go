@@ -18,7 +18,9 @@
 //   - loong64: LoongArch 64-bit with LL/SC atomics
 //
 // All other architectures fall back to sync/atomic which provides sequential
-// consistency (equivalent to AcqRel ordering).
+// consistency (equivalent to AcqRel ordering). Builds with -race use the
+// sync/atomic backend on every architecture, so the race detector sees each
+// access; 128-bit operations then take a striped mutex.
 //
 // # Memory Ordering
 //
@@ -46,7 +48,9 @@
 //	- Relaxed Load/Store: Plain memory access (inlinable pure Go)
 //	- Acquire Load: LDAR instruction (assembly)
 //	- Release Store: STLR instruction (assembly)
-//	- RMW operations: LSE instructions with ordering (assembly)
+//	- RMW operations: LSE instructions with ordering (assembly), or
+//	  LDXR/STXR loops with the same ordering on cores without LSE; the
+//	  choice is made at startup (cpu_arm64.go, -tags=lse/nolse)
 //
 // RISC-V64 / LoongArch64:
 //
@@ -59,8 +63,11 @@
 //
 // 128-bit operations are available on all supported architectures:
 //   - amd64: CMPXCHG16B instruction (requires 16-byte alignment)
-//   - arm64: LDXP/STXP (default) or CASP with -tags=lse2 for ARMv8.4+
-//   - riscv64/loong64: Emulated via LL/SC on low 64 bits with full barrier
+//   - arm64: CASP on ARMv8.4+ with LSE2, else LDXP/STXP, chosen at startup
+//     (cpu128_arm64.go, -tags=lse2/nolse2);
+//     And/AndNot/Or use LDCLRP/LDSETP with -tags=lse128 for ARMv9.4+
+//   - riscv64/loong64, other architectures and race builds: a mutex from
+//     the address-hashed table in lock128.go, taken by every operation
 //
 // # Inlining Optimization
 //
@@ -78,10 +85,10 @@
 //	<Op><Type><Ordering>
 //
 // Where:
-//   - Op: Load, Store, Swap, Cas, Cax, Add, And, Or, Xor
+//   - Op: Load, Store, Swap, Cas, Cax, Add, And, AndNot, Or, Xor
 //   - Type: Int32, Uint32, Int64, Uint64, Uintptr, Pointer, Uint128
 //   - Ordering: Relaxed, Acquire, Release, AcqRel
 //
 // Cas returns bool (success), Cax returns old value (compare-exchange).
-// Add returns the new value. Swap/And/Or/Xor return the previous value.
+// Add returns the new value. Swap/And/AndNot/Or/Xor return the previous value.
 package arch
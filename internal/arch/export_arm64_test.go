@@ -0,0 +1,23 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race
+
+package arch
+
+// SetLSE selects the LSE or the exclusive load/store path of asm_arm64.s
+// and returns a function that restores the previous choice.
+func SetLSE(on bool) (restore func()) {
+	old := hasLSE
+	hasLSE = on
+	return func() { hasLSE = old }
+}
+
+// SetLSE2 selects the CASP or the LDXP/STXP path of asm_arm64_128.s and
+// returns a function that restores the previous choice.
+func SetLSE2(on bool) (restore func()) {
+	old := hasLSE2
+	hasLSE2 = on
+	return func() { hasLSE2 = old }
+}
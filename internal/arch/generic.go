@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build !amd64 && !arm64 && !riscv64 && !loong64
+//go:build (!amd64 && !arm64 && !riscv64 && !loong64) || race
 
 package arch
 
@@ -14,17 +14,19 @@ import (
 // Generic fallback implementation using sync/atomic.
 //
 // This file provides atomic operations for architectures without optimized
-// assembly implementations (e.g., 386, ppc64, s390x, wasm).
+// assembly implementations (e.g., 386, ppc64, s390x, wasm), and for every
+// architecture under -race: the race detector instruments sync/atomic but
+// sees neither assembly nor plain loads, so a race build reports each
+// operation through sync/atomic with its acquire/release edges.
 //
 // Implementation characteristics:
 //   - All operations use sync/atomic which provides sequential consistency
 //   - Memory ordering variants (Relaxed, Acquire, Release, AcqRel) are
 //     all equivalent since sync/atomic doesn't expose weaker orderings
-//   - 128-bit operations are NOT atomic (no hardware support)
-//
-// WARNING: 128-bit operations on unsupported architectures are implemented
-// as non-atomic read/write sequences. They must not be used in concurrent
-// contexts without external synchronization.
+//   - 128-bit operations live in lock128.go, a striped lock table that
+//     riscv64 and loong64 share
+//   - Memory barriers live in barrier_*.go and barrier_*.s, which race
+//     builds share with release builds
 
 // =============================================================================
 // 32-bit Signed Integer Operations
@@ -876,109 +878,3 @@ func CaxPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Poi
 func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer {
 	return CaxPointerRelaxed(addr, old, new)
 }
-
-// =============================================================================
-// 128-bit Operations (NOT ATOMIC!)
-// =============================================================================
-
-// WARNING: 128-bit operations are NOT concurrency-safe on generic architectures.
-// These are simple sequential read/write operations without atomicity guarantees.
-// Use external synchronization when accessing 128-bit values concurrently.
-
-func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64) {
-	lo = *(*uint64)(unsafe.Pointer(addr))
-	hi = *(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8))
-	return
-}
-
-func LoadUint128Acquire(addr *[16]byte) (lo, hi uint64) {
-	return LoadUint128Relaxed(addr)
-}
-
-func StoreUint128Relaxed(addr *[16]byte, lo, hi uint64) {
-	*(*uint64)(unsafe.Pointer(addr)) = lo
-	*(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8)) = hi
-}
-
-func StoreUint128Release(addr *[16]byte, lo, hi uint64) {
-	StoreUint128Relaxed(addr, lo, hi)
-}
-
-func SwapUint128Relaxed(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
-	oldLo = *(*uint64)(unsafe.Pointer(addr))
-	oldHi = *(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8))
-	*(*uint64)(unsafe.Pointer(addr)) = newLo
-	*(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8)) = newHi
-	return
-}
-
-func SwapUint128Acquire(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return SwapUint128Relaxed(addr, newLo, newHi)
-}
-
-func SwapUint128Release(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return SwapUint128Relaxed(addr, newLo, newHi)
-}
-
-func SwapUint128AcqRel(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return SwapUint128Relaxed(addr, newLo, newHi)
-}
-
-func CasUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
-	curLo := *(*uint64)(unsafe.Pointer(addr))
-	curHi := *(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8))
-	if curLo == oldLo && curHi == oldHi {
-		*(*uint64)(unsafe.Pointer(addr)) = newLo
-		*(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8)) = newHi
-		return true
-	}
-	return false
-}
-
-func CasUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
-	return CasUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
-}
-
-func CasUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
-	return CasUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
-}
-
-func CasUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
-	return CasUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
-}
-
-func CaxUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	lo = *(*uint64)(unsafe.Pointer(addr))
-	hi = *(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8))
-	if lo == oldLo && hi == oldHi {
-		*(*uint64)(unsafe.Pointer(addr)) = newLo
-		*(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8)) = newHi
-	}
-	return
-}
-
-func CaxUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return CaxUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
-}
-
-func CaxUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return CaxUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
-}
-
-func CaxUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return CaxUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
-}
-
-// =============================================================================
-// Memory Barriers
-// =============================================================================
-
-// Barriers are no-ops on generic platforms.
-// sync/atomic provides sequential consistency, which is stronger than
-// any acquire/release barrier requirement.
-
-func BarrierAcquire() {}
-
-func BarrierRelease() {}
-
-func BarrierAcqRel() {}
@@ -0,0 +1,83 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race
+
+package arch
+
+import (
+	"runtime"
+	_ "unsafe" // for go:linkname
+)
+
+// Auxiliary vector keys and HWCAP bits, from the Linux and FreeBSD ELF
+// headers. The HWCAP bits are the same on both.
+const (
+	_AT_HWCAP_linux   = 16
+	_AT_HWCAP_freebsd = 25
+	_HWCAP_ATOMICS    = 1 << 8
+	_HWCAP_USCAT      = 1 << 25
+)
+
+// getAuxv returns the auxiliary vector the kernel passed to the process.
+//
+//go:linkname getAuxv runtime.getAuxv
+func getAuxv() []uintptr
+
+// hwcap returns the AT_HWCAP word, or 0 where the kernel does not report one.
+func hwcap() uintptr {
+	var key uintptr
+	switch runtime.GOOS {
+	case "linux", "android":
+		key = _AT_HWCAP_linux
+	case "freebsd":
+		key = _AT_HWCAP_freebsd
+	default:
+		return 0
+	}
+	auxv := getAuxv()
+	for i := 0; i+1 < len(auxv); i += 2 {
+		if auxv[i] == key {
+			return auxv[i+1]
+		}
+	}
+	return 0
+}
+
+// DetectLSE reports whether the CPU implements the ARMv8.1 Large System
+// Extensions (FEAT_LSE): CAS, CASP, SWP and the LD<op> instructions.
+//
+// Linux and FreeBSD report it in AT_HWCAP; every Mac with an arm64 core
+// implements it. Elsewhere, including iOS, whose oldest supported devices
+// are ARMv8.0, DetectLSE answers false, which is always safe.
+func DetectLSE() bool {
+	if runtime.GOOS == "darwin" {
+		return true
+	}
+	return hwcap()&_HWCAP_ATOMICS != 0
+}
+
+// DetectLSE2 reports whether the CPU implements FEAT_LSE2 (ARMv8.4), which
+// makes 16-byte aligned LDP and STP single-copy atomic. The kernel reports
+// it as HWCAP_USCAT; every Mac with an arm64 core implements it.
+func DetectLSE2() bool {
+	if runtime.GOOS == "darwin" {
+		return true
+	}
+	return hwcap()&_HWCAP_USCAT != 0
+}
+
+// LSE reports whether read-modify-write operations use LSE instructions
+// rather than exclusive load/store loops.
+func LSE() bool {
+	return hasLSE
+}
+
+// Variant128 names the implementation of the 128-bit operations.
+func Variant128() string {
+	if hasLSE2 {
+		return "CASP"
+	}
+	return "LDXP/STXP"
+}
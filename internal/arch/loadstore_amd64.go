@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build amd64
+//go:build amd64 && !race
 
 package arch
 
@@ -24,7 +24,8 @@ import "unsafe"
 // inlinable. The intrinsics compiler intercepts them at SSA level to ensure
 // atomic semantics. Without intrinsics, these are plain memory accesses —
 // correct on x86-64 TSO hardware but not recognized as atomic by the Go
-// memory model or race detector.
+// memory model or race detector, which is why race builds use generic.go
+// instead.
 
 // =============================================================================
 // 32-bit Load operations
@@ -171,3 +172,47 @@ func StorePointerRelaxed(addr *unsafe.Pointer, val unsafe.Pointer) {
 func StorePointerRelease(addr *unsafe.Pointer, val unsafe.Pointer) {
 	*addr = val
 }
+
+// =============================================================================
+// 8-bit and 16-bit Load/Store operations
+// =============================================================================
+
+// LoadUint8Relaxed atomically loads *addr with relaxed memory ordering.
+func LoadUint8Relaxed(addr *uint8) uint8 {
+	return *addr
+}
+
+// LoadUint8Acquire atomically loads *addr with acquire memory ordering.
+func LoadUint8Acquire(addr *uint8) uint8 {
+	return *addr
+}
+
+// StoreUint8Relaxed atomically stores val to *addr with relaxed memory ordering.
+func StoreUint8Relaxed(addr *uint8, val uint8) {
+	*addr = val
+}
+
+// StoreUint8Release atomically stores val to *addr with release memory ordering.
+func StoreUint8Release(addr *uint8, val uint8) {
+	*addr = val
+}
+
+// LoadUint16Relaxed atomically loads *addr with relaxed memory ordering.
+func LoadUint16Relaxed(addr *uint16) uint16 {
+	return *addr
+}
+
+// LoadUint16Acquire atomically loads *addr with acquire memory ordering.
+func LoadUint16Acquire(addr *uint16) uint16 {
+	return *addr
+}
+
+// StoreUint16Relaxed atomically stores val to *addr with relaxed memory ordering.
+func StoreUint16Relaxed(addr *uint16, val uint16) {
+	*addr = val
+}
+
+// StoreUint16Release atomically stores val to *addr with release memory ordering.
+func StoreUint16Release(addr *uint16, val uint16) {
+	*addr = val
+}
@@ -2,11 +2,14 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build arm64
+//go:build arm64 && !race
 
 package arch
 
-import "unsafe"
+import (
+	"sync/atomic"
+	"unsafe"
+)
 
 // ARM64 Memory Model:
 //
@@ -138,3 +141,41 @@ func LoadPointerRelaxed(addr *unsafe.Pointer) unsafe.Pointer {
 func StorePointerRelaxed(addr *unsafe.Pointer, val unsafe.Pointer) {
 	*addr = val
 }
+
+// StorePointerRelease atomically stores val to *addr with release memory ordering.
+// sync/atomic emits STLR and runs the write barrier that assembly would skip.
+func StorePointerRelease(addr *unsafe.Pointer, val unsafe.Pointer) {
+	atomic.StorePointer(addr, val)
+}
+
+// =============================================================================
+// 8-bit and 16-bit Load/Store operations (inlinable)
+// =============================================================================
+
+// LoadUint8Relaxed atomically loads *addr with relaxed memory ordering.
+//
+//go:nosplit
+func LoadUint8Relaxed(addr *uint8) uint8 {
+	return *addr
+}
+
+// StoreUint8Relaxed atomically stores val to *addr with relaxed memory ordering.
+//
+//go:nosplit
+func StoreUint8Relaxed(addr *uint8, val uint8) {
+	*addr = val
+}
+
+// LoadUint16Relaxed atomically loads *addr with relaxed memory ordering.
+//
+//go:nosplit
+func LoadUint16Relaxed(addr *uint16) uint16 {
+	return *addr
+}
+
+// StoreUint16Relaxed atomically stores val to *addr with relaxed memory ordering.
+//
+//go:nosplit
+func StoreUint16Relaxed(addr *uint16, val uint16) {
+	*addr = val
+}
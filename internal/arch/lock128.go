@@ -0,0 +1,137 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build (!amd64 && !arm64) || race
+
+package arch
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// 128-bit operations without a native 16-byte atomic.
+//
+// riscv64, loong64 and the sync/atomic fallback architectures have no
+// instruction that reads or writes 16 bytes atomically, and race builds must
+// use operations the race detector can see. These backends guard each
+// 16-byte slot with one mutex of a table, chosen by address. Every 128-bit
+// operation, loads included, holds the slot's mutex for both halves, so no
+// reader sees half of a write. The lock and unlock order the operation at
+// least as strongly as any ordering suffix asks for.
+//
+// Race builds also guard 8-bit and 16-bit values with the table (see
+// subword_race.go).
+//
+// The table lives in process memory: a slot shared with another process,
+// or written by anything but these functions, is not protected.
+
+// LockFree128 reports whether 128-bit operations are single-instruction atomic.
+const LockFree128 = false
+
+// Variant128 names the implementation of the 128-bit operations.
+func Variant128() string {
+	return "lock table"
+}
+
+// lock128 is the mutex table; entries are padded to avoid false sharing.
+var lock128 [64]struct {
+	sync.Mutex
+	_ [64 - unsafe.Sizeof(sync.Mutex{})]byte
+}
+
+// lockFor returns the mutex guarding the slot at addr.
+func lockFor(addr *[16]byte) *sync.Mutex {
+	return &lock128[uintptr(unsafe.Pointer(addr))>>4%uintptr(len(lock128))].Mutex
+}
+
+func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64) {
+	mu := lockFor(addr)
+	mu.Lock()
+	lo = *(*uint64)(unsafe.Pointer(addr))
+	hi = *(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8))
+	mu.Unlock()
+	return
+}
+
+func LoadUint128Acquire(addr *[16]byte) (lo, hi uint64) {
+	return LoadUint128Relaxed(addr)
+}
+
+func StoreUint128Relaxed(addr *[16]byte, lo, hi uint64) {
+	mu := lockFor(addr)
+	mu.Lock()
+	*(*uint64)(unsafe.Pointer(addr)) = lo
+	*(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8)) = hi
+	mu.Unlock()
+}
+
+func StoreUint128Release(addr *[16]byte, lo, hi uint64) {
+	StoreUint128Relaxed(addr, lo, hi)
+}
+
+func SwapUint128Relaxed(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
+	mu := lockFor(addr)
+	mu.Lock()
+	oldLo = *(*uint64)(unsafe.Pointer(addr))
+	oldHi = *(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8))
+	*(*uint64)(unsafe.Pointer(addr)) = newLo
+	*(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8)) = newHi
+	mu.Unlock()
+	return
+}
+
+func SwapUint128Acquire(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
+	return SwapUint128Relaxed(addr, newLo, newHi)
+}
+
+func SwapUint128Release(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
+	return SwapUint128Relaxed(addr, newLo, newHi)
+}
+
+func SwapUint128AcqRel(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
+	return SwapUint128Relaxed(addr, newLo, newHi)
+}
+
+func CasUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
+	lo, hi := CaxUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
+	return lo == oldLo && hi == oldHi
+}
+
+func CasUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
+	return CasUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
+}
+
+func CasUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
+	return CasUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
+}
+
+func CasUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
+	return CasUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
+}
+
+func CaxUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
+	mu := lockFor(addr)
+	mu.Lock()
+	lo = *(*uint64)(unsafe.Pointer(addr))
+	hi = *(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8))
+	if lo == oldLo && hi == oldHi {
+		*(*uint64)(unsafe.Pointer(addr)) = newLo
+		*(*uint64)(unsafe.Add(unsafe.Pointer(addr), 8)) = newHi
+	}
+	mu.Unlock()
+	return
+}
+
+func CaxUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
+	return CaxUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
+}
+
+func CaxUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
+	return CaxUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
+}
+
+func CaxUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
+	return CaxUint128Relaxed(addr, oldLo, oldHi, newLo, newHi)
+}
@@ -0,0 +1,344 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race
+
+package arch_test
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// =============================================================================
+// LSE Dispatch Tests
+// =============================================================================
+
+// lsePaths returns the read-modify-write paths this CPU can run: always the
+// exclusive load/store loops, and LSE when the CPU implements it.
+func lsePaths(t *testing.T) []bool {
+	t.Logf("DetectLSE=%v LSE=%v DetectLSE2=%v Variant128=%s",
+		arch.DetectLSE(), arch.LSE(), arch.DetectLSE2(), arch.Variant128())
+	if arch.DetectLSE() {
+		return []bool{false, true}
+	}
+	return []bool{false}
+}
+
+func lsePathName(on bool) string {
+	if on {
+		return "lse"
+	}
+	return "llsc"
+}
+
+// TestLSEPaths reruns the single-threaded operation tests on each path.
+func TestLSEPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(*testing.T)
+	}{
+		{"SwapInt32", TestSwapInt32}, {"SwapUint32", TestSwapUint32},
+		{"SwapInt64", TestSwapInt64}, {"SwapUint64", TestSwapUint64},
+		{"SwapUintptr", TestSwapUintptr},
+		{"CasInt32", TestCasInt32}, {"CasUint32", TestCasUint32},
+		{"CasInt64", TestCasInt64}, {"CasUint64", TestCasUint64},
+		{"CasUintptr", TestCasUintptr},
+		{"CaxInt32", TestCaxInt32}, {"CaxUint32", TestCaxUint32},
+		{"CaxInt64", TestCaxInt64}, {"CaxUint64", TestCaxUint64},
+		{"CaxUintptr", TestCaxUintptr},
+		{"AddInt32", TestAddInt32}, {"AddUint32", TestAddUint32},
+		{"AddInt64", TestAddInt64}, {"AddUint64", TestAddUint64},
+		{"AddUintptr", TestAddUintptr},
+		{"BitOps", TestBitOps},
+		{"SubwordUint8", TestSubwordUint8}, {"SubwordUint16", TestSubwordUint16},
+		{"Bitwise", testBitwise},
+	}
+	for _, on := range lsePaths(t) {
+		t.Run(lsePathName(on), func(t *testing.T) {
+			defer arch.SetLSE(on)()
+			for _, tt := range tests {
+				t.Run(tt.name, tt.f)
+			}
+		})
+	}
+}
+
+// testBitwise checks Or, And and Xor at every width and ordering; the
+// single-threaded tests above do not cover them.
+func testBitwise(t *testing.T) {
+	var w uint32 = 0x0F
+	checks32 := []struct {
+		name string
+		op   func() uint32
+		old  uint32
+	}{
+		{"OrUint32Relaxed", func() uint32 { return arch.OrUint32Relaxed(&w, 0x30) }, 0x0F},
+		{"OrUint32Acquire", func() uint32 { return arch.OrUint32Acquire(&w, 0x100) }, 0x3F},
+		{"AndUint32Release", func() uint32 { return arch.AndUint32Release(&w, 0x1F0) }, 0x13F},
+		{"AndUint32AcqRel", func() uint32 { return arch.AndUint32AcqRel(&w, 0x130) }, 0x130},
+		{"XorUint32Acquire", func() uint32 { return arch.XorUint32Acquire(&w, 0xFFFF) }, 0x130},
+		{"XorUint32Release", func() uint32 { return arch.XorUint32Release(&w, 0xFFFF0000) }, 0xFECF},
+	}
+	for _, c := range checks32 {
+		if old := c.op(); old != c.old {
+			t.Fatalf("%s: got %#x, want %#x", c.name, old, c.old)
+		}
+	}
+	if w != 0xFFFFFECF {
+		t.Fatalf("32-bit result: got %#x, want 0xFFFFFECF", w)
+	}
+
+	var d uint64 = 1
+	checks64 := []struct {
+		name string
+		op   func() uint64
+		old  uint64
+	}{
+		{"OrUint64AcqRel", func() uint64 { return arch.OrUint64AcqRel(&d, 1<<62) }, 1},
+		{"AndUint64Relaxed", func() uint64 { return arch.AndUint64Relaxed(&d, 1<<62|2) }, 1<<62 | 1},
+		{"XorUint64Relaxed", func() uint64 { return arch.XorUint64Relaxed(&d, 3) }, 1 << 62},
+		{"OrUint64Release", func() uint64 { return arch.OrUint64Release(&d, 4) }, 1<<62 | 3},
+		{"AndUint64Acquire", func() uint64 { return arch.AndUint64Acquire(&d, ^uint64(1)) }, 1<<62 | 7},
+		{"XorUint64AcqRel", func() uint64 { return arch.XorUint64AcqRel(&d, 1<<63) }, 1<<62 | 6},
+	}
+	for _, c := range checks64 {
+		if old := c.op(); old != c.old {
+			t.Fatalf("%s: got %#x, want %#x", c.name, old, c.old)
+		}
+	}
+	if d != 1<<63|1<<62|6 {
+		t.Fatalf("64-bit result: got %#x, want %#x", d, uint64(1<<63|1<<62|6))
+	}
+}
+
+// TestLSEPathsConcurrent contends on each width with every operation kind,
+// so the exclusive loops must retry when another core takes the line.
+func TestLSEPathsConcurrent(t *testing.T) {
+	const workers, iterations = 4, 5000
+	for _, on := range lsePaths(t) {
+		t.Run(lsePathName(on), func(t *testing.T) {
+			defer arch.SetLSE(on)()
+			var (
+				sum   uint64
+				cas   uint32
+				bits  uint16
+				bytes [workers]uint8
+				wg    sync.WaitGroup
+			)
+			for w := range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range iterations {
+						arch.AddUint64AcqRel(&sum, 1)
+						for {
+							v := arch.LoadUint32Acquire(&cas)
+							if arch.CasUint32AcqRel(&cas, v, v+1) {
+								break
+							}
+						}
+						bit := uint16(1) << (w*4 + i%4)
+						arch.XorUint16AcqRel(&bits, bit)
+						arch.AddUint8Relaxed(&bytes[w], 1)
+					}
+				}()
+			}
+			wg.Wait()
+			if sum != workers*iterations || cas != workers*iterations {
+				t.Fatalf("sum=%d cas=%d, want %d", sum, cas, workers*iterations)
+			}
+			// Each bit was toggled iterations/4 times, an even number.
+			if bits != 0 {
+				t.Fatalf("bits: got %#x, want 0", bits)
+			}
+			for w, b := range bytes {
+				if b != uint8(iterations%256) {
+					t.Fatalf("byte %d: got %d, want %d", w, b, iterations%256)
+				}
+			}
+		})
+	}
+}
+
+// lse2Paths returns the 128-bit paths this CPU can run: always LDXP/STXP,
+// and CASP when the CPU implements LSE and LSE2.
+func lse2Paths() []bool {
+	if arch.DetectLSE() && arch.DetectLSE2() {
+		return []bool{false, true}
+	}
+	return []bool{false}
+}
+
+func lse2PathName(on bool) string {
+	if on {
+		return "casp"
+	}
+	return "llsc"
+}
+
+func lse2Variant(on bool) string {
+	if on {
+		return "CASP"
+	}
+	return "LDXP/STXP"
+}
+
+func TestLSE2Paths(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(*testing.T)
+	}{
+		{"LoadStoreUint128", TestLoadStoreUint128},
+		{"SwapUint128", TestSwapUint128},
+		{"CasUint128", TestCasUint128},
+		{"CaxUint128", TestCaxUint128},
+		{"BitwiseUint128", TestBitwiseUint128},
+	}
+	for _, on := range lse2Paths() {
+		t.Run(lse2PathName(on), func(t *testing.T) {
+			defer arch.SetLSE2(on)()
+			if got := arch.Variant128(); got != lse2Variant(on) {
+				t.Fatalf("Variant128: got %q, want %q", got, lse2Variant(on))
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, tt.f)
+			}
+		})
+	}
+}
+
+// TestLSE2PathsConcurrent increments both halves of one slot from several
+// goroutines with CAS loops while readers check that the halves never
+// disagree.
+func TestLSE2PathsConcurrent(t *testing.T) {
+	const workers, iterations = 4, 5000
+	for _, on := range lse2Paths() {
+		t.Run(lse2PathName(on), func(t *testing.T) {
+			defer arch.SetLSE2(on)()
+			addr := newAligned16()
+			var done atomic.Bool
+			var readers sync.WaitGroup
+			readers.Add(1)
+			go func() {
+				defer readers.Done()
+				for !done.Load() {
+					if lo, hi := arch.LoadUint128Acquire(addr); lo != hi {
+						t.Errorf("torn load: lo=%d hi=%d", lo, hi)
+						return
+					}
+				}
+			}()
+			var wg sync.WaitGroup
+			for range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for range iterations {
+						for {
+							lo, hi := arch.LoadUint128Relaxed(addr)
+							if arch.CasUint128AcqRel(addr, lo, hi, lo+1, hi+1) {
+								break
+							}
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			done.Store(true)
+			readers.Wait()
+			if lo, hi := arch.LoadUint128Acquire(addr); lo != workers*iterations || hi != lo {
+				t.Fatalf("got lo=%d hi=%d, want %d", lo, hi, workers*iterations)
+			}
+		})
+	}
+}
+
+// TestLSE2MixedSizeStoreBuffering runs the store-buffering litmus test
+//
+//	T0: x = 1 (64-bit); r0 = y (128-bit)   T1: y = 1 (128-bit); r1 = x (64-bit)
+//
+// with SeqCst stores and loads on each path and checks that both loads never
+// see 0. On the CASP path the 128-bit load needs its leading DMB ISH to stay
+// behind the 64-bit STLR.
+func TestLSE2MixedSizeStoreBuffering(t *testing.T) {
+	if runtime.GOMAXPROCS(0) < 2 || runtime.NumCPU() < 2 {
+		t.Skip("needs two CPUs running in parallel")
+	}
+	iters := uint32(200000)
+	if testing.Short() {
+		iters = 20000
+	}
+	for _, on := range lse2Paths() {
+		t.Run(lse2PathName(on), func(t *testing.T) {
+			defer arch.SetLSE2(on)()
+			var x uint64
+			y := newAligned16()
+			var epoch, done, r1 atomic.Uint32
+			go func() {
+				for i := uint32(1); i <= iters; i++ {
+					for epoch.Load() != i {
+					}
+					arch.StoreUint128SeqCst(y, 1, 1)
+					r1.Store(uint32(arch.LoadUint64SeqCst(&x)))
+					done.Store(i)
+				}
+			}()
+			forbidden := 0
+			for i := uint32(1); i <= iters; i++ {
+				arch.StoreUint64Relaxed(&x, 0)
+				arch.StoreUint128Relaxed(y, 0, 0)
+				epoch.Store(i)
+				arch.StoreUint64SeqCst(&x, 1)
+				r0, _ := arch.LoadUint128SeqCst(y)
+				for done.Load() != i {
+				}
+				if r0 == 0 && r1.Load() == 0 {
+					forbidden++
+				}
+			}
+			if forbidden != 0 {
+				t.Fatalf("store-buffering outcome r0 == r1 == 0 observed %d/%d times", forbidden, iters)
+			}
+		})
+	}
+}
+
+// BenchmarkLSE2Paths compares the CASP and LDXP/STXP paths, uncontended and
+// with every P updating the same slot.
+func BenchmarkLSE2Paths(b *testing.B) {
+	for _, on := range lse2Paths() {
+		b.Run(lse2PathName(on), func(b *testing.B) {
+			defer arch.SetLSE2(on)()
+			addr := newAligned16()
+			b.Run("LoadAcquire", func(b *testing.B) {
+				for b.Loop() {
+					arch.LoadUint128Acquire(addr)
+				}
+			})
+			b.Run("StoreRelease", func(b *testing.B) {
+				for i := uint64(0); b.Loop(); i++ {
+					arch.StoreUint128Release(addr, i, i)
+				}
+			})
+			b.Run("CasAcqRel", func(b *testing.B) {
+				for i := uint64(0); b.Loop(); i++ {
+					arch.CasUint128AcqRel(addr, i, i, i+1, i+1)
+				}
+			})
+			b.Run("CasAcqRelContended", func(b *testing.B) {
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						lo, hi := arch.LoadUint128Relaxed(addr)
+						arch.CasUint128AcqRel(addr, lo, hi, lo+1, hi+1)
+					}
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build (amd64 || arm64 || riscv64 || loong64) && !race
+
+package arch
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// Pointer read-modify-write operations.
+//
+// Storing a pointer must run the garbage collector's write barrier, and the
+// compiler must see the new pointer escape. Assembly stubs do neither, so
+// pointer RMW operations use sync/atomic, whose sequentially consistent
+// instructions satisfy every ordering (on amd64 they are the same LOCK XCHG
+// and LOCK CMPXCHG). Cax has no sync/atomic counterpart and is a load plus
+// CompareAndSwap, retried when *addr changes between the two.
+
+// SwapPointerRelaxed atomically stores new into *addr and returns the old value.
+func SwapPointerRelaxed(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer {
+	return atomic.SwapPointer(addr, new)
+}
+
+// SwapPointerAcquire atomically stores new into *addr and returns the old value.
+func SwapPointerAcquire(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer {
+	return atomic.SwapPointer(addr, new)
+}
+
+// SwapPointerRelease atomically stores new into *addr and returns the old value.
+func SwapPointerRelease(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer {
+	return atomic.SwapPointer(addr, new)
+}
+
+// SwapPointerAcqRel atomically stores new into *addr and returns the old value.
+func SwapPointerAcqRel(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer {
+	return atomic.SwapPointer(addr, new)
+}
+
+// CasPointerRelaxed atomically stores new into *addr if it equals old.
+func CasPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) bool {
+	return atomic.CompareAndSwapPointer(addr, old, new)
+}
+
+// CasPointerAcquire atomically stores new into *addr if it equals old.
+func CasPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) bool {
+	return atomic.CompareAndSwapPointer(addr, old, new)
+}
+
+// CasPointerRelease atomically stores new into *addr if it equals old.
+func CasPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) bool {
+	return atomic.CompareAndSwapPointer(addr, old, new)
+}
+
+// CasPointerAcqRel atomically stores new into *addr if it equals old.
+func CasPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) bool {
+	return atomic.CompareAndSwapPointer(addr, old, new)
+}
+
+// CaxPointerRelaxed atomically stores new into *addr if it equals old and
+// returns the value *addr held before the operation.
+func CaxPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer {
+	for {
+		cur := atomic.LoadPointer(addr)
+		if cur != old {
+			return cur
+		}
+		if atomic.CompareAndSwapPointer(addr, old, new) {
+			return old
+		}
+	}
+}
+
+// CaxPointerAcquire atomically stores new into *addr if it equals old and
+// returns the value *addr held before the operation.
+func CaxPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer {
+	return CaxPointerRelaxed(addr, old, new)
+}
+
+// CaxPointerRelease atomically stores new into *addr if it equals old and
+// returns the value *addr held before the operation.
+func CaxPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer {
+	return CaxPointerRelaxed(addr, old, new)
+}
+
+// CaxPointerAcqRel atomically stores new into *addr if it equals old and
+// returns the value *addr held before the operation.
+func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer {
+	return CaxPointerRelaxed(addr, old, new)
+}
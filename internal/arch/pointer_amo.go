@@ -0,0 +1,25 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build (riscv64 || loong64) && !race
+
+package arch
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// Pointer stores run the garbage collector's write barrier, which the
+// assembly aliases to the 64-bit stores would skip.
+
+// StorePointerRelaxed atomically stores val to *addr with relaxed memory ordering.
+func StorePointerRelaxed(addr *unsafe.Pointer, val unsafe.Pointer) {
+	*addr = val
+}
+
+// StorePointerRelease atomically stores val to *addr with release memory ordering.
+func StorePointerRelease(addr *unsafe.Pointer, val unsafe.Pointer) {
+	atomic.StorePointer(addr, val)
+}
@@ -0,0 +1,133 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !(arm64 && lse128) || race
+
+package arch
+
+// 128-bit bitwise read-modify-write operations.
+//
+// Without single-instruction pair atomics (LDCLRP/LDSETP, -tags=lse128 on
+// arm64), these run a CAS loop over the 128-bit compare-and-swap of the
+// current architecture. All return the previous value.
+
+// =============================================================================
+// AndUint128
+// =============================================================================
+
+func AndUint128Relaxed(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Relaxed(addr, oldLo, oldHi, oldLo&lo, oldHi&hi) {
+			return
+		}
+	}
+}
+
+func AndUint128Acquire(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Acquire(addr, oldLo, oldHi, oldLo&lo, oldHi&hi) {
+			return
+		}
+	}
+}
+
+func AndUint128Release(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Release(addr, oldLo, oldHi, oldLo&lo, oldHi&hi) {
+			return
+		}
+	}
+}
+
+func AndUint128AcqRel(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128AcqRel(addr, oldLo, oldHi, oldLo&lo, oldHi&hi) {
+			return
+		}
+	}
+}
+
+// =============================================================================
+// AndNotUint128
+// =============================================================================
+
+func AndNotUint128Relaxed(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Relaxed(addr, oldLo, oldHi, oldLo&^lo, oldHi&^hi) {
+			return
+		}
+	}
+}
+
+func AndNotUint128Acquire(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Acquire(addr, oldLo, oldHi, oldLo&^lo, oldHi&^hi) {
+			return
+		}
+	}
+}
+
+func AndNotUint128Release(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Release(addr, oldLo, oldHi, oldLo&^lo, oldHi&^hi) {
+			return
+		}
+	}
+}
+
+func AndNotUint128AcqRel(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128AcqRel(addr, oldLo, oldHi, oldLo&^lo, oldHi&^hi) {
+			return
+		}
+	}
+}
+
+// =============================================================================
+// OrUint128
+// =============================================================================
+
+func OrUint128Relaxed(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Relaxed(addr, oldLo, oldHi, oldLo|lo, oldHi|hi) {
+			return
+		}
+	}
+}
+
+func OrUint128Acquire(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Acquire(addr, oldLo, oldHi, oldLo|lo, oldHi|hi) {
+			return
+		}
+	}
+}
+
+func OrUint128Release(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128Release(addr, oldLo, oldHi, oldLo|lo, oldHi|hi) {
+			return
+		}
+	}
+}
+
+func OrUint128AcqRel(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = LoadUint128Relaxed(addr)
+		if CasUint128AcqRel(addr, oldLo, oldHi, oldLo|lo, oldHi|hi) {
+			return
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package arch
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// Sequentially consistent loads and stores.
+//
+// sync/atomic operations are sequentially consistent, and the compiler lowers
+// them to the standard SeqCst mappings: a plain MOV load and an XCHG store on
+// amd64, LDAR and STLR on arm64, and fence-bracketed accesses on riscv64 and
+// loong64. Read-modify-write operations need no SeqCst variants: the AcqRel
+// instructions (LOCK on amd64, the AL forms on arm64, .aqrl AMOs on riscv64,
+// AM*_DB on loong64) are already sequentially consistent.
+
+// LoadInt32SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadInt32SeqCst(addr *int32) int32 {
+	return atomic.LoadInt32(addr)
+}
+
+// LoadUint32SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint32SeqCst(addr *uint32) uint32 {
+	return atomic.LoadUint32(addr)
+}
+
+// LoadInt64SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadInt64SeqCst(addr *int64) int64 {
+	return atomic.LoadInt64(addr)
+}
+
+// LoadUint64SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint64SeqCst(addr *uint64) uint64 {
+	return atomic.LoadUint64(addr)
+}
+
+// LoadUintptrSeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUintptrSeqCst(addr *uintptr) uintptr {
+	return atomic.LoadUintptr(addr)
+}
+
+// LoadPointerSeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadPointerSeqCst(addr *unsafe.Pointer) unsafe.Pointer {
+	return atomic.LoadPointer(addr)
+}
+
+// StoreInt32SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreInt32SeqCst(addr *int32, val int32) {
+	atomic.StoreInt32(addr, val)
+}
+
+// StoreUint32SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint32SeqCst(addr *uint32, val uint32) {
+	atomic.StoreUint32(addr, val)
+}
+
+// StoreInt64SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreInt64SeqCst(addr *int64, val int64) {
+	atomic.StoreInt64(addr, val)
+}
+
+// StoreUint64SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint64SeqCst(addr *uint64, val uint64) {
+	atomic.StoreUint64(addr, val)
+}
+
+// StoreUintptrSeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUintptrSeqCst(addr *uintptr, val uintptr) {
+	atomic.StoreUintptr(addr, val)
+}
+
+// StorePointerSeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StorePointerSeqCst(addr *unsafe.Pointer, val unsafe.Pointer) {
+	atomic.StorePointer(addr, val)
+}
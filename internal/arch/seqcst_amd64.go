@@ -0,0 +1,41 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !race
+
+package arch
+
+// Under TSO only store-load reordering must be prevented, so sequentially
+// consistent loads are plain MOVs and stores use the implicitly locked XCHG.
+// 128-bit loads and stores are already LOCK CMPXCHG16B.
+
+// LoadUint8SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint8SeqCst(addr *uint8) uint8 {
+	return LoadUint8Acquire(addr)
+}
+
+// StoreUint8SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint8SeqCst(addr *uint8, val uint8) {
+	SwapUint8AcqRel(addr, val)
+}
+
+// LoadUint16SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint16SeqCst(addr *uint16) uint16 {
+	return LoadUint16Acquire(addr)
+}
+
+// StoreUint16SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint16SeqCst(addr *uint16, val uint16) {
+	SwapUint16AcqRel(addr, val)
+}
+
+// LoadUint128SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint128SeqCst(addr *[16]byte) (lo, hi uint64) {
+	return LoadUint128Relaxed(addr)
+}
+
+// StoreUint128SeqCst atomically stores (lo, hi) to *addr with sequentially consistent ordering.
+func StoreUint128SeqCst(addr *[16]byte, lo, hi uint64) {
+	StoreUint128Relaxed(addr, lo, hi)
+}
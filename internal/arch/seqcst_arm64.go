@@ -0,0 +1,54 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && !race
+
+package arch
+
+// ARMv8 load-acquire and store-release (LDAR/STLR and the exclusive-pair
+// forms LDAXP/STLXP) are RCsc: a store-release is never reordered with a
+// later load-acquire, so the acquire and release forms are sequentially
+// consistent when paired with each other.
+//
+// The CASP path of the 128-bit operations (hasLSE2) uses LDP, STP and CASP
+// with DMB barriers instead, which are not RCsc: a store there needs a full
+// barrier after it and a load a full barrier before it, so neither passes a
+// SeqCst access of any size on the other side. Its AcqRel read-modify-writes
+// end with DMB ISH and already serve as SeqCst.
+
+// LoadUint8SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint8SeqCst(addr *uint8) uint8 {
+	return LoadUint8Acquire(addr)
+}
+
+// StoreUint8SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint8SeqCst(addr *uint8, val uint8) {
+	StoreUint8Release(addr, val)
+}
+
+// LoadUint16SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint16SeqCst(addr *uint16) uint16 {
+	return LoadUint16Acquire(addr)
+}
+
+// StoreUint16SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint16SeqCst(addr *uint16, val uint16) {
+	StoreUint16Release(addr, val)
+}
+
+// LoadUint128SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint128SeqCst(addr *[16]byte) (lo, hi uint64) {
+	if hasLSE2 {
+		BarrierSeqCst()
+	}
+	return LoadUint128Acquire(addr)
+}
+
+// StoreUint128SeqCst atomically stores (lo, hi) to *addr with sequentially consistent ordering.
+func StoreUint128SeqCst(addr *[16]byte, lo, hi uint64) {
+	StoreUint128Release(addr, lo, hi)
+	if hasLSE2 {
+		BarrierSeqCst()
+	}
+}
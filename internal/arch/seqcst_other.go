@@ -0,0 +1,55 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build (!amd64 && !arm64) || race
+
+package arch
+
+// Without RCsc load/store instructions, a sequentially consistent access is
+// a relaxed access between two full fences.
+
+// LoadUint8SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint8SeqCst(addr *uint8) uint8 {
+	BarrierSeqCst()
+	v := LoadUint8Relaxed(addr)
+	BarrierSeqCst()
+	return v
+}
+
+// StoreUint8SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint8SeqCst(addr *uint8, val uint8) {
+	BarrierSeqCst()
+	StoreUint8Relaxed(addr, val)
+	BarrierSeqCst()
+}
+
+// LoadUint16SeqCst atomically loads *addr with sequentially consistent ordering.
+func LoadUint16SeqCst(addr *uint16) uint16 {
+	BarrierSeqCst()
+	v := LoadUint16Relaxed(addr)
+	BarrierSeqCst()
+	return v
+}
+
+// StoreUint16SeqCst atomically stores val to *addr with sequentially consistent ordering.
+func StoreUint16SeqCst(addr *uint16, val uint16) {
+	BarrierSeqCst()
+	StoreUint16Relaxed(addr, val)
+	BarrierSeqCst()
+}
+
+// LoadUint128SeqCst loads *addr with sequentially consistent ordering.
+func LoadUint128SeqCst(addr *[16]byte) (lo, hi uint64) {
+	BarrierSeqCst()
+	lo, hi = LoadUint128Relaxed(addr)
+	BarrierSeqCst()
+	return lo, hi
+}
+
+// StoreUint128SeqCst stores (lo, hi) to *addr with sequentially consistent ordering.
+func StoreUint128SeqCst(addr *[16]byte, lo, hi uint64) {
+	BarrierSeqCst()
+	StoreUint128Relaxed(addr, lo, hi)
+	BarrierSeqCst()
+}
@@ -0,0 +1,22 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 && !arm64
+
+package arch
+
+// SpinWait busy-waits for about n rounds of a plain delay loop. Other
+// architectures have no spin-wait hint the Go assembler can encode, so the
+// loop only keeps the caller off the contended line for a while.
+func SpinWait(n uint32) {
+	for ; n > 0; n-- {
+		spinDelay()
+	}
+}
+
+// spinDelay is one round of SpinWait. It is not inlined, so the loop is not
+// optimized away.
+//
+//go:noinline
+func spinDelay() {}
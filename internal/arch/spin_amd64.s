@@ -0,0 +1,19 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64
+
+#include "textflag.h"
+
+// func SpinWait(n uint32)
+TEXT ·SpinWait(SB), NOSPLIT, $0-4
+	MOVL	n+0(FP), AX
+	TESTL	AX, AX
+	JZ	spin_done
+spin_loop:
+	PAUSE
+	DECL	AX
+	JNZ	spin_loop
+spin_done:
+	RET
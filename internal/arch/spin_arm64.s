@@ -0,0 +1,18 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64
+
+#include "textflag.h"
+
+// func SpinWait(n uint32)
+TEXT ·SpinWait(SB), NOSPLIT, $0-4
+	MOVWU	n+0(FP), R0
+	CBZW	R0, spin_done
+spin_loop:
+	YIELD
+	SUBW	$1, R0
+	CBNZW	R0, spin_loop
+spin_done:
+	RET
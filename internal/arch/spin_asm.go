@@ -0,0 +1,15 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 || arm64
+
+package arch
+
+// SpinWait busy-waits for about n rounds of the processor's spin-wait hint:
+// PAUSE on amd64 and YIELD on arm64. The hint lets a sibling hyperthread run
+// and keeps the loop from flooding the memory system while another thread
+// holds a contended line.
+//
+//go:noescape
+func SpinWait(n uint32)
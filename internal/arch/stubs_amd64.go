@@ -2,12 +2,10 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build amd64
+//go:build amd64 && !race
 
 package arch
 
-import "unsafe"
-
 // x86-64 atomic operations.
 //
 // x86-64 has Total Store Ordering (TSO), which provides strong guarantees:
@@ -292,46 +290,6 @@ func AddUintptrRelease(addr *uintptr, delta uintptr) uintptr
 //go:noescape
 func AddUintptrAcqRel(addr *uintptr, delta uintptr) uintptr
 
-// =============================================================================
-// Pointer Operations
-// =============================================================================
-
-//go:noescape
-func SwapPointerRelaxed(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcquire(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerRelease(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcqRel(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CasPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CaxPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
 // =============================================================================
 // 128-bit Operations
 // =============================================================================
@@ -339,6 +297,14 @@ func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Poin
 // 128-bit operations use CMPXCHG16B instruction.
 // Requires 16-byte alignment for correctness.
 
+// LockFree128 reports whether 128-bit operations are single-instruction atomic.
+const LockFree128 = true
+
+// Variant128 names the implementation of the 128-bit operations.
+func Variant128() string {
+	return "CMPXCHG16B"
+}
+
 //go:noescape
 func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64)
 
@@ -578,17 +544,322 @@ func XorUintptrRelease(addr *uintptr, mask uintptr) uintptr
 func XorUintptrAcqRel(addr *uintptr, mask uintptr) uintptr
 
 // =============================================================================
-// Memory Barriers
+// 8-bit and 16-bit Unsigned Integer Operations
+// =============================================================================
+
+// Byte and halfword RMW operations use XCHG, LOCK CMPXCHG and LOCK XADD
+// with B/W operand sizes. Load/Store are in loadstore_amd64.go.
+
+// Swap atomically stores new and returns the old value.
+//
+//go:noescape
+func SwapUint8Relaxed(addr *uint8, new uint8) uint8
+
+//go:noescape
+func SwapUint8Acquire(addr *uint8, new uint8) uint8
+
+//go:noescape
+func SwapUint8Release(addr *uint8, new uint8) uint8
+
+//go:noescape
+func SwapUint8AcqRel(addr *uint8, new uint8) uint8
+
+// Cas atomically compares *addr with old and swaps if equal.
+// Returns true if the swap occurred.
+//
+//go:noescape
+func CasUint8Relaxed(addr *uint8, old, new uint8) bool
+
+//go:noescape
+func CasUint8Acquire(addr *uint8, old, new uint8) bool
+
+//go:noescape
+func CasUint8Release(addr *uint8, old, new uint8) bool
+
+//go:noescape
+func CasUint8AcqRel(addr *uint8, old, new uint8) bool
+
+// Cax atomically compares *addr with old and swaps if equal.
+// Returns the previous value (compare-exchange).
+//
+//go:noescape
+func CaxUint8Relaxed(addr *uint8, old, new uint8) uint8
+
+//go:noescape
+func CaxUint8Acquire(addr *uint8, old, new uint8) uint8
+
+//go:noescape
+func CaxUint8Release(addr *uint8, old, new uint8) uint8
+
+//go:noescape
+func CaxUint8AcqRel(addr *uint8, old, new uint8) uint8
+
+// Add atomically adds delta and returns the new value.
+//
+//go:noescape
+func AddUint8Relaxed(addr *uint8, delta uint8) uint8
+
+//go:noescape
+func AddUint8Acquire(addr *uint8, delta uint8) uint8
+
+//go:noescape
+func AddUint8Release(addr *uint8, delta uint8) uint8
+
+//go:noescape
+func AddUint8AcqRel(addr *uint8, delta uint8) uint8
+
+// And atomically performs *addr &= mask and returns the old value.
+//
+//go:noescape
+func AndUint8Relaxed(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func AndUint8Acquire(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func AndUint8Release(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func AndUint8AcqRel(addr *uint8, mask uint8) uint8
+
+// Or atomically performs *addr |= mask and returns the old value.
+//
+//go:noescape
+func OrUint8Relaxed(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func OrUint8Acquire(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func OrUint8Release(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func OrUint8AcqRel(addr *uint8, mask uint8) uint8
+
+// Xor atomically performs *addr ^= mask and returns the old value.
+//
+//go:noescape
+func XorUint8Relaxed(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func XorUint8Acquire(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func XorUint8Release(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func XorUint8AcqRel(addr *uint8, mask uint8) uint8
+
+// Swap atomically stores new and returns the old value.
+//
+//go:noescape
+func SwapUint16Relaxed(addr *uint16, new uint16) uint16
+
+//go:noescape
+func SwapUint16Acquire(addr *uint16, new uint16) uint16
+
+//go:noescape
+func SwapUint16Release(addr *uint16, new uint16) uint16
+
+//go:noescape
+func SwapUint16AcqRel(addr *uint16, new uint16) uint16
+
+// Cas atomically compares *addr with old and swaps if equal.
+// Returns true if the swap occurred.
+//
+//go:noescape
+func CasUint16Relaxed(addr *uint16, old, new uint16) bool
+
+//go:noescape
+func CasUint16Acquire(addr *uint16, old, new uint16) bool
+
+//go:noescape
+func CasUint16Release(addr *uint16, old, new uint16) bool
+
+//go:noescape
+func CasUint16AcqRel(addr *uint16, old, new uint16) bool
+
+// Cax atomically compares *addr with old and swaps if equal.
+// Returns the previous value (compare-exchange).
+//
+//go:noescape
+func CaxUint16Relaxed(addr *uint16, old, new uint16) uint16
+
+//go:noescape
+func CaxUint16Acquire(addr *uint16, old, new uint16) uint16
+
+//go:noescape
+func CaxUint16Release(addr *uint16, old, new uint16) uint16
+
+//go:noescape
+func CaxUint16AcqRel(addr *uint16, old, new uint16) uint16
+
+// Add atomically adds delta and returns the new value.
+//
+//go:noescape
+func AddUint16Relaxed(addr *uint16, delta uint16) uint16
+
+//go:noescape
+func AddUint16Acquire(addr *uint16, delta uint16) uint16
+
+//go:noescape
+func AddUint16Release(addr *uint16, delta uint16) uint16
+
+//go:noescape
+func AddUint16AcqRel(addr *uint16, delta uint16) uint16
+
+// And atomically performs *addr &= mask and returns the old value.
+//
+//go:noescape
+func AndUint16Relaxed(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func AndUint16Acquire(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func AndUint16Release(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func AndUint16AcqRel(addr *uint16, mask uint16) uint16
+
+// Or atomically performs *addr |= mask and returns the old value.
+//
+//go:noescape
+func OrUint16Relaxed(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func OrUint16Acquire(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func OrUint16Release(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func OrUint16AcqRel(addr *uint16, mask uint16) uint16
+
+// Xor atomically performs *addr ^= mask and returns the old value.
+//
+//go:noescape
+func XorUint16Relaxed(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func XorUint16Acquire(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func XorUint16Release(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func XorUint16AcqRel(addr *uint16, mask uint16) uint16
+
+// =============================================================================
+// Single-bit operations
 // =============================================================================
 
-// Barriers are no-ops on x86-64 TSO for most purposes.
-// MFENCE is used for sequential consistency requirements.
+// BitSet, BitClear and BitToggle use LOCK BTS/BTR/BTC and return the old bit.
+
+//go:noescape
+func BitSetUint32Relaxed(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitSetUint32Acquire(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitSetUint32Release(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitSetUint32AcqRel(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitSetUint64Relaxed(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitSetUint64Acquire(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitSetUint64Release(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitSetUint64AcqRel(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitSetUintptrRelaxed(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitSetUintptrAcquire(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitSetUintptrRelease(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitSetUintptrAcqRel(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitClearUint32Relaxed(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitClearUint32Acquire(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitClearUint32Release(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitClearUint32AcqRel(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitClearUint64Relaxed(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitClearUint64Acquire(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitClearUint64Release(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitClearUint64AcqRel(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitClearUintptrRelaxed(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitClearUintptrAcquire(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitClearUintptrRelease(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitClearUintptrAcqRel(addr *uintptr, bit uint) bool
+
+//go:noescape
+func BitToggleUint32Relaxed(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitToggleUint32Acquire(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitToggleUint32Release(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitToggleUint32AcqRel(addr *uint32, bit uint) bool
+
+//go:noescape
+func BitToggleUint64Relaxed(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitToggleUint64Acquire(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitToggleUint64Release(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitToggleUint64AcqRel(addr *uint64, bit uint) bool
+
+//go:noescape
+func BitToggleUintptrRelaxed(addr *uintptr, bit uint) bool
 
 //go:noescape
-func BarrierAcquire()
+func BitToggleUintptrAcquire(addr *uintptr, bit uint) bool
 
 //go:noescape
-func BarrierRelease()
+func BitToggleUintptrRelease(addr *uintptr, bit uint) bool
 
 //go:noescape
-func BarrierAcqRel()
+func BitToggleUintptrAcqRel(addr *uintptr, bit uint) bool
@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build arm64
+//go:build arm64 && !race
 
 package arch
 
@@ -20,7 +20,7 @@ import "unsafe"
 //   - Relaxed Load/Store: Pure Go in loadstore_arm64.go (inlinable)
 //   - Acquire Load / Release Store: Assembly with LDAR/STLR
 //   - RMW ops: Assembly with ordered LSE instructions
-//   - 128-bit: LDXP/STXP (default) or CASP with -tags=lse2 (ARMv8.4+)
+//   - 128-bit: CASP on ARMv8.4+ with LSE2, else LDXP/STXP
 //
 // The assembly uses these LSE instruction patterns:
 //   - SWPA/SWPL/SWPAL: Swap with acquire/release/acq-rel
@@ -337,53 +337,17 @@ func AddUintptrAcqRel(addr *uintptr, delta uintptr) uintptr
 //go:noescape
 func LoadPointerAcquire(addr *unsafe.Pointer) unsafe.Pointer
 
-//go:noescape
-func StorePointerRelease(addr *unsafe.Pointer, val unsafe.Pointer)
-
-//go:noescape
-func SwapPointerRelaxed(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcquire(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerRelease(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcqRel(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CasPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CaxPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
 // =============================================================================
 // 128-bit Operations
 // =============================================================================
 
 // 128-bit operations require 16-byte alignment.
 //
-// Default implementation uses LDXP/STXP (load-exclusive/store-exclusive pairs).
-// With -tags=lse2, uses CASP instruction (ARMv8.4+ with LSE2).
+// asm_arm64_128.s uses CASP on cores with LSE2 (ARMv8.4+) and LDXP/STXP
+// (load-exclusive/store-exclusive pairs) elsewhere; see cpu128_arm64.go.
+
+// LockFree128 reports whether 128-bit operations are single-instruction atomic.
+const LockFree128 = true
 
 //go:noescape
 func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64)
@@ -632,16 +596,222 @@ func XorUintptrRelease(addr *uintptr, mask uintptr) uintptr
 func XorUintptrAcqRel(addr *uintptr, mask uintptr) uintptr
 
 // =============================================================================
-// Memory Barriers
+// 8-bit and 16-bit Unsigned Integer Operations
 // =============================================================================
 
-// DMB (Data Memory Barrier) instructions with different scopes.
+// Byte and halfword RMW operations use the LSE B/H forms (SWPB, CASB,
+// LDADDB, LDCLRB, LDORB, LDEORB). Relaxed Load/Store are in
+// loadstore_arm64.go. Go's assembler lacks CASAB/CASLB and CASAH/CASLH,
+// so Acquire and Release CAS use the stronger CASALB/CASALH.
+
+//go:noescape
+func LoadUint8Acquire(addr *uint8) uint8
+
+//go:noescape
+func StoreUint8Release(addr *uint8, val uint8)
+
+// Swap atomically stores new and returns the old value.
+//
+//go:noescape
+func SwapUint8Relaxed(addr *uint8, new uint8) uint8
+
+//go:noescape
+func SwapUint8Acquire(addr *uint8, new uint8) uint8
+
+//go:noescape
+func SwapUint8Release(addr *uint8, new uint8) uint8
+
+//go:noescape
+func SwapUint8AcqRel(addr *uint8, new uint8) uint8
+
+// Cas atomically compares *addr with old and swaps if equal.
+// Returns true if the swap occurred.
+//
+//go:noescape
+func CasUint8Relaxed(addr *uint8, old, new uint8) bool
+
+//go:noescape
+func CasUint8Acquire(addr *uint8, old, new uint8) bool
+
+//go:noescape
+func CasUint8Release(addr *uint8, old, new uint8) bool
+
+//go:noescape
+func CasUint8AcqRel(addr *uint8, old, new uint8) bool
+
+// Cax atomically compares *addr with old and swaps if equal.
+// Returns the previous value (compare-exchange).
+//
+//go:noescape
+func CaxUint8Relaxed(addr *uint8, old, new uint8) uint8
+
+//go:noescape
+func CaxUint8Acquire(addr *uint8, old, new uint8) uint8
+
+//go:noescape
+func CaxUint8Release(addr *uint8, old, new uint8) uint8
+
+//go:noescape
+func CaxUint8AcqRel(addr *uint8, old, new uint8) uint8
+
+// Add atomically adds delta and returns the new value.
+//
+//go:noescape
+func AddUint8Relaxed(addr *uint8, delta uint8) uint8
+
+//go:noescape
+func AddUint8Acquire(addr *uint8, delta uint8) uint8
+
+//go:noescape
+func AddUint8Release(addr *uint8, delta uint8) uint8
+
+//go:noescape
+func AddUint8AcqRel(addr *uint8, delta uint8) uint8
+
+// And atomically performs *addr &= mask and returns the old value.
+//
+//go:noescape
+func AndUint8Relaxed(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func AndUint8Acquire(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func AndUint8Release(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func AndUint8AcqRel(addr *uint8, mask uint8) uint8
+
+// Or atomically performs *addr |= mask and returns the old value.
+//
+//go:noescape
+func OrUint8Relaxed(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func OrUint8Acquire(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func OrUint8Release(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func OrUint8AcqRel(addr *uint8, mask uint8) uint8
+
+// Xor atomically performs *addr ^= mask and returns the old value.
+//
+//go:noescape
+func XorUint8Relaxed(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func XorUint8Acquire(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func XorUint8Release(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func XorUint8AcqRel(addr *uint8, mask uint8) uint8
+
+//go:noescape
+func LoadUint16Acquire(addr *uint16) uint16
+
+//go:noescape
+func StoreUint16Release(addr *uint16, val uint16)
+
+// Swap atomically stores new and returns the old value.
+//
+//go:noescape
+func SwapUint16Relaxed(addr *uint16, new uint16) uint16
+
+//go:noescape
+func SwapUint16Acquire(addr *uint16, new uint16) uint16
+
+//go:noescape
+func SwapUint16Release(addr *uint16, new uint16) uint16
+
+//go:noescape
+func SwapUint16AcqRel(addr *uint16, new uint16) uint16
+
+// Cas atomically compares *addr with old and swaps if equal.
+// Returns true if the swap occurred.
+//
+//go:noescape
+func CasUint16Relaxed(addr *uint16, old, new uint16) bool
+
+//go:noescape
+func CasUint16Acquire(addr *uint16, old, new uint16) bool
+
+//go:noescape
+func CasUint16Release(addr *uint16, old, new uint16) bool
+
+//go:noescape
+func CasUint16AcqRel(addr *uint16, old, new uint16) bool
+
+// Cax atomically compares *addr with old and swaps if equal.
+// Returns the previous value (compare-exchange).
+//
+//go:noescape
+func CaxUint16Relaxed(addr *uint16, old, new uint16) uint16
+
+//go:noescape
+func CaxUint16Acquire(addr *uint16, old, new uint16) uint16
+
+//go:noescape
+func CaxUint16Release(addr *uint16, old, new uint16) uint16
+
+//go:noescape
+func CaxUint16AcqRel(addr *uint16, old, new uint16) uint16
+
+// Add atomically adds delta and returns the new value.
+//
+//go:noescape
+func AddUint16Relaxed(addr *uint16, delta uint16) uint16
+
+//go:noescape
+func AddUint16Acquire(addr *uint16, delta uint16) uint16
+
+//go:noescape
+func AddUint16Release(addr *uint16, delta uint16) uint16
+
+//go:noescape
+func AddUint16AcqRel(addr *uint16, delta uint16) uint16
+
+// And atomically performs *addr &= mask and returns the old value.
+//
+//go:noescape
+func AndUint16Relaxed(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func AndUint16Acquire(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func AndUint16Release(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func AndUint16AcqRel(addr *uint16, mask uint16) uint16
+
+// Or atomically performs *addr |= mask and returns the old value.
+//
+//go:noescape
+func OrUint16Relaxed(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func OrUint16Acquire(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func OrUint16Release(addr *uint16, mask uint16) uint16
+
+//go:noescape
+func OrUint16AcqRel(addr *uint16, mask uint16) uint16
+
+// Xor atomically performs *addr ^= mask and returns the old value.
+//
+//go:noescape
+func XorUint16Relaxed(addr *uint16, mask uint16) uint16
 
 //go:noescape
-func BarrierAcquire()
+func XorUint16Acquire(addr *uint16, mask uint16) uint16
 
 //go:noescape
-func BarrierRelease()
+func XorUint16Release(addr *uint16, mask uint16) uint16
 
 //go:noescape
-func BarrierAcqRel()
+func XorUint16AcqRel(addr *uint16, mask uint16) uint16
@@ -0,0 +1,49 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build arm64 && lse128 && !race
+
+package arch
+
+// 128-bit bitwise read-modify-write operations using FEAT_LSE128 (ARMv9.4+).
+//
+// LDCLRP and LDSETP update a 16-byte aligned pair in one instruction.
+// Build with -tags=lse128 only for hardware that implements FEAT_LSE128;
+// other CPUs raise an undefined-instruction fault.
+
+//go:noescape
+func AndUint128Relaxed(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func AndUint128Acquire(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func AndUint128Release(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func AndUint128AcqRel(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func AndNotUint128Relaxed(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func AndNotUint128Acquire(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func AndNotUint128Release(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func AndNotUint128AcqRel(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func OrUint128Relaxed(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func OrUint128Acquire(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func OrUint128Release(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
+
+//go:noescape
+func OrUint128AcqRel(addr *[16]byte, lo, hi uint64) (oldLo, oldHi uint64)
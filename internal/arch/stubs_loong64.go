@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build loong64
+//go:build loong64 && !race
 
 package arch
 
@@ -18,7 +18,7 @@ import "unsafe"
 //   - Load: LD.D with optional DBAR for acquire
 //   - Store: ST.D with optional DBAR for release
 //   - RMW ops: LL/SC loops or AM* instructions
-//   - 128-bit: Emulated via LL/SC on low 64 bits with full barriers
+//   - 128-bit: Striped lock table in lock128.go
 //
 // LoongArch atomic instructions:
 //   - LL.D/SC.D: Load-linked/store-conditional (doubleword)
@@ -368,103 +368,6 @@ func LoadPointerRelaxed(addr *unsafe.Pointer) unsafe.Pointer
 //go:noescape
 func LoadPointerAcquire(addr *unsafe.Pointer) unsafe.Pointer
 
-//go:noescape
-func StorePointerRelaxed(addr *unsafe.Pointer, val unsafe.Pointer)
-
-//go:noescape
-func StorePointerRelease(addr *unsafe.Pointer, val unsafe.Pointer)
-
-//go:noescape
-func SwapPointerRelaxed(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcquire(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerRelease(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcqRel(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CasPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CaxPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-// =============================================================================
-// 128-bit Operations
-// =============================================================================
-
-// 128-bit operations are emulated on LoongArch using LL/SC on the low 64 bits
-// with full memory barriers (DBAR 0). Requires 16-byte alignment.
-
-//go:noescape
-func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64)
-
-//go:noescape
-func LoadUint128Acquire(addr *[16]byte) (lo, hi uint64)
-
-//go:noescape
-func StoreUint128Relaxed(addr *[16]byte, lo, hi uint64)
-
-//go:noescape
-func StoreUint128Release(addr *[16]byte, lo, hi uint64)
-
-//go:noescape
-func SwapUint128Relaxed(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func SwapUint128Acquire(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func SwapUint128Release(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func SwapUint128AcqRel(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func CasUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CasUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CasUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CasUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CaxUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
-//go:noescape
-func CaxUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
-//go:noescape
-func CaxUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
-//go:noescape
-func CaxUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
 // =============================================================================
 // Bitwise Operations (And, Or, Xor)
 // =============================================================================
@@ -654,18 +557,3 @@ func XorUintptrRelease(addr *uintptr, mask uintptr) uintptr
 
 //go:noescape
 func XorUintptrAcqRel(addr *uintptr, mask uintptr) uintptr
-
-// =============================================================================
-// Memory Barriers
-// =============================================================================
-
-// DBAR instructions with different hint values for ordering control.
-
-//go:noescape
-func BarrierAcquire()
-
-//go:noescape
-func BarrierRelease()
-
-//go:noescape
-func BarrierAcqRel()
@@ -2,7 +2,7 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:build riscv64
+//go:build riscv64 && !race
 
 package arch
 
@@ -18,7 +18,7 @@ import "unsafe"
 //   - Load: LD with optional FENCE for acquire
 //   - Store: SD with optional FENCE for release
 //   - RMW ops: LR/SC loops or AMO instructions with ordering bits
-//   - 128-bit: Emulated via LR/SC on low 64 bits with full barriers
+//   - 128-bit: Striped lock table in lock128.go
 //
 // RISC-V atomic instructions:
 //   - LR.D/LR.W: Load-reserved (doubleword/word)
@@ -368,103 +368,6 @@ func LoadPointerRelaxed(addr *unsafe.Pointer) unsafe.Pointer
 //go:noescape
 func LoadPointerAcquire(addr *unsafe.Pointer) unsafe.Pointer
 
-//go:noescape
-func StorePointerRelaxed(addr *unsafe.Pointer, val unsafe.Pointer)
-
-//go:noescape
-func StorePointerRelease(addr *unsafe.Pointer, val unsafe.Pointer)
-
-//go:noescape
-func SwapPointerRelaxed(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcquire(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerRelease(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func SwapPointerAcqRel(addr *unsafe.Pointer, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CasPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CasPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) bool
-
-//go:noescape
-func CaxPointerRelaxed(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcquire(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerRelease(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-//go:noescape
-func CaxPointerAcqRel(addr *unsafe.Pointer, old, new unsafe.Pointer) unsafe.Pointer
-
-// =============================================================================
-// 128-bit Operations
-// =============================================================================
-
-// 128-bit operations are emulated on RISC-V using LR/SC on the low 64 bits
-// with full memory barriers. Requires 16-byte alignment.
-
-//go:noescape
-func LoadUint128Relaxed(addr *[16]byte) (lo, hi uint64)
-
-//go:noescape
-func LoadUint128Acquire(addr *[16]byte) (lo, hi uint64)
-
-//go:noescape
-func StoreUint128Relaxed(addr *[16]byte, lo, hi uint64)
-
-//go:noescape
-func StoreUint128Release(addr *[16]byte, lo, hi uint64)
-
-//go:noescape
-func SwapUint128Relaxed(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func SwapUint128Acquire(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func SwapUint128Release(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func SwapUint128AcqRel(addr *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64)
-
-//go:noescape
-func CasUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CasUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CasUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CasUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool
-
-//go:noescape
-func CaxUint128Relaxed(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
-//go:noescape
-func CaxUint128Acquire(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
-//go:noescape
-func CaxUint128Release(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
-//go:noescape
-func CaxUint128AcqRel(addr *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64)
-
 // =============================================================================
 // Bitwise Operations (And, Or, Xor)
 // =============================================================================
@@ -654,18 +557,3 @@ func XorUintptrRelease(addr *uintptr, mask uintptr) uintptr
 
 //go:noescape
 func XorUintptrAcqRel(addr *uintptr, mask uintptr) uintptr
-
-// =============================================================================
-// Memory Barriers
-// =============================================================================
-
-// FENCE instructions with different read/write combinations.
-
-//go:noescape
-func BarrierAcquire()
-
-//go:noescape
-func BarrierRelease()
-
-//go:noescape
-func BarrierAcqRel()
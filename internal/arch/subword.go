@@ -0,0 +1,385 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 && !arm64 && !race
+
+package arch
+
+import "unsafe"
+
+// 8-bit and 16-bit operations for architectures without byte/halfword
+// atomics (riscv64 and loong64 base ISAs, and the generic fallback). Race
+// builds use subword_race.go instead.
+//
+// Each operation is a CAS loop on the naturally aligned 32-bit word that
+// contains the target, replacing only the target's bits. A concurrent
+// change to a neighbouring byte makes the word CAS fail and retry, so
+// neighbours are never overwritten. The ordering of the 32-bit CAS (and of
+// the initial load for Acquire/AcqRel) gives each operation its ordering.
+
+// bigEndian reports whether the most significant byte is stored first.
+var bigEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 0
+}()
+
+// subword returns the aligned 32-bit word containing the size-byte value at
+// p and the bit shift of that value within the word.
+//
+//go:nocheckptr
+func subword(p unsafe.Pointer, size uintptr) (w *uint32, shift uint32) {
+	off := uintptr(p) & 3
+	if bigEndian {
+		off = 4 - size - off
+	}
+	return (*uint32)(unsafe.Pointer(uintptr(p) &^ 3)), uint32(off * 8)
+}
+
+// caxMasked compares the field (w >> shift) & mask with old and replaces it
+// with new if equal, returning the previous field value.
+func caxMasked(w *uint32, shift, mask, old, new uint32,
+	load func(*uint32) uint32, cas func(*uint32, uint32, uint32) bool) uint32 {
+	for {
+		cur := load(w)
+		got := (cur >> shift) & mask
+		if got != old {
+			return got
+		}
+		if cas(w, cur, cur&^(mask<<shift)|new<<shift) {
+			return old
+		}
+	}
+}
+
+// rmwMasked replaces the field with f(field) and returns the old field value.
+func rmwMasked(w *uint32, shift, mask uint32, f func(old uint32) uint32,
+	cas func(*uint32, uint32, uint32) bool) uint32 {
+	for {
+		cur := LoadUint32Relaxed(w)
+		old := (cur >> shift) & mask
+		if cas(w, cur, cur&^(mask<<shift)|(f(old)&mask)<<shift) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// 8-bit Unsigned Integer Operations
+// =============================================================================
+
+func LoadUint8Relaxed(addr *uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(LoadUint32Relaxed(w) >> shift)
+}
+
+func LoadUint8Acquire(addr *uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(LoadUint32Acquire(w) >> shift)
+}
+
+func StoreUint8Relaxed(addr *uint8, val uint8) {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	rmwMasked(w, shift, 0xFF, func(uint32) uint32 { return uint32(val) }, CasUint32Relaxed)
+}
+
+func StoreUint8Release(addr *uint8, val uint8) {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	rmwMasked(w, shift, 0xFF, func(uint32) uint32 { return uint32(val) }, CasUint32Release)
+}
+
+func SwapUint8Relaxed(addr *uint8, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(uint32) uint32 { return uint32(new) }, CasUint32Relaxed))
+}
+
+func SwapUint8Acquire(addr *uint8, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(uint32) uint32 { return uint32(new) }, CasUint32Acquire))
+}
+
+func SwapUint8Release(addr *uint8, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(uint32) uint32 { return uint32(new) }, CasUint32Release))
+}
+
+func SwapUint8AcqRel(addr *uint8, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(uint32) uint32 { return uint32(new) }, CasUint32AcqRel))
+}
+
+func CasUint8Relaxed(addr *uint8, old, new uint8) bool {
+	return CaxUint8Relaxed(addr, old, new) == old
+}
+
+func CasUint8Acquire(addr *uint8, old, new uint8) bool {
+	return CaxUint8Acquire(addr, old, new) == old
+}
+
+func CasUint8Release(addr *uint8, old, new uint8) bool {
+	return CaxUint8Release(addr, old, new) == old
+}
+
+func CasUint8AcqRel(addr *uint8, old, new uint8) bool {
+	return CaxUint8AcqRel(addr, old, new) == old
+}
+
+func CaxUint8Relaxed(addr *uint8, old, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(caxMasked(w, shift, 0xFF, uint32(old), uint32(new), LoadUint32Relaxed, CasUint32Relaxed))
+}
+
+func CaxUint8Acquire(addr *uint8, old, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(caxMasked(w, shift, 0xFF, uint32(old), uint32(new), LoadUint32Acquire, CasUint32Acquire))
+}
+
+func CaxUint8Release(addr *uint8, old, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(caxMasked(w, shift, 0xFF, uint32(old), uint32(new), LoadUint32Relaxed, CasUint32Release))
+}
+
+func CaxUint8AcqRel(addr *uint8, old, new uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(caxMasked(w, shift, 0xFF, uint32(old), uint32(new), LoadUint32Acquire, CasUint32AcqRel))
+}
+
+func AddUint8Relaxed(addr *uint8, delta uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32Relaxed)) + delta
+}
+
+func AddUint8Acquire(addr *uint8, delta uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32Acquire)) + delta
+}
+
+func AddUint8Release(addr *uint8, delta uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32Release)) + delta
+}
+
+func AddUint8AcqRel(addr *uint8, delta uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32AcqRel)) + delta
+}
+
+func AndUint8Relaxed(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32Relaxed))
+}
+
+func AndUint8Acquire(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32Acquire))
+}
+
+func AndUint8Release(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32Release))
+}
+
+func AndUint8AcqRel(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32AcqRel))
+}
+
+func OrUint8Relaxed(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32Relaxed))
+}
+
+func OrUint8Acquire(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32Acquire))
+}
+
+func OrUint8Release(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32Release))
+}
+
+func OrUint8AcqRel(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32AcqRel))
+}
+
+func XorUint8Relaxed(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32Relaxed))
+}
+
+func XorUint8Acquire(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32Acquire))
+}
+
+func XorUint8Release(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32Release))
+}
+
+func XorUint8AcqRel(addr *uint8, mask uint8) uint8 {
+	w, shift := subword(unsafe.Pointer(addr), 1)
+	return uint8(rmwMasked(w, shift, 0xFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32AcqRel))
+}
+
+// =============================================================================
+// 16-bit Unsigned Integer Operations
+// =============================================================================
+
+func LoadUint16Relaxed(addr *uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(LoadUint32Relaxed(w) >> shift)
+}
+
+func LoadUint16Acquire(addr *uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(LoadUint32Acquire(w) >> shift)
+}
+
+func StoreUint16Relaxed(addr *uint16, val uint16) {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	rmwMasked(w, shift, 0xFFFF, func(uint32) uint32 { return uint32(val) }, CasUint32Relaxed)
+}
+
+func StoreUint16Release(addr *uint16, val uint16) {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	rmwMasked(w, shift, 0xFFFF, func(uint32) uint32 { return uint32(val) }, CasUint32Release)
+}
+
+func SwapUint16Relaxed(addr *uint16, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(uint32) uint32 { return uint32(new) }, CasUint32Relaxed))
+}
+
+func SwapUint16Acquire(addr *uint16, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(uint32) uint32 { return uint32(new) }, CasUint32Acquire))
+}
+
+func SwapUint16Release(addr *uint16, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(uint32) uint32 { return uint32(new) }, CasUint32Release))
+}
+
+func SwapUint16AcqRel(addr *uint16, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(uint32) uint32 { return uint32(new) }, CasUint32AcqRel))
+}
+
+func CasUint16Relaxed(addr *uint16, old, new uint16) bool {
+	return CaxUint16Relaxed(addr, old, new) == old
+}
+
+func CasUint16Acquire(addr *uint16, old, new uint16) bool {
+	return CaxUint16Acquire(addr, old, new) == old
+}
+
+func CasUint16Release(addr *uint16, old, new uint16) bool {
+	return CaxUint16Release(addr, old, new) == old
+}
+
+func CasUint16AcqRel(addr *uint16, old, new uint16) bool {
+	return CaxUint16AcqRel(addr, old, new) == old
+}
+
+func CaxUint16Relaxed(addr *uint16, old, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(caxMasked(w, shift, 0xFFFF, uint32(old), uint32(new), LoadUint32Relaxed, CasUint32Relaxed))
+}
+
+func CaxUint16Acquire(addr *uint16, old, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(caxMasked(w, shift, 0xFFFF, uint32(old), uint32(new), LoadUint32Acquire, CasUint32Acquire))
+}
+
+func CaxUint16Release(addr *uint16, old, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(caxMasked(w, shift, 0xFFFF, uint32(old), uint32(new), LoadUint32Relaxed, CasUint32Release))
+}
+
+func CaxUint16AcqRel(addr *uint16, old, new uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(caxMasked(w, shift, 0xFFFF, uint32(old), uint32(new), LoadUint32Acquire, CasUint32AcqRel))
+}
+
+func AddUint16Relaxed(addr *uint16, delta uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32Relaxed)) + delta
+}
+
+func AddUint16Acquire(addr *uint16, delta uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32Acquire)) + delta
+}
+
+func AddUint16Release(addr *uint16, delta uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32Release)) + delta
+}
+
+func AddUint16AcqRel(addr *uint16, delta uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v + uint32(delta) }, CasUint32AcqRel)) + delta
+}
+
+func AndUint16Relaxed(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32Relaxed))
+}
+
+func AndUint16Acquire(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32Acquire))
+}
+
+func AndUint16Release(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32Release))
+}
+
+func AndUint16AcqRel(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v & uint32(mask) }, CasUint32AcqRel))
+}
+
+func OrUint16Relaxed(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32Relaxed))
+}
+
+func OrUint16Acquire(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32Acquire))
+}
+
+func OrUint16Release(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32Release))
+}
+
+func OrUint16AcqRel(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v | uint32(mask) }, CasUint32AcqRel))
+}
+
+func XorUint16Relaxed(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32Relaxed))
+}
+
+func XorUint16Acquire(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32Acquire))
+}
+
+func XorUint16Release(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32Release))
+}
+
+func XorUint16AcqRel(addr *uint16, mask uint16) uint16 {
+	w, shift := subword(unsafe.Pointer(addr), 2)
+	return uint16(rmwMasked(w, shift, 0xFFFF, func(v uint32) uint32 { return v ^ uint32(mask) }, CasUint32AcqRel))
+}
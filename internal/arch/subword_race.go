@@ -0,0 +1,322 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build race
+
+package arch
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// 8-bit and 16-bit operations for race builds.
+//
+// sync/atomic has no byte or halfword operations, and a CAS on the
+// containing 32-bit word would look to the race detector like an access to
+// the neighbouring bytes too, so a plain write to a neighbouring struct field
+// would be reported as a race. Instead, each operation holds a mutex of the
+// 128-bit lock table, chosen by address, and accesses only the target's
+// bytes. The lock and unlock order the operation at least as strongly as any
+// ordering suffix asks for.
+
+// lockSub returns the mutex guarding the 8- or 16-bit value at p.
+func lockSub(p unsafe.Pointer) *sync.Mutex {
+	return &lock128[uintptr(p)>>4%uintptr(len(lock128))].Mutex
+}
+
+func loadSub[T uint8 | uint16](addr *T) T {
+	mu := lockSub(unsafe.Pointer(addr))
+	mu.Lock()
+	v := *addr
+	mu.Unlock()
+	return v
+}
+
+// rmwSub replaces *addr with f(*addr) and returns the old value.
+func rmwSub[T uint8 | uint16](addr *T, f func(old T) T) T {
+	mu := lockSub(unsafe.Pointer(addr))
+	mu.Lock()
+	old := *addr
+	*addr = f(old)
+	mu.Unlock()
+	return old
+}
+
+// caxSub replaces *addr with new if it equals old and returns the previous
+// value.
+func caxSub[T uint8 | uint16](addr *T, old, new T) T {
+	mu := lockSub(unsafe.Pointer(addr))
+	mu.Lock()
+	cur := *addr
+	if cur == old {
+		*addr = new
+	}
+	mu.Unlock()
+	return cur
+}
+
+// =============================================================================
+// 8-bit Unsigned Integer Operations
+// =============================================================================
+
+func LoadUint8Relaxed(addr *uint8) uint8 {
+	return loadSub(addr)
+}
+
+func LoadUint8Acquire(addr *uint8) uint8 {
+	return loadSub(addr)
+}
+
+func StoreUint8Relaxed(addr *uint8, val uint8) {
+	rmwSub(addr, func(uint8) uint8 { return val })
+}
+
+func StoreUint8Release(addr *uint8, val uint8) {
+	rmwSub(addr, func(uint8) uint8 { return val })
+}
+
+func SwapUint8Relaxed(addr *uint8, new uint8) uint8 {
+	return rmwSub(addr, func(uint8) uint8 { return new })
+}
+
+func SwapUint8Acquire(addr *uint8, new uint8) uint8 {
+	return rmwSub(addr, func(uint8) uint8 { return new })
+}
+
+func SwapUint8Release(addr *uint8, new uint8) uint8 {
+	return rmwSub(addr, func(uint8) uint8 { return new })
+}
+
+func SwapUint8AcqRel(addr *uint8, new uint8) uint8 {
+	return rmwSub(addr, func(uint8) uint8 { return new })
+}
+
+func CasUint8Relaxed(addr *uint8, old, new uint8) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CasUint8Acquire(addr *uint8, old, new uint8) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CasUint8Release(addr *uint8, old, new uint8) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CasUint8AcqRel(addr *uint8, old, new uint8) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CaxUint8Relaxed(addr *uint8, old, new uint8) uint8 {
+	return caxSub(addr, old, new)
+}
+
+func CaxUint8Acquire(addr *uint8, old, new uint8) uint8 {
+	return caxSub(addr, old, new)
+}
+
+func CaxUint8Release(addr *uint8, old, new uint8) uint8 {
+	return caxSub(addr, old, new)
+}
+
+func CaxUint8AcqRel(addr *uint8, old, new uint8) uint8 {
+	return caxSub(addr, old, new)
+}
+
+func AddUint8Relaxed(addr *uint8, delta uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v + delta }) + delta
+}
+
+func AddUint8Acquire(addr *uint8, delta uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v + delta }) + delta
+}
+
+func AddUint8Release(addr *uint8, delta uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v + delta }) + delta
+}
+
+func AddUint8AcqRel(addr *uint8, delta uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v + delta }) + delta
+}
+
+func AndUint8Relaxed(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v & mask })
+}
+
+func AndUint8Acquire(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v & mask })
+}
+
+func AndUint8Release(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v & mask })
+}
+
+func AndUint8AcqRel(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v & mask })
+}
+
+func OrUint8Relaxed(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v | mask })
+}
+
+func OrUint8Acquire(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v | mask })
+}
+
+func OrUint8Release(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v | mask })
+}
+
+func OrUint8AcqRel(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v | mask })
+}
+
+func XorUint8Relaxed(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v ^ mask })
+}
+
+func XorUint8Acquire(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v ^ mask })
+}
+
+func XorUint8Release(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v ^ mask })
+}
+
+func XorUint8AcqRel(addr *uint8, mask uint8) uint8 {
+	return rmwSub(addr, func(v uint8) uint8 { return v ^ mask })
+}
+
+// =============================================================================
+// 16-bit Unsigned Integer Operations
+// =============================================================================
+
+func LoadUint16Relaxed(addr *uint16) uint16 {
+	return loadSub(addr)
+}
+
+func LoadUint16Acquire(addr *uint16) uint16 {
+	return loadSub(addr)
+}
+
+func StoreUint16Relaxed(addr *uint16, val uint16) {
+	rmwSub(addr, func(uint16) uint16 { return val })
+}
+
+func StoreUint16Release(addr *uint16, val uint16) {
+	rmwSub(addr, func(uint16) uint16 { return val })
+}
+
+func SwapUint16Relaxed(addr *uint16, new uint16) uint16 {
+	return rmwSub(addr, func(uint16) uint16 { return new })
+}
+
+func SwapUint16Acquire(addr *uint16, new uint16) uint16 {
+	return rmwSub(addr, func(uint16) uint16 { return new })
+}
+
+func SwapUint16Release(addr *uint16, new uint16) uint16 {
+	return rmwSub(addr, func(uint16) uint16 { return new })
+}
+
+func SwapUint16AcqRel(addr *uint16, new uint16) uint16 {
+	return rmwSub(addr, func(uint16) uint16 { return new })
+}
+
+func CasUint16Relaxed(addr *uint16, old, new uint16) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CasUint16Acquire(addr *uint16, old, new uint16) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CasUint16Release(addr *uint16, old, new uint16) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CasUint16AcqRel(addr *uint16, old, new uint16) bool {
+	return caxSub(addr, old, new) == old
+}
+
+func CaxUint16Relaxed(addr *uint16, old, new uint16) uint16 {
+	return caxSub(addr, old, new)
+}
+
+func CaxUint16Acquire(addr *uint16, old, new uint16) uint16 {
+	return caxSub(addr, old, new)
+}
+
+func CaxUint16Release(addr *uint16, old, new uint16) uint16 {
+	return caxSub(addr, old, new)
+}
+
+func CaxUint16AcqRel(addr *uint16, old, new uint16) uint16 {
+	return caxSub(addr, old, new)
+}
+
+func AddUint16Relaxed(addr *uint16, delta uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v + delta }) + delta
+}
+
+func AddUint16Acquire(addr *uint16, delta uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v + delta }) + delta
+}
+
+func AddUint16Release(addr *uint16, delta uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v + delta }) + delta
+}
+
+func AddUint16AcqRel(addr *uint16, delta uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v + delta }) + delta
+}
+
+func AndUint16Relaxed(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v & mask })
+}
+
+func AndUint16Acquire(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v & mask })
+}
+
+func AndUint16Release(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v & mask })
+}
+
+func AndUint16AcqRel(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v & mask })
+}
+
+func OrUint16Relaxed(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v | mask })
+}
+
+func OrUint16Acquire(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v | mask })
+}
+
+func OrUint16Release(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v | mask })
+}
+
+func OrUint16AcqRel(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v | mask })
+}
+
+func XorUint16Relaxed(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v ^ mask })
+}
+
+func XorUint16Acquire(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v ^ mask })
+}
+
+func XorUint16Release(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v ^ mask })
+}
+
+func XorUint16AcqRel(addr *uint16, mask uint16) uint16 {
+	return rmwSub(addr, func(v uint16) uint16 { return v ^ mask })
+}
@@ -0,0 +1,680 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Command opsgen generates ops_gen.go: the Inc/Dec, FetchAdd/FetchSub/
+// FetchInc/FetchDec, single-bit, conditional arithmetic, ordered Max/Min,
+// ordered 128-bit arithmetic, and SeqCst methods, and the MemoryOrder Sub/Inc/Dec, Fetch and single-bit
+// functions, so that every integer type carries the full operation × ordering
+// matrix.
+//
+// Run it from the module root with go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strings"
+)
+
+// orderings lists the explicit ordering suffixes. The unsuffixed method
+// uses acquire-release ordering.
+var orderings = []string{"Relaxed", "Acquire", "Release", "AcqRel"}
+
+var orderDoc = map[string]string{
+	"":        "acquire-release",
+	"Relaxed": "relaxed",
+	"Acquire": "acquire",
+	"Release": "release",
+	"AcqRel":  "acquire-release",
+}
+
+// intType describes an integer type backed by a single machine word.
+type intType struct {
+	Name   string // atomix type, e.g. "Int8"
+	Go     string // Go value type, e.g. "int8"
+	Arch   string // arch function suffix, e.g. "Uint8"
+	ArchGo string // Go type of the arch functions, e.g. "uint8"
+}
+
+var intTypes = []intType{
+	{"Int8", "int8", "Uint8", "uint8"},
+	{"Uint8", "uint8", "Uint8", "uint8"},
+	{"Int16", "int16", "Uint16", "uint16"},
+	{"Uint16", "uint16", "Uint16", "uint16"},
+	{"Int32", "int32", "Int32", "int32"},
+	{"Uint32", "uint32", "Uint32", "uint32"},
+	{"Int64", "int64", "Int64", "int64"},
+	{"Uint64", "uint64", "Uint64", "uint64"},
+	{"Uintptr", "uintptr", "Uintptr", "uintptr"},
+}
+
+// ofType describes a named-integer wrapper such as Int32Of[T].
+type ofType struct {
+	Name string // e.g. "Int32Of"
+	Base string // MemoryOrder suffix and storage type, e.g. "Int32"
+	Go   string // storage Go type, e.g. "int32"
+}
+
+var ofTypes = []ofType{
+	{"Int32Of", "Int32", "int32"},
+	{"Uint32Of", "Uint32", "uint32"},
+	{"Int64Of", "Int64", "int64"},
+	{"Uint64Of", "Uint64", "uint64"},
+	{"UintptrOf", "Uintptr", "uintptr"},
+}
+
+// wide describes a 128-bit integer type.
+type wide struct {
+	Name string // "Uint128" or "Int128"
+	Half string // Go type of each half in the method signature
+}
+
+var wideTypes = []wide{{"Uint128", "uint64"}, {"Int128", "int64"}}
+
+// conv returns expr converted to typ, or expr itself if from == typ.
+func conv(typ, from, expr string) string {
+	if typ == from {
+		return expr
+	}
+	return typ + "(" + expr + ")"
+}
+
+// minusOne returns the arch-typed delta that decrements by one.
+func (t intType) minusOne() string {
+	if strings.HasPrefix(t.ArchGo, "int") {
+		return "-1"
+	}
+	return "^" + t.ArchGo + "(0)"
+}
+
+// neg returns the arch-typed negation of the t.Go-typed expr.
+func (t intType) neg(expr string) string {
+	x := conv(t.ArchGo, t.Go, expr)
+	if strings.HasPrefix(t.ArchGo, "int") {
+		return "-" + x
+	}
+	return "^(" + x + " - 1)"
+}
+
+// loadOrder returns the ordering of the initial load in a Max/Min loop:
+// acquire whenever the operation has acquire semantics, so that the
+// no-store path is ordered too.
+func loadOrder(ord string) string {
+	if ord == "Acquire" || ord == "AcqRel" {
+		return "Acquire"
+	}
+	return "Relaxed"
+}
+
+type gen struct{ bytes.Buffer }
+
+func (g *gen) p(format string, args ...any) {
+	fmt.Fprintf(&g.Buffer, format, args...)
+	g.WriteByte('\n')
+}
+
+func (g *gen) banner(title string) {
+	g.p("// =============================================================================")
+	g.p("// %s", title)
+	g.p("// =============================================================================")
+	g.p("")
+}
+
+func (g *gen) method(doc, recv, sig, body string) {
+	g.p("%s\n//\n//go:nosplit\nfunc (a *%s) %s {\n%s\n}\n", doc, recv, sig, body)
+}
+
+func (g *gen) intMethods(t intType) {
+	g.banner(t.Name)
+	for _, ord := range append([]string{""}, orderings...) {
+		arch := ord
+		if arch == "" {
+			arch = "AcqRel"
+		}
+		add := func(delta string) string {
+			return "\treturn " + conv(t.Go, t.ArchGo, fmt.Sprintf("arch.Add%s%s(&a.v, %s)", t.Arch, arch, delta))
+		}
+		g.method(fmt.Sprintf("// Inc%s atomically adds 1 and returns the new value with %s ordering.", ord, orderDoc[ord]),
+			t.Name, fmt.Sprintf("Inc%s() %s", ord, t.Go), add("1"))
+		g.method(fmt.Sprintf("// Dec%s atomically subtracts 1 and returns the new value with %s ordering.", ord, orderDoc[ord]),
+			t.Name, fmt.Sprintf("Dec%s() %s", ord, t.Go), add(t.minusOne()))
+	}
+	// The arch layer returns the new value; undoing the delta recovers the
+	// old one exactly, wraparound included.
+	for _, ord := range append([]string{""}, orderings...) {
+		arch := ord
+		if arch == "" {
+			arch = "AcqRel"
+		}
+		add := func(delta, undo string) string {
+			return fmt.Sprintf("\treturn %s %s", conv(t.Go, t.ArchGo, fmt.Sprintf("arch.Add%s%s(&a.v, %s)", t.Arch, arch, delta)), undo)
+		}
+		for _, f := range []struct{ name, doc, params, body string }{
+			{"FetchAdd", "adds delta", "delta " + t.Go, add(conv(t.ArchGo, t.Go, "delta"), "- delta")},
+			{"FetchSub", "subtracts delta", "delta " + t.Go, add(t.neg("delta"), "+ delta")},
+			{"FetchInc", "adds 1", "", add("1", "- 1")},
+			{"FetchDec", "subtracts 1", "", add(t.minusOne(), "+ 1")},
+		} {
+			g.method(fmt.Sprintf("// %s%s atomically %s and returns the old value with %s ordering.", f.name, ord, f.doc, orderDoc[ord]),
+				t.Name, fmt.Sprintf("%s%s(%s) %s", f.name, ord, f.params, t.Go), f.body)
+		}
+	}
+	for _, ord := range orderings[1:] {
+		for _, op := range []struct{ name, word, cmp string }{{"Max", "maximum", ">="}, {"Min", "minimum", "<="}} {
+			body := fmt.Sprintf(`	for {
+		old := %s
+		if old %s val {
+			return old
+		}
+		if arch.Cas%s%s(&a.v, %s, %s) {
+			return old
+		}
+	}`, conv(t.Go, t.ArchGo, fmt.Sprintf("arch.Load%s%s(&a.v)", t.Arch, loadOrder(ord))), op.cmp,
+				t.Arch, ord, conv(t.ArchGo, t.Go, "old"), conv(t.ArchGo, t.Go, "val"))
+			g.method(fmt.Sprintf("// %s%s atomically stores the %s with %s ordering.", op.name, ord, op.word, orderDoc[ord]),
+				t.Name, fmt.Sprintf("%s%s(val %s) %s", op.name, ord, t.Go, t.Go), body)
+		}
+	}
+}
+
+func (g *gen) ofMethods(t ofType) {
+	g.banner(t.Name)
+	for _, ord := range append([]string{""}, orderings...) {
+		o := ord
+		if o == "" {
+			o = "AcqRel"
+		}
+		for _, op := range []struct{ name, verb, delta string }{{"Inc", "adds", "1"}, {"Dec", "subtracts", "1"}} {
+			fn := "Add"
+			if op.name == "Dec" {
+				fn = "Sub"
+			}
+			g.method(fmt.Sprintf("// %s%s atomically %s 1 and returns the new value with %s ordering.", op.name, ord, op.verb, orderDoc[ord]),
+				t.Name+"[T]", fmt.Sprintf("%s%s() T", op.name, ord),
+				fmt.Sprintf("\treturn T(%s.%s%s(&a.v, 1))", o, fn, t.Base))
+		}
+		for _, f := range []struct{ name, doc, params, args string }{
+			{"FetchAdd", "adds delta", "delta T", t.Go + "(delta)"},
+			{"FetchSub", "subtracts delta", "delta T", t.Go + "(delta)"},
+			{"FetchInc", "adds 1", "", ""},
+			{"FetchDec", "subtracts 1", "", ""},
+		} {
+			g.method(fmt.Sprintf("// %s%s atomically %s and returns the old value with %s ordering.", f.name, ord, f.doc, orderDoc[ord]),
+				t.Name+"[T]", fmt.Sprintf("%s%s(%s) T", f.name, ord, f.params),
+				fmt.Sprintf("\treturn T(%s.%s%s(&a.v%s))", o, f.name, t.Base, strings.TrimSuffix(", "+f.args, ", ")))
+		}
+	}
+}
+
+func (g *gen) wideMethods(t wide) {
+	g.banner(t.Name)
+	// Uint128 computes into its named results; Int128 converts on return.
+	results, assign, ret := "newLo, newHi uint64", "=", "newLo, newHi"
+	if t.Half != "uint64" {
+		results, assign, ret = "lo, hi "+t.Half, ":=", fmt.Sprintf("%[1]s(newLo), %[1]s(newHi)", t.Half)
+	}
+	for _, ord := range orderings[1:] {
+		for _, op := range []struct{ name, verb, sign, carry, fix string }{
+			{"Add", "adds", "+", "<", "++"},
+			{"Sub", "subtracts", "-", ">", "--"},
+		} {
+			body := fmt.Sprintf(`	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo %[8]s oldLo %[1]s %[2]s
+		newHi %[8]s oldHi %[1]s %[3]s
+		if newLo %[4]s oldLo {
+			newHi%[5]s
+		}
+		if arch.CasUint128%[6]s(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return %[7]s
+		}
+	}`, op.sign, conv("uint64", t.Half, "deltaLo"), conv("uint64", t.Half, "deltaHi"), op.carry, op.fix, ord,
+				ret, assign)
+			g.method(fmt.Sprintf("// %s%s atomically %s (deltaLo, deltaHi) and returns the new value with %s ordering.", op.name, ord, op.verb, orderDoc[ord]),
+				t.Name, fmt.Sprintf("%s%s(deltaLo, deltaHi %s) (%s)", op.name, ord, t.Half, results), body)
+		}
+		g.method(fmt.Sprintf("// Inc%s atomically increments by 1 and returns the new value with %s ordering.", ord, orderDoc[ord]),
+			t.Name, fmt.Sprintf("Inc%s() (%s)", ord, results), fmt.Sprintf("\treturn a.Add%s(1, 0)", ord))
+		g.method(fmt.Sprintf("// Dec%s atomically decrements by 1 and returns the new value with %s ordering.", ord, orderDoc[ord]),
+			t.Name, fmt.Sprintf("Dec%s() (%s)", ord, results), fmt.Sprintf("\treturn a.Sub%s(1, 0)", ord))
+	}
+	old := "lo, hi"
+	if t.Half != "uint64" {
+		old = fmt.Sprintf("%[1]s(lo), %[1]s(hi)", t.Half)
+	}
+	for _, ord := range append([]string{""}, orderings...) {
+		cas := ord
+		if cas == "" {
+			cas = "AcqRel"
+		}
+		for _, op := range []struct{ name, verb, sign, carry, fix string }{
+			{"FetchAdd", "adds", "+", "<", "++"},
+			{"FetchSub", "subtracts", "-", ">", "--"},
+		} {
+			body := fmt.Sprintf(`	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo %[1]s %[2]s
+		newHi := hi %[1]s %[3]s
+		if newLo %[4]s lo {
+			newHi%[5]s
+		}
+		if arch.CasUint128%[6]s(a.v.slot(), lo, hi, newLo, newHi) {
+			return %[7]s
+		}
+	}`, op.sign, conv("uint64", t.Half, "deltaLo"), conv("uint64", t.Half, "deltaHi"), op.carry, op.fix, cas, old)
+			g.method(fmt.Sprintf("// %s%s atomically %s (deltaLo, deltaHi) and returns the old value with %s ordering.", op.name, ord, op.verb, orderDoc[ord]),
+				t.Name, fmt.Sprintf("%s%s(deltaLo, deltaHi %s) (oldLo, oldHi %s)", op.name, ord, t.Half, t.Half), body)
+		}
+		g.method(fmt.Sprintf("// FetchInc%s atomically increments by 1 and returns the old value with %s ordering.", ord, orderDoc[ord]),
+			t.Name, fmt.Sprintf("FetchInc%s() (oldLo, oldHi %s)", ord, t.Half), fmt.Sprintf("\treturn a.FetchAdd%s(1, 0)", ord))
+		g.method(fmt.Sprintf("// FetchDec%s atomically decrements by 1 and returns the old value with %s ordering.", ord, orderDoc[ord]),
+			t.Name, fmt.Sprintf("FetchDec%s() (oldLo, oldHi %s)", ord, t.Half), fmt.Sprintf("\treturn a.FetchSub%s(1, 0)", ord))
+	}
+}
+
+func (g *gen) orderFuncs(t intType) {
+	g.banner("MemoryOrder " + t.Name)
+	neg := "-delta"
+	if !strings.HasPrefix(t.Go, "int") {
+		neg = "^(delta - 1)"
+	}
+	fns := []struct{ name, doc, params, delta string }{
+		{"Sub", "subtracts delta from *addr", fmt.Sprintf(", delta %s", t.Go), neg},
+		{"Inc", "adds 1 to *addr", "", "1"},
+		{"Dec", "subtracts 1 from *addr", "", "0"},
+	}
+	for _, f := range fns {
+		body := fmt.Sprintf("\treturn o.Add%s(addr, %s)", t.Name, f.delta)
+		if f.name == "Dec" {
+			body = fmt.Sprintf("\treturn o.Sub%s(addr, 1)", t.Name)
+		}
+		g.p("// %s%s atomically %s and returns the new value.", f.name, t.Name, f.doc)
+		g.p("// Unknown orderings fallback to AcqRel.\n//\n//go:nosplit")
+		g.p("func (o MemoryOrder) %s%s(addr *%s%s) (new %s) {\n%s\n}\n", f.name, t.Name, t.Go, f.params, t.Go, body)
+	}
+	for _, f := range []struct{ name, doc, params, body string }{
+		{"FetchAdd", "adds delta to *addr", fmt.Sprintf(", delta %s", t.Go), "o.Add%s(addr, delta) - delta"},
+		{"FetchSub", "subtracts delta from *addr", fmt.Sprintf(", delta %s", t.Go), "o.Sub%s(addr, delta) + delta"},
+		{"FetchInc", "adds 1 to *addr", "", "o.Add%s(addr, 1) - 1"},
+		{"FetchDec", "subtracts 1 from *addr", "", "o.Sub%s(addr, 1) + 1"},
+	} {
+		g.p("// %s%s atomically %s and returns the old value.", f.name, t.Name, f.doc)
+		g.p("// Unknown orderings fallback to AcqRel.\n//\n//go:nosplit")
+		g.p("func (o MemoryOrder) %s%s(addr *%s%s) (old %s) {\n\treturn %s\n}\n", f.name, t.Name, t.Go, f.params, t.Go, fmt.Sprintf(f.body, t.Name))
+	}
+}
+
+// condOps are the conditional arithmetic methods; step names the helper in
+// cond.go that computes the value to store, and note ends the method doc.
+var condOps = []struct{ name, doc, params, args, step, note string }{
+	{"AddIfBelow", "adds delta if the sum stays below limit", "delta, limit V", "delta, limit", "addBelow",
+		"whether it applied. A sum that overflows never applies."},
+	{"SubIfAtLeast", "subtracts delta if the difference stays at or above floor", "delta, floor V", "delta, floor", "subAtLeast",
+		"whether it applied. A difference that overflows never applies."},
+	{"IncIfNonZero", "adds 1 unless the value is 0", "", "", "incNonZero",
+		"whether it applied. It does not apply at the maximum value."},
+	{"DecIfPositive", "subtracts 1 if the value is greater than 0", "", "", "decPositive",
+		"whether it applied."},
+	{"AddChecked", "adds delta unless the sum overflows", "delta V", "delta", "addChecked",
+		"whether it applied."},
+	{"AddSaturating", "adds delta, clamping the sum to the range of the type", "delta V", "delta", "addSaturating",
+		"whether the sum was exact."},
+}
+
+// condRMW returns the conditional arithmetic methods over values of type v,
+// for the SeqCst variants.
+func condRMW(v string) []rmw {
+	var ops []rmw
+	for _, op := range condOps {
+		result := "ok"
+		if op.step == "addSaturating" {
+			result = "exact"
+		}
+		ops = append(ops, rmw{op.name, strings.ReplaceAll(op.params, "V", v), op.args, "old " + v + ", " + result + " bool"})
+	}
+	return ops
+}
+
+// condMethods generates the conditional arithmetic methods of recv, whose
+// values have type val. The steps are the cond.go helpers named by condOps
+// plus suffix. load and cax return the expressions that load the value and
+// compare-exchange it with the given ordering.
+func (g *gen) condMethods(recv, val, suffix string, load func(ord string) string, cax func(ord, old, new string) string) {
+	g.banner(recv + " conditional arithmetic")
+	for _, op := range condOps {
+		for _, ord := range append([]string{""}, orderings...) {
+			o := ord
+			if o == "" {
+				o = "AcqRel"
+			}
+			args := "old"
+			if op.args != "" {
+				args += ", " + op.args
+			}
+			var body string
+			if op.step == "addSaturating" {
+				body = fmt.Sprintf(`	old = %[1]s
+	for {
+		new, exact := %[2]s(%[3]s)
+		if new == old {
+			return old, exact
+		}
+		prev := %[4]s
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}`, load(loadOrder(o)), op.step+suffix, args, cax(o, "old", "new"))
+			} else {
+				body = fmt.Sprintf(`	old = %[1]s
+	for {
+		new, ok := %[2]s(%[3]s)
+		if !ok {
+			return old, false
+		}
+		prev := %[4]s
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}`, load(loadOrder(o)), op.step+suffix, args, cax(o, "old", "new"))
+			}
+			result := "ok"
+			if op.step == "addSaturating" {
+				result = "exact"
+			}
+			g.method(fmt.Sprintf("// %s%s atomically %s, with %s ordering.\n// It returns the observed value and reports %s",
+				op.name, ord, op.doc, orderDoc[ord], op.note),
+				recv, fmt.Sprintf("%s%s(%s) (old %s, %s bool)", op.name, ord, strings.ReplaceAll(op.params, "V", val), val, result), body)
+		}
+	}
+}
+
+// bitTypes lists the types with single-bit operations.
+var bitTypes = []intType{intTypes[5], intTypes[7], intTypes[8]}
+
+var bitOps = []struct{ name, verb string }{
+	{"BitSet", "sets"},
+	{"BitClear", "clears"},
+	{"BitToggle", "flips"},
+}
+
+func (g *gen) bitMethods(t intType) {
+	g.banner(t.Name + " single-bit operations")
+	for _, op := range bitOps {
+		for _, ord := range append([]string{""}, orderings...) {
+			arch := ord
+			if arch == "" {
+				arch = "AcqRel"
+			}
+			g.method(fmt.Sprintf("// %s%s atomically %s bit (modulo the width) and reports whether it was\n"+
+				"// set before, with %s ordering.", op.name, ord, op.verb, orderDoc[ord]),
+				t.Name, fmt.Sprintf("%s%s(bit uint) (wasSet bool)", op.name, ord),
+				fmt.Sprintf("\treturn arch.%s%s%s(&a.v, bit)", op.name, t.Arch, arch))
+		}
+	}
+	for _, op := range bitOps {
+		g.p("// %s%s atomically %s bit (modulo the width) of *addr and reports whether", op.name, t.Name, op.verb)
+		g.p("// it was set before. Unknown orderings fallback to AcqRel.\n//\n//go:nosplit")
+		g.p("func (o MemoryOrder) %s%s(addr *%s, bit uint) (wasSet bool) {\n\tswitch o {", op.name, t.Name, t.Go)
+		for _, ord := range orderings[:3] {
+			g.p("\tcase %s:\n\t\treturn arch.%s%s%s(addr, bit)", ord, op.name, t.Arch, ord)
+		}
+		g.p("\tdefault:\n\t\treturn arch.%s%sAcqRel(addr, bit)\n\t}\n}\n", op.name, t.Arch)
+	}
+}
+
+// rmw describes a read-modify-write method by its signature, with V
+// standing for the value type.
+type rmw struct{ name, params, args, results string }
+
+var (
+	intRMW = []rmw{
+		{"Swap", "new V", "new", "V"},
+		{"CompareAndSwap", "old, new V", "old, new", "bool"},
+		{"CompareExchange", "old, new V", "old, new", "V"},
+		{"Add", "delta V", "delta", "V"},
+		{"Sub", "delta V", "delta", "V"},
+		{"Inc", "", "", "V"},
+		{"Dec", "", "", "V"},
+		{"FetchAdd", "delta V", "delta", "V"},
+		{"FetchSub", "delta V", "delta", "V"},
+		{"FetchInc", "", "", "V"},
+		{"FetchDec", "", "", "V"},
+		{"AddIfBelow", "delta, limit V", "delta, limit", "old V, ok bool"},
+		{"SubIfAtLeast", "delta, floor V", "delta, floor", "old V, ok bool"},
+		{"IncIfNonZero", "", "", "old V, ok bool"},
+		{"DecIfPositive", "", "", "old V, ok bool"},
+		{"AddChecked", "delta V", "delta", "old V, ok bool"},
+		{"AddSaturating", "delta V", "delta", "old V, exact bool"},
+		{"And", "mask V", "mask", "V"},
+		{"Or", "mask V", "mask", "V"},
+		{"Xor", "mask V", "mask", "V"},
+		{"Max", "val V", "val", "V"},
+		{"Min", "val V", "val", "V"},
+	}
+	floatRMW = []rmw{
+		{"Swap", "new V", "new", "V"},
+		{"CompareAndSwap", "old, new V", "old, new", "bool"},
+		{"CompareExchange", "old, new V", "old, new", "V"},
+		{"Add", "delta V", "delta", "V"},
+		{"Sub", "delta V", "delta", "V"},
+		{"Max", "val V", "val", "V"},
+		{"Min", "val V", "val", "V"},
+	}
+	boolRMW = []rmw{
+		{"Swap", "new V", "new", "V"},
+		{"CompareAndSwap", "old, new V", "old, new", "bool"},
+		{"Toggle", "", "", "V"},
+		{"And", "val V", "val", "V"},
+		{"Or", "val V", "val", "V"},
+	}
+	bitRMW = []rmw{
+		{"BitSet", "bit uint", "bit", "wasSet bool"},
+		{"BitClear", "bit uint", "bit", "wasSet bool"},
+		{"BitToggle", "bit uint", "bit", "wasSet bool"},
+	}
+	pointerRMW = []rmw{
+		{"Swap", "new V", "new", "V"},
+		{"CompareAndSwap", "old, new V", "old, new", "bool"},
+		{"CompareExchange", "old, new V", "old, new", "V"},
+	}
+)
+
+// wideRMW returns the 128-bit read-modify-writes; sum names the results of
+// Add/Sub/Inc/Dec, which differ between Uint128 and Int128.
+func wideRMW(sum string) []rmw {
+	ops := []rmw{
+		{"Swap", "newLo, newHi V", "newLo, newHi", "oldLo, oldHi V"},
+		{"CompareAndSwap", "oldLo, oldHi, newLo, newHi V", "oldLo, oldHi, newLo, newHi", "bool"},
+		{"CompareExchange", "oldLo, oldHi, newLo, newHi V", "oldLo, oldHi, newLo, newHi", "lo, hi V"},
+		{"Add", "deltaLo, deltaHi V", "deltaLo, deltaHi", sum},
+		{"Sub", "deltaLo, deltaHi V", "deltaLo, deltaHi", sum},
+		{"Inc", "", "", sum},
+		{"Dec", "", "", sum},
+		{"FetchAdd", "deltaLo, deltaHi V", "deltaLo, deltaHi", "oldLo, oldHi V"},
+		{"FetchSub", "deltaLo, deltaHi V", "deltaLo, deltaHi", "oldLo, oldHi V"},
+		{"FetchInc", "", "", "oldLo, oldHi V"},
+		{"FetchDec", "", "", "oldLo, oldHi V"},
+	}
+	for _, op := range []string{"And", "AndNot", "Or", "Xor", "Max", "Min"} {
+		ops = append(ops, rmw{op, "lo, hi V", "lo, hi", "oldLo, oldHi V"})
+	}
+	return ops
+}
+
+// updateRMW returns Update and TryUpdate over values of type v.
+func updateRMW(v string) []rmw {
+	return []rmw{
+		{"Update", "f func(old " + v + ") " + v, "f", "old, new " + v},
+		{"TryUpdate", "f func(old " + v + ") (" + v + ", bool)", "f", "old, new " + v + ", updated bool"},
+	}
+}
+
+// seqCstType describes the SeqCst methods of one type.
+type seqCstType struct {
+	Recv   string // receiver type, e.g. "Pointer[T]"
+	Val    string // value type
+	Load   string // LoadSeqCst body
+	Store  string // StoreSeqCst body
+	Store1 string // StoreSeqCst parameters
+	Load1  string // LoadSeqCst results
+	RMW    []rmw
+}
+
+func seqCstTypes() []seqCstType {
+	var ts []seqCstType
+	for _, t := range intTypes {
+		ops := intRMW
+		if slices.Contains(bitTypes, t) {
+			ops = append(slices.Clip(ops), bitRMW...)
+		}
+		if t.Arch != "Uint8" && t.Arch != "Uint16" {
+			ops = append(slices.Clip(ops), updateRMW(t.Go)...)
+		}
+		ts = append(ts, seqCstType{
+			Recv: t.Name, Val: t.Go, Load1: t.Go, Store1: "val " + t.Go, RMW: ops,
+			Load:  "\treturn " + conv(t.Go, t.ArchGo, fmt.Sprintf("arch.Load%sSeqCst(&a.v)", t.Arch)),
+			Store: fmt.Sprintf("\tarch.Store%sSeqCst(&a.v, %s)", t.Arch, conv(t.ArchGo, t.Go, "val")),
+		})
+	}
+	for _, t := range ofTypes {
+		ts = append(ts, seqCstType{
+			Recv: t.Name + "[T]", Val: "T", Load1: "T", Store1: "val T", RMW: intRMW,
+			Load:  fmt.Sprintf("\treturn T(arch.Load%sSeqCst(&a.v))", t.Base),
+			Store: fmt.Sprintf("\tarch.Store%sSeqCst(&a.v, %s(val))", t.Base, t.Go),
+		})
+	}
+	for _, bits := range []string{"32", "64"} {
+		ts = append(ts, seqCstType{
+			Recv: "Float" + bits, Val: "float" + bits, Load1: "float" + bits, Store1: "val float" + bits, RMW: floatRMW,
+			Load:  fmt.Sprintf("\treturn math.Float%sfrombits(arch.LoadUint%sSeqCst(&a.v))", bits, bits),
+			Store: fmt.Sprintf("\tarch.StoreUint%sSeqCst(&a.v, math.Float%sbits(val))", bits, bits),
+		})
+	}
+	ts = append(ts,
+		seqCstType{
+			Recv: "Bool", Val: "bool", Load1: "bool", Store1: "val bool",
+			RMW:   append(slices.Clip(boolRMW), updateRMW("bool")...),
+			Load:  "\treturn arch.LoadUint32SeqCst(&a.v) != 0",
+			Store: "\tarch.StoreUint32SeqCst(&a.v, b2u(val))",
+		},
+		seqCstType{
+			Recv: "Pointer[T]", Val: "*T", Load1: "*T", Store1: "val *T",
+			RMW:   append(slices.Clip(pointerRMW), updateRMW("*T")...),
+			Load:  "\treturn (*T)(arch.LoadPointerSeqCst(&a.v))",
+			Store: "\tarch.StorePointerSeqCst(&a.v, unsafe.Pointer(val))",
+		},
+		seqCstType{
+			Recv: "Uint128", Val: "uint64", Load1: "lo, hi uint64", Store1: "lo, hi uint64",
+			RMW:   slices.Concat(wideRMW("newLo, newHi V"), condRMW("U128"), updateRMW("U128")),
+			Load:  "\treturn arch.LoadUint128SeqCst(a.v.slot())",
+			Store: "\tarch.StoreUint128SeqCst(a.v.slot(), lo, hi)",
+		},
+		seqCstType{
+			Recv: "Int128", Val: "int64", Load1: "lo, hi int64", Store1: "lo, hi int64",
+			RMW:   slices.Concat(wideRMW("lo, hi V"), condRMW("I128"), updateRMW("I128")),
+			Load:  "\tulo, uhi := arch.LoadUint128SeqCst(a.v.slot())\n\treturn int64(ulo), int64(uhi)",
+			Store: "\tarch.StoreUint128SeqCst(a.v.slot(), uint64(lo), uint64(hi))",
+		},
+	)
+	return ts
+}
+
+func (g *gen) seqCstMethods(t seqCstType) {
+	g.banner(t.Recv + " SeqCst")
+	results := func(r string) string {
+		r = strings.ReplaceAll(r, "V", t.Val)
+		if strings.Contains(r, " ") {
+			return "(" + r + ")"
+		}
+		return r
+	}
+	g.method("// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.",
+		t.Recv, "LoadSeqCst() "+results(t.Load1), t.Load)
+	g.method("// StoreSeqCst atomically stores the value with sequentially consistent ordering.",
+		t.Recv, "StoreSeqCst("+t.Store1+")", t.Store)
+	for _, op := range t.RMW {
+		doc := fmt.Sprintf("// %[1]sSeqCst is %[1]sAcqRel with sequentially consistent ordering; the\n"+
+			"// acquire-release instructions already provide it.", op.name)
+		g.method(doc, t.Recv,
+			fmt.Sprintf("%sSeqCst(%s) %s", op.name, strings.ReplaceAll(op.params, "V", t.Val), results(op.results)),
+			fmt.Sprintf("\treturn a.%sAcqRel(%s)", op.name, op.args))
+	}
+}
+
+func generate() []byte {
+	var g gen
+	g.p("// Code generated by internal/opsgen; DO NOT EDIT.\n")
+	g.p("package atomix\n")
+	g.p("import (\n\t\"math\"\n\t\"unsafe\"\n\n\t\"code.hybscloud.com/atomix/internal/arch\"\n)\n")
+	for _, t := range intTypes {
+		g.intMethods(t)
+	}
+	for _, t := range ofTypes {
+		g.ofMethods(t)
+	}
+	for _, t := range wideTypes {
+		g.wideMethods(t)
+	}
+	for _, t := range intTypes {
+		g.orderFuncs(t)
+	}
+	for _, t := range bitTypes {
+		g.bitMethods(t)
+	}
+	for _, t := range intTypes {
+		g.condMethods(t.Name, t.Go, "",
+			func(ord string) string {
+				return conv(t.Go, t.ArchGo, fmt.Sprintf("arch.Load%s%s(&a.v)", t.Arch, ord))
+			},
+			func(ord, old, new string) string {
+				return conv(t.Go, t.ArchGo, fmt.Sprintf("arch.Cax%s%s(&a.v, %s, %s)", t.Arch, ord, conv(t.ArchGo, t.Go, old), conv(t.ArchGo, t.Go, new)))
+			})
+	}
+	for _, t := range ofTypes {
+		g.condMethods(t.Name+"[T]", "T", "",
+			func(ord string) string {
+				return fmt.Sprintf("T(arch.Load%s%s(&a.v))", t.Base, ord)
+			},
+			func(ord, old, new string) string {
+				return fmt.Sprintf("T(arch.Cax%s%s(&a.v, %s(%s), %s(%s)))", t.Base, ord, t.Go, old, t.Go, new)
+			})
+	}
+	g.condMethods("Uint128", "U128", "128",
+		func(ord string) string {
+			return fmt.Sprintf("u128(arch.LoadUint128%s(a.v.slot()))", ord)
+		},
+		func(ord, old, new string) string {
+			return fmt.Sprintf("u128(arch.CaxUint128%s(a.v.slot(), %[2]s.Lo, %[2]s.Hi, %[3]s.Lo, %[3]s.Hi))", ord, old, new)
+		})
+	g.condMethods("Int128", "I128", "128",
+		func(ord string) string {
+			return fmt.Sprintf("i128(arch.LoadUint128%s(a.v.slot()))", ord)
+		},
+		func(ord, old, new string) string {
+			return fmt.Sprintf("i128(arch.CaxUint128%s(a.v.slot(), %[2]s.Lo, uint64(%[2]s.Hi), %[3]s.Lo, uint64(%[3]s.Hi)))", ord, old, new)
+		})
+	for _, t := range seqCstTypes() {
+		g.seqCstMethods(t)
+	}
+	src, err := format.Source(g.Bytes())
+	if err != nil {
+		log.Fatalf("opsgen: %v\n%s", err, g.Bytes())
+	}
+	return src
+}
+
+func main() {
+	out := flag.String("o", "ops_gen.go", "output file")
+	flag.Parse()
+	if err := os.WriteFile(*out, generate(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,21 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedUpToDate(t *testing.T) {
+	have, err := os.ReadFile("../../ops_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(have, generate()) {
+		t.Fatal("ops_gen.go is stale; run go generate in the module root")
+	}
+}
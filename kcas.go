@@ -0,0 +1,301 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"cmp"
+	"slices"
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// Multi-word compare-and-swap after Harris, Fraser and Pratt, "A Practical
+// Multi-Word Compare-and-Swap Operation" (DISC 2002).
+//
+// KCAS publishes a descriptor listing every (word, old, new) entry, sorted by
+// address. Phase one installs a marker for the descriptor in each word with
+// RDCSS, a double-compare single-swap that only installs while the descriptor
+// is undecided. Phase two writes the new values if every word matched, or
+// restores the old ones. Any thread that meets a marker helps that operation
+// finish instead of waiting for it, so a stalled thread never blocks others.
+//
+// A KCAS word points at an immutable kcasNode: either a value, or the marker
+// of an operation in flight on the word. Markers are told apart from values
+// by the node alone, so a word may hold any uint64 or pointer and no
+// registry of live descriptors is needed. Every write installs a fresh node,
+// and the garbage collector keeps a node alive while any thread still holds
+// it, so a word never returns to a node it held before (no ABA).
+
+// Descriptor status.
+const (
+	kcasUndecided uint32 = iota
+	kcasSucceeded
+	kcasFailed
+)
+
+// kcasWord is the value of a KCASUint64 (u) or KCASPointer (p) word.
+type kcasWord struct {
+	u uint64
+	p unsafe.Pointer
+}
+
+// kcasNode is what a KCAS word points to: the value v, or the marker of the
+// KCAS d or the RDCSS r in flight on the word. A nil node is the zero value.
+type kcasNode struct {
+	v kcasWord
+	d *kcasDesc
+	r *rdcssDesc
+}
+
+// value returns the value of a value node.
+func (n *kcasNode) value() kcasWord {
+	if n == nil {
+		return kcasWord{}
+	}
+	return n.v
+}
+
+// KCASEntry is one word of a multi-word compare-and-swap, built by
+// [KCASUint64.Entry] or [KCASPointer.Entry].
+type KCASEntry struct {
+	addr     *unsafe.Pointer // the word, holding a *kcasNode
+	old, new kcasWord
+	// Value nodes for the old and new values, set by KCAS.
+	oldNode, newNode *kcasNode
+}
+
+// Load atomically loads and returns the value, accounting for a KCAS in
+// flight on the word.
+func (a *KCASUint64) Load() uint64 {
+	return kcasRead(&a.p).u
+}
+
+// Store atomically stores val. It first completes any KCAS in flight on the
+// word, so the store takes effect after it.
+func (a *KCASUint64) Store(val uint64) {
+	kcasStore(&a.p, kcasWord{u: val})
+}
+
+// CompareAndSwap atomically replaces old with new and reports whether it
+// did. It first completes any KCAS in flight on the word.
+func (a *KCASUint64) CompareAndSwap(old, new uint64) bool {
+	return kcasCompareAndSwap(&a.p, kcasWord{u: old}, kcasWord{u: new})
+}
+
+// Entry returns a [KCAS] entry that replaces old with new in a.
+func (a *KCASUint64) Entry(old, new uint64) KCASEntry {
+	return KCASEntry{addr: &a.p, old: kcasWord{u: old}, new: kcasWord{u: new}}
+}
+
+// Load atomically loads and returns the pointer, accounting for a KCAS in
+// flight on the word.
+func (a *KCASPointer[T]) Load() *T {
+	return (*T)(kcasRead(&a.p).p)
+}
+
+// Store atomically stores val. It first completes any KCAS in flight on the
+// word, so the store takes effect after it.
+func (a *KCASPointer[T]) Store(val *T) {
+	kcasStore(&a.p, kcasWord{p: unsafe.Pointer(val)})
+}
+
+// CompareAndSwap atomically replaces old with new and reports whether it
+// did. It first completes any KCAS in flight on the word.
+func (a *KCASPointer[T]) CompareAndSwap(old, new *T) bool {
+	return kcasCompareAndSwap(&a.p, kcasWord{p: unsafe.Pointer(old)}, kcasWord{p: unsafe.Pointer(new)})
+}
+
+// Entry returns a [KCAS] entry that replaces old with new in a.
+func (a *KCASPointer[T]) Entry(old, new *T) KCASEntry {
+	return KCASEntry{addr: &a.p, old: kcasWord{p: unsafe.Pointer(old)}, new: kcasWord{p: unsafe.Pointer(new)}}
+}
+
+// KCAS atomically replaces the old value of every entry with its new value
+// if all of them hold their old value, and reports whether it did. The
+// operation takes no locks and is linearizable; a successful KCAS orders
+// like a sequentially consistent RMW on each word.
+//
+// Entries name [KCASUint64] and [KCASPointer] words. KCAS panics if two
+// entries name the same word.
+func KCAS(entries ...KCASEntry) bool {
+	if len(entries) == 0 {
+		return true
+	}
+	d := &kcasDesc{entries: slices.Clone(entries)}
+	slices.SortFunc(d.entries, func(a, b KCASEntry) int {
+		return cmp.Compare(uintptr(unsafe.Pointer(a.addr)), uintptr(unsafe.Pointer(b.addr)))
+	})
+	for i := 1; i < len(d.entries); i++ {
+		if d.entries[i].addr == d.entries[i-1].addr {
+			panic("atomix: KCAS entries name the same word")
+		}
+	}
+	// A word that already differs fails the operation at that read,
+	// without publishing a descriptor.
+	for i := range d.entries {
+		e := &d.entries[i]
+		if kcasRead(e.addr) != e.old {
+			return false
+		}
+		e.oldNode, e.newNode = &kcasNode{v: e.old}, &kcasNode{v: e.new}
+	}
+	d.mark = &kcasNode{d: d}
+	return d.help()
+}
+
+// kcasDesc describes one KCAS operation.
+type kcasDesc struct {
+	status  Uint32
+	mark    *kcasNode
+	entries []KCASEntry // sorted by address
+}
+
+// rdcssDesc describes the installation of a kcasDesc marker in one word,
+// replacing the value node old.
+type rdcssDesc struct {
+	cd        *kcasDesc
+	e         *KCASEntry
+	old, mark *kcasNode
+}
+
+// help runs d to completion and reports whether it succeeded.
+func (d *kcasDesc) help() bool {
+	if d.status.LoadAcquire() == kcasUndecided {
+		status := kcasSucceeded
+	install:
+		for i := range d.entries {
+			e := &d.entries[i]
+			for {
+				n := d.rdcss(e)
+				if n == d.mark {
+					break
+				}
+				if n != nil && n.d != nil {
+					n.d.help()
+					continue
+				}
+				status = kcasFailed
+				break install
+			}
+		}
+		d.status.CompareAndSwapAcqRel(kcasUndecided, status)
+	}
+	ok := d.status.LoadAcquire() == kcasSucceeded
+	for i := range d.entries {
+		e := &d.entries[i]
+		n := e.oldNode
+		if ok {
+			n = e.newNode
+		}
+		arch.CaxPointerAcqRel(e.addr, unsafe.Pointer(d.mark), unsafe.Pointer(n))
+	}
+	return ok
+}
+
+// rdcss installs d's marker in e's word if the word holds e.old while d is
+// undecided. It returns the node the word then holds: d's marker, the marker
+// of another KCAS, or a value node that does not match.
+func (d *kcasDesc) rdcss(e *KCASEntry) *kcasNode {
+	for {
+		n := (*kcasNode)(arch.LoadPointerAcquire(e.addr))
+		switch {
+		case n != nil && n.r != nil:
+			n.r.complete()
+			continue
+		case n != nil && n.d != nil:
+			return n
+		case n.value() != e.old || d.status.LoadAcquire() != kcasUndecided:
+			return n
+		}
+		r := &rdcssDesc{cd: d, e: e, old: n}
+		r.mark = &kcasNode{r: r}
+		if arch.CaxPointerAcqRel(e.addr, unsafe.Pointer(n), unsafe.Pointer(r.mark)) == unsafe.Pointer(n) {
+			r.complete()
+		}
+	}
+}
+
+// complete replaces r's marker with the KCAS marker if the KCAS is still
+// undecided, or puts the old value node back.
+func (r *rdcssDesc) complete() {
+	n := r.old
+	if r.cd.status.LoadAcquire() == kcasUndecided {
+		n = r.cd.mark
+	}
+	arch.CaxPointerAcqRel(r.e.addr, unsafe.Pointer(r.mark), unsafe.Pointer(n))
+}
+
+// entry returns d's entry for the word at addr.
+func (d *kcasDesc) entry(addr *unsafe.Pointer) *KCASEntry {
+	for i := range d.entries {
+		if d.entries[i].addr == addr {
+			return &d.entries[i]
+		}
+	}
+	panic("atomix: KCAS marker in a word outside its entries")
+}
+
+// kcasRead returns the value of the word at addr. A word under an RDCSS
+// marker holds its old value; a word under a KCAS marker holds the new value
+// once that KCAS has succeeded and the old value until then.
+func kcasRead(addr *unsafe.Pointer) kcasWord {
+	n := (*kcasNode)(arch.LoadPointerAcquire(addr))
+	switch {
+	case n == nil:
+		return kcasWord{}
+	case n.r != nil:
+		return n.r.old.value()
+	case n.d != nil:
+		e := n.d.entry(addr)
+		if n.d.status.LoadAcquire() == kcasSucceeded {
+			return e.new
+		}
+		return e.old
+	}
+	return n.v
+}
+
+// kcasSettle returns the value node of the word at addr, completing any
+// operation in flight on it first.
+func kcasSettle(addr *unsafe.Pointer) *kcasNode {
+	for {
+		n := (*kcasNode)(arch.LoadPointerAcquire(addr))
+		switch {
+		case n == nil || (n.r == nil && n.d == nil):
+			return n
+		case n.r != nil:
+			n.r.complete()
+		default:
+			n.d.help()
+		}
+	}
+}
+
+func kcasStore(addr *unsafe.Pointer, w kcasWord) {
+	nn := &kcasNode{v: w}
+	for {
+		n := kcasSettle(addr)
+		if arch.CaxPointerAcqRel(addr, unsafe.Pointer(n), unsafe.Pointer(nn)) == unsafe.Pointer(n) {
+			return
+		}
+	}
+}
+
+func kcasCompareAndSwap(addr *unsafe.Pointer, old, new kcasWord) bool {
+	var nn *kcasNode
+	for {
+		n := kcasSettle(addr)
+		if n.value() != old {
+			return false
+		}
+		if nn == nil {
+			nn = &kcasNode{v: new}
+		}
+		if arch.CaxPointerAcqRel(addr, unsafe.Pointer(n), unsafe.Pointer(nn)) == unsafe.Pointer(n) {
+			return true
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// KCAS Tests
+// =============================================================================
+
+func TestKCAS(t *testing.T) {
+	type node struct{ v int }
+	a, b := &node{1}, &node{2}
+	var x, y atomix.KCASUint64
+	var p atomix.KCASPointer[node]
+	x.Store(10)
+	p.Store(a)
+
+	if !atomix.KCAS() {
+		t.Fatal("empty KCAS failed")
+	}
+	if atomix.KCAS(x.Entry(10, 11), y.Entry(1, 2), p.Entry(a, b)) {
+		t.Fatal("KCAS with a stale entry succeeded")
+	}
+	if x.Load() != 10 || y.Load() != 0 || p.Load() != a {
+		t.Fatalf("failed KCAS wrote: x=%d y=%d", x.Load(), y.Load())
+	}
+	if !atomix.KCAS(p.Entry(a, b), y.Entry(0, 2), x.Entry(10, 11)) {
+		t.Fatal("KCAS with matching entries failed")
+	}
+	if x.Load() != 11 || y.Load() != 2 || p.Load() != b {
+		t.Fatalf("KCAS result: x=%d y=%d", x.Load(), y.Load())
+	}
+	if y.CompareAndSwap(0, 3) || !y.CompareAndSwap(2, 3) || y.Load() != 3 {
+		t.Fatalf("CompareAndSwap after KCAS: y=%d", y.Load())
+	}
+	if !p.CompareAndSwap(b, nil) || p.Load() != nil {
+		t.Fatal("CompareAndSwap to nil failed")
+	}
+}
+
+func TestKCASPanics(t *testing.T) {
+	var x atomix.KCASUint64
+	defer func() {
+		if recover() == nil {
+			t.Error("same word: no panic")
+		}
+	}()
+	atomix.KCAS(x.Entry(0, 1), x.Entry(1, 2))
+}
+
+// KCAS words take any value: all 64 bits and pointers with the low bit set.
+func TestKCASAnyValue(t *testing.T) {
+	var x atomix.KCASUint64
+	for _, v := range []uint64{1 << 63, 1<<63 | 1, ^uint64(0), 0} {
+		old := x.Load()
+		if !atomix.KCAS(x.Entry(old, v)) || x.Load() != v {
+			t.Fatalf("KCAS %#x -> %#x: loaded %#x", old, v, x.Load())
+		}
+	}
+	buf := make([]byte, 8)
+	var p atomix.KCASPointer[byte]
+	for i := range buf {
+		old := p.Load()
+		if !atomix.KCAS(p.Entry(old, &buf[i]), x.Entry(uint64(i), uint64(i+1))) || p.Load() != &buf[i] {
+			t.Fatalf("KCAS to buf[%d]: loaded %p", i, p.Load())
+		}
+	}
+	if x.Load() != uint64(len(buf)) {
+		t.Fatalf("x = %d, want %d", x.Load(), len(buf))
+	}
+}
+
+// TestKCASStressTransfers moves units between accounts with KCAS and replays
+// every successful transfer on a mutex-guarded reference. Concurrent readers
+// use Load, which must never expose a descriptor.
+func TestKCASStressTransfers(t *testing.T) {
+	const accounts, initial = 8, 1000
+	workers, iters := 4*runtime.GOMAXPROCS(0)+2, 4000
+	if testing.Short() {
+		iters = 500
+	}
+
+	var acc [accounts]atomix.KCASUint64
+	for i := range acc {
+		acc[i].Store(initial)
+	}
+	var ref struct {
+		sync.Mutex
+		bal [accounts]int64
+	}
+	for i := range ref.bal {
+		ref.bal[i] = initial
+	}
+
+	var done atomix.Bool
+	var readers sync.WaitGroup
+	for range 2 {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for !done.Load() {
+				var sum uint64
+				var entries []atomix.KCASEntry
+				for i := range acc {
+					v := acc[i].Load()
+					if v > accounts*initial {
+						t.Errorf("account %d loaded %#x", i, v)
+						return
+					}
+					sum += v
+					entries = append(entries, acc[i].Entry(v, v))
+				}
+				// An identity KCAS validates the reads as one snapshot.
+				if atomix.KCAS(entries...) && sum != accounts*initial {
+					t.Errorf("snapshot sum %d, want %d", sum, accounts*initial)
+					return
+				}
+			}
+		}()
+	}
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := rand.New(rand.NewPCG(uint64(w), 19))
+			for range iters {
+				from, to, fee := r.IntN(accounts), r.IntN(accounts), r.IntN(accounts)
+				if from == to || fee == from || fee == to {
+					continue
+				}
+				amount := uint64(r.IntN(20) + 2)
+				f, tv, fv := acc[from].Load(), acc[to].Load(), acc[fee].Load()
+				if f < amount {
+					continue
+				}
+				if !atomix.KCAS(
+					acc[from].Entry(f, f-amount),
+					acc[to].Entry(tv, tv+amount-1),
+					acc[fee].Entry(fv, fv+1),
+				) {
+					continue
+				}
+				ref.Lock()
+				ref.bal[from] -= int64(amount)
+				ref.bal[to] += int64(amount) - 1
+				ref.bal[fee]++
+				ref.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	done.Store(true)
+	readers.Wait()
+
+	for i := range acc {
+		if got := int64(acc[i].Load()); got != ref.bal[i] {
+			t.Errorf("account %d = %d, reference %d", i, got, ref.bal[i])
+		}
+	}
+}
+
+// TestKCASStressPointers swaps tokens between KCASPointer slots together with
+// a KCASUint64 move counter, and checks the result against a mutex reference.
+func TestKCASStressPointers(t *testing.T) {
+	type token struct{ id int }
+	const slots = 6
+	workers, iters := 4*runtime.GOMAXPROCS(0)+2, 4000
+	if testing.Short() {
+		iters = 500
+	}
+
+	var slot [slots]atomix.KCASPointer[token]
+	known := make(map[*token]bool)
+	for i := range slot {
+		tk := &token{i}
+		known[tk] = true
+		slot[i].Store(tk)
+	}
+	var moves atomix.KCASUint64
+	var ref struct {
+		sync.Mutex
+		moves uint64
+	}
+
+	var done atomix.Bool
+	var reader sync.WaitGroup
+	reader.Add(1)
+	go func() {
+		defer reader.Done()
+		for !done.Load() {
+			for i := range slot {
+				if p := slot[i].Load(); !known[p] {
+					t.Errorf("slot %d loaded %p", i, p)
+					return
+				}
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := rand.New(rand.NewPCG(uint64(w), 23))
+			for range iters {
+				i, j := r.IntN(slots), r.IntN(slots)
+				if i == j {
+					continue
+				}
+				a, b, m := slot[i].Load(), slot[j].Load(), moves.Load()
+				if atomix.KCAS(
+					slot[i].Entry(a, b),
+					slot[j].Entry(b, a),
+					moves.Entry(m, m+1),
+				) {
+					ref.Lock()
+					ref.moves++
+					ref.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	done.Store(true)
+	reader.Wait()
+
+	if moves.Load() != ref.moves {
+		t.Errorf("moves = %d, reference %d", moves.Load(), ref.moves)
+	}
+	seen := make(map[*token]bool)
+	for i := range slot {
+		seen[slot[i].Load()] = true
+	}
+	if len(seen) != slots {
+		t.Errorf("slots hold %d distinct tokens, want %d", len(seen), slots)
+	}
+}
+
+// TestKCASStressMixedWrites bumps two words together with KCAS while other
+// goroutines bump one of them with CompareAndSwap, which must complete any
+// KCAS in flight rather than overwrite its marker.
+func TestKCASStressMixedWrites(t *testing.T) {
+	workers, iters := 2*runtime.GOMAXPROCS(0)+1, 4000
+	if testing.Short() {
+		iters = 500
+	}
+	var x, y atomix.KCASUint64
+	var kcasOps, casOps atomix.Uint64
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iters {
+				if w%2 == 0 {
+					a, b := x.Load(), y.Load()
+					if atomix.KCAS(x.Entry(a, a+1), y.Entry(b, b+1)) {
+						kcasOps.Add(1)
+					}
+					continue
+				}
+				if v := x.Load(); x.CompareAndSwap(v, v+1) {
+					casOps.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if x.Load() != kcasOps.Load()+casOps.Load() || y.Load() != kcasOps.Load() {
+		t.Errorf("x=%d y=%d, want %d and %d", x.Load(), y.Load(), kcasOps.Load()+casOps.Load(), kcasOps.Load())
+	}
+}
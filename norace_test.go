@@ -0,0 +1,11 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build !race
+
+package atomix_test
+
+// raceEnabled reports whether the tests run under the race detector, which
+// selects the sync/atomic backend.
+const raceEnabled = false
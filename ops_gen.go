@@ -0,0 +1,18426 @@
+// Code generated by internal/opsgen; DO NOT EDIT.
+
+package atomix
+
+import (
+	"math"
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// =============================================================================
+// Int8
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Inc() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, 1))
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) Dec() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, ^uint8(0)))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) IncRelaxed() int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, 1))
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) DecRelaxed() int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, ^uint8(0)))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) IncAcquire() int8 {
+	return int8(arch.AddUint8Acquire(&a.v, 1))
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) DecAcquire() int8 {
+	return int8(arch.AddUint8Acquire(&a.v, ^uint8(0)))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int8) IncRelease() int8 {
+	return int8(arch.AddUint8Release(&a.v, 1))
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int8) DecRelease() int8 {
+	return int8(arch.AddUint8Release(&a.v, ^uint8(0)))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) IncAcqRel() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, 1))
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) DecAcqRel() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, ^uint8(0)))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchAdd(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, uint8(delta))) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchSub(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, ^(uint8(delta)-1))) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchInc() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, 1)) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchDec() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, ^uint8(0))) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) FetchAddRelaxed(delta int8) int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, uint8(delta))) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) FetchSubRelaxed(delta int8) int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, ^(uint8(delta)-1))) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) FetchIncRelaxed() int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, 1)) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int8) FetchDecRelaxed() int8 {
+	return int8(arch.AddUint8Relaxed(&a.v, ^uint8(0))) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) FetchAddAcquire(delta int8) int8 {
+	return int8(arch.AddUint8Acquire(&a.v, uint8(delta))) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) FetchSubAcquire(delta int8) int8 {
+	return int8(arch.AddUint8Acquire(&a.v, ^(uint8(delta)-1))) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) FetchIncAcquire() int8 {
+	return int8(arch.AddUint8Acquire(&a.v, 1)) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) FetchDecAcquire() int8 {
+	return int8(arch.AddUint8Acquire(&a.v, ^uint8(0))) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchAddRelease(delta int8) int8 {
+	return int8(arch.AddUint8Release(&a.v, uint8(delta))) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchSubRelease(delta int8) int8 {
+	return int8(arch.AddUint8Release(&a.v, ^(uint8(delta)-1))) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchIncRelease() int8 {
+	return int8(arch.AddUint8Release(&a.v, 1)) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchDecRelease() int8 {
+	return int8(arch.AddUint8Release(&a.v, ^uint8(0))) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchAddAcqRel(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, uint8(delta))) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchSubAcqRel(delta int8) int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, ^(uint8(delta)-1))) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchIncAcqRel() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, 1)) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) FetchDecAcqRel() int8 {
+	return int8(arch.AddUint8AcqRel(&a.v, ^uint8(0))) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) MaxAcquire(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Acquire(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8Acquire(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Int8) MinAcquire(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Acquire(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8Acquire(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Int8) MaxRelease(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Relaxed(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8Release(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Int8) MinRelease(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Relaxed(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8Release(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) MaxAcqRel(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Acquire(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int8) MinAcqRel(val int8) int8 {
+	for {
+		old := int8(arch.LoadUint8Acquire(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Uint8
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Inc() uint8 {
+	return arch.AddUint8AcqRel(&a.v, 1)
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Dec() uint8 {
+	return arch.AddUint8AcqRel(&a.v, ^uint8(0))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) IncRelaxed() uint8 {
+	return arch.AddUint8Relaxed(&a.v, 1)
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) DecRelaxed() uint8 {
+	return arch.AddUint8Relaxed(&a.v, ^uint8(0))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) IncAcquire() uint8 {
+	return arch.AddUint8Acquire(&a.v, 1)
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) DecAcquire() uint8 {
+	return arch.AddUint8Acquire(&a.v, ^uint8(0))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) IncRelease() uint8 {
+	return arch.AddUint8Release(&a.v, 1)
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) DecRelease() uint8 {
+	return arch.AddUint8Release(&a.v, ^uint8(0))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) IncAcqRel() uint8 {
+	return arch.AddUint8AcqRel(&a.v, 1)
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) DecAcqRel() uint8 {
+	return arch.AddUint8AcqRel(&a.v, ^uint8(0))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchAdd(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, delta) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchSub(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchInc() uint8 {
+	return arch.AddUint8AcqRel(&a.v, 1) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchDec() uint8 {
+	return arch.AddUint8AcqRel(&a.v, ^uint8(0)) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchAddRelaxed(delta uint8) uint8 {
+	return arch.AddUint8Relaxed(&a.v, delta) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchSubRelaxed(delta uint8) uint8 {
+	return arch.AddUint8Relaxed(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchIncRelaxed() uint8 {
+	return arch.AddUint8Relaxed(&a.v, 1) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchDecRelaxed() uint8 {
+	return arch.AddUint8Relaxed(&a.v, ^uint8(0)) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchAddAcquire(delta uint8) uint8 {
+	return arch.AddUint8Acquire(&a.v, delta) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchSubAcquire(delta uint8) uint8 {
+	return arch.AddUint8Acquire(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchIncAcquire() uint8 {
+	return arch.AddUint8Acquire(&a.v, 1) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchDecAcquire() uint8 {
+	return arch.AddUint8Acquire(&a.v, ^uint8(0)) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchAddRelease(delta uint8) uint8 {
+	return arch.AddUint8Release(&a.v, delta) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchSubRelease(delta uint8) uint8 {
+	return arch.AddUint8Release(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchIncRelease() uint8 {
+	return arch.AddUint8Release(&a.v, 1) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchDecRelease() uint8 {
+	return arch.AddUint8Release(&a.v, ^uint8(0)) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchAddAcqRel(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, delta) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchSubAcqRel(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchIncAcqRel() uint8 {
+	return arch.AddUint8AcqRel(&a.v, 1) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) FetchDecAcqRel() uint8 {
+	return arch.AddUint8AcqRel(&a.v, ^uint8(0)) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) MaxAcquire(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) MinAcquire(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uint8) MaxRelease(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uint8) MinRelease(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) MaxAcqRel(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) MinAcqRel(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Int16
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Inc() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, 1))
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) Dec() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, ^uint16(0)))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) IncRelaxed() int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, 1))
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) DecRelaxed() int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, ^uint16(0)))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) IncAcquire() int16 {
+	return int16(arch.AddUint16Acquire(&a.v, 1))
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) DecAcquire() int16 {
+	return int16(arch.AddUint16Acquire(&a.v, ^uint16(0)))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int16) IncRelease() int16 {
+	return int16(arch.AddUint16Release(&a.v, 1))
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int16) DecRelease() int16 {
+	return int16(arch.AddUint16Release(&a.v, ^uint16(0)))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) IncAcqRel() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, 1))
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) DecAcqRel() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, ^uint16(0)))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchAdd(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, uint16(delta))) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchSub(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, ^(uint16(delta)-1))) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchInc() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, 1)) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchDec() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, ^uint16(0))) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) FetchAddRelaxed(delta int16) int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, uint16(delta))) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) FetchSubRelaxed(delta int16) int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, ^(uint16(delta)-1))) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) FetchIncRelaxed() int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, 1)) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int16) FetchDecRelaxed() int16 {
+	return int16(arch.AddUint16Relaxed(&a.v, ^uint16(0))) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) FetchAddAcquire(delta int16) int16 {
+	return int16(arch.AddUint16Acquire(&a.v, uint16(delta))) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) FetchSubAcquire(delta int16) int16 {
+	return int16(arch.AddUint16Acquire(&a.v, ^(uint16(delta)-1))) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) FetchIncAcquire() int16 {
+	return int16(arch.AddUint16Acquire(&a.v, 1)) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) FetchDecAcquire() int16 {
+	return int16(arch.AddUint16Acquire(&a.v, ^uint16(0))) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchAddRelease(delta int16) int16 {
+	return int16(arch.AddUint16Release(&a.v, uint16(delta))) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchSubRelease(delta int16) int16 {
+	return int16(arch.AddUint16Release(&a.v, ^(uint16(delta)-1))) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchIncRelease() int16 {
+	return int16(arch.AddUint16Release(&a.v, 1)) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchDecRelease() int16 {
+	return int16(arch.AddUint16Release(&a.v, ^uint16(0))) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchAddAcqRel(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, uint16(delta))) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchSubAcqRel(delta int16) int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, ^(uint16(delta)-1))) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchIncAcqRel() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, 1)) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) FetchDecAcqRel() int16 {
+	return int16(arch.AddUint16AcqRel(&a.v, ^uint16(0))) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) MaxAcquire(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Acquire(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16Acquire(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Int16) MinAcquire(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Acquire(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16Acquire(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Int16) MaxRelease(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Relaxed(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16Release(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Int16) MinRelease(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Relaxed(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16Release(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) MaxAcqRel(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Acquire(&a.v))
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int16) MinAcqRel(val int16) int16 {
+	for {
+		old := int16(arch.LoadUint16Acquire(&a.v))
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Uint16
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Inc() uint16 {
+	return arch.AddUint16AcqRel(&a.v, 1)
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Dec() uint16 {
+	return arch.AddUint16AcqRel(&a.v, ^uint16(0))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) IncRelaxed() uint16 {
+	return arch.AddUint16Relaxed(&a.v, 1)
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) DecRelaxed() uint16 {
+	return arch.AddUint16Relaxed(&a.v, ^uint16(0))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) IncAcquire() uint16 {
+	return arch.AddUint16Acquire(&a.v, 1)
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) DecAcquire() uint16 {
+	return arch.AddUint16Acquire(&a.v, ^uint16(0))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) IncRelease() uint16 {
+	return arch.AddUint16Release(&a.v, 1)
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) DecRelease() uint16 {
+	return arch.AddUint16Release(&a.v, ^uint16(0))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) IncAcqRel() uint16 {
+	return arch.AddUint16AcqRel(&a.v, 1)
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) DecAcqRel() uint16 {
+	return arch.AddUint16AcqRel(&a.v, ^uint16(0))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchAdd(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, delta) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchSub(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchInc() uint16 {
+	return arch.AddUint16AcqRel(&a.v, 1) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchDec() uint16 {
+	return arch.AddUint16AcqRel(&a.v, ^uint16(0)) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchAddRelaxed(delta uint16) uint16 {
+	return arch.AddUint16Relaxed(&a.v, delta) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchSubRelaxed(delta uint16) uint16 {
+	return arch.AddUint16Relaxed(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchIncRelaxed() uint16 {
+	return arch.AddUint16Relaxed(&a.v, 1) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchDecRelaxed() uint16 {
+	return arch.AddUint16Relaxed(&a.v, ^uint16(0)) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchAddAcquire(delta uint16) uint16 {
+	return arch.AddUint16Acquire(&a.v, delta) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchSubAcquire(delta uint16) uint16 {
+	return arch.AddUint16Acquire(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchIncAcquire() uint16 {
+	return arch.AddUint16Acquire(&a.v, 1) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchDecAcquire() uint16 {
+	return arch.AddUint16Acquire(&a.v, ^uint16(0)) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchAddRelease(delta uint16) uint16 {
+	return arch.AddUint16Release(&a.v, delta) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchSubRelease(delta uint16) uint16 {
+	return arch.AddUint16Release(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchIncRelease() uint16 {
+	return arch.AddUint16Release(&a.v, 1) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchDecRelease() uint16 {
+	return arch.AddUint16Release(&a.v, ^uint16(0)) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchAddAcqRel(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, delta) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchSubAcqRel(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchIncAcqRel() uint16 {
+	return arch.AddUint16AcqRel(&a.v, 1) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) FetchDecAcqRel() uint16 {
+	return arch.AddUint16AcqRel(&a.v, ^uint16(0)) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) MaxAcquire(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) MinAcquire(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uint16) MaxRelease(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uint16) MinRelease(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) MaxAcqRel(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) MinAcqRel(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Int32
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) Inc() int32 {
+	return arch.AddInt32AcqRel(&a.v, 1)
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) Dec() int32 {
+	return arch.AddInt32AcqRel(&a.v, -1)
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32) IncRelaxed() int32 {
+	return arch.AddInt32Relaxed(&a.v, 1)
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32) DecRelaxed() int32 {
+	return arch.AddInt32Relaxed(&a.v, -1)
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) IncAcquire() int32 {
+	return arch.AddInt32Acquire(&a.v, 1)
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) DecAcquire() int32 {
+	return arch.AddInt32Acquire(&a.v, -1)
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int32) IncRelease() int32 {
+	return arch.AddInt32Release(&a.v, 1)
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int32) DecRelease() int32 {
+	return arch.AddInt32Release(&a.v, -1)
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) IncAcqRel() int32 {
+	return arch.AddInt32AcqRel(&a.v, 1)
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) DecAcqRel() int32 {
+	return arch.AddInt32AcqRel(&a.v, -1)
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchAdd(delta int32) int32 {
+	return arch.AddInt32AcqRel(&a.v, delta) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchSub(delta int32) int32 {
+	return arch.AddInt32AcqRel(&a.v, -delta) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchInc() int32 {
+	return arch.AddInt32AcqRel(&a.v, 1) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchDec() int32 {
+	return arch.AddInt32AcqRel(&a.v, -1) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32) FetchAddRelaxed(delta int32) int32 {
+	return arch.AddInt32Relaxed(&a.v, delta) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32) FetchSubRelaxed(delta int32) int32 {
+	return arch.AddInt32Relaxed(&a.v, -delta) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32) FetchIncRelaxed() int32 {
+	return arch.AddInt32Relaxed(&a.v, 1) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32) FetchDecRelaxed() int32 {
+	return arch.AddInt32Relaxed(&a.v, -1) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) FetchAddAcquire(delta int32) int32 {
+	return arch.AddInt32Acquire(&a.v, delta) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) FetchSubAcquire(delta int32) int32 {
+	return arch.AddInt32Acquire(&a.v, -delta) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) FetchIncAcquire() int32 {
+	return arch.AddInt32Acquire(&a.v, 1) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) FetchDecAcquire() int32 {
+	return arch.AddInt32Acquire(&a.v, -1) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchAddRelease(delta int32) int32 {
+	return arch.AddInt32Release(&a.v, delta) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchSubRelease(delta int32) int32 {
+	return arch.AddInt32Release(&a.v, -delta) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchIncRelease() int32 {
+	return arch.AddInt32Release(&a.v, 1) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchDecRelease() int32 {
+	return arch.AddInt32Release(&a.v, -1) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchAddAcqRel(delta int32) int32 {
+	return arch.AddInt32AcqRel(&a.v, delta) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchSubAcqRel(delta int32) int32 {
+	return arch.AddInt32AcqRel(&a.v, -delta) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchIncAcqRel() int32 {
+	return arch.AddInt32AcqRel(&a.v, 1) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) FetchDecAcqRel() int32 {
+	return arch.AddInt32AcqRel(&a.v, -1) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) MaxAcquire(val int32) int32 {
+	for {
+		old := arch.LoadInt32Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasInt32Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Int32) MinAcquire(val int32) int32 {
+	for {
+		old := arch.LoadInt32Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasInt32Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Int32) MaxRelease(val int32) int32 {
+	for {
+		old := arch.LoadInt32Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasInt32Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Int32) MinRelease(val int32) int32 {
+	for {
+		old := arch.LoadInt32Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasInt32Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) MaxAcqRel(val int32) int32 {
+	for {
+		old := arch.LoadInt32Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasInt32AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32) MinAcqRel(val int32) int32 {
+	for {
+		old := arch.LoadInt32Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasInt32AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Uint32
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) Inc() uint32 {
+	return arch.AddUint32AcqRel(&a.v, 1)
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) Dec() uint32 {
+	return arch.AddUint32AcqRel(&a.v, ^uint32(0))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) IncRelaxed() uint32 {
+	return arch.AddUint32Relaxed(&a.v, 1)
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) DecRelaxed() uint32 {
+	return arch.AddUint32Relaxed(&a.v, ^uint32(0))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) IncAcquire() uint32 {
+	return arch.AddUint32Acquire(&a.v, 1)
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) DecAcquire() uint32 {
+	return arch.AddUint32Acquire(&a.v, ^uint32(0))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint32) IncRelease() uint32 {
+	return arch.AddUint32Release(&a.v, 1)
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint32) DecRelease() uint32 {
+	return arch.AddUint32Release(&a.v, ^uint32(0))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) IncAcqRel() uint32 {
+	return arch.AddUint32AcqRel(&a.v, 1)
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) DecAcqRel() uint32 {
+	return arch.AddUint32AcqRel(&a.v, ^uint32(0))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchAdd(delta uint32) uint32 {
+	return arch.AddUint32AcqRel(&a.v, delta) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchSub(delta uint32) uint32 {
+	return arch.AddUint32AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchInc() uint32 {
+	return arch.AddUint32AcqRel(&a.v, 1) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchDec() uint32 {
+	return arch.AddUint32AcqRel(&a.v, ^uint32(0)) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchAddRelaxed(delta uint32) uint32 {
+	return arch.AddUint32Relaxed(&a.v, delta) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchSubRelaxed(delta uint32) uint32 {
+	return arch.AddUint32Relaxed(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchIncRelaxed() uint32 {
+	return arch.AddUint32Relaxed(&a.v, 1) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchDecRelaxed() uint32 {
+	return arch.AddUint32Relaxed(&a.v, ^uint32(0)) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchAddAcquire(delta uint32) uint32 {
+	return arch.AddUint32Acquire(&a.v, delta) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchSubAcquire(delta uint32) uint32 {
+	return arch.AddUint32Acquire(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchIncAcquire() uint32 {
+	return arch.AddUint32Acquire(&a.v, 1) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchDecAcquire() uint32 {
+	return arch.AddUint32Acquire(&a.v, ^uint32(0)) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchAddRelease(delta uint32) uint32 {
+	return arch.AddUint32Release(&a.v, delta) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchSubRelease(delta uint32) uint32 {
+	return arch.AddUint32Release(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchIncRelease() uint32 {
+	return arch.AddUint32Release(&a.v, 1) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchDecRelease() uint32 {
+	return arch.AddUint32Release(&a.v, ^uint32(0)) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchAddAcqRel(delta uint32) uint32 {
+	return arch.AddUint32AcqRel(&a.v, delta) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchSubAcqRel(delta uint32) uint32 {
+	return arch.AddUint32AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchIncAcqRel() uint32 {
+	return arch.AddUint32AcqRel(&a.v, 1) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) FetchDecAcqRel() uint32 {
+	return arch.AddUint32AcqRel(&a.v, ^uint32(0)) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) MaxAcquire(val uint32) uint32 {
+	for {
+		old := arch.LoadUint32Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint32Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) MinAcquire(val uint32) uint32 {
+	for {
+		old := arch.LoadUint32Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint32Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uint32) MaxRelease(val uint32) uint32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint32Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uint32) MinRelease(val uint32) uint32 {
+	for {
+		old := arch.LoadUint32Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint32Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) MaxAcqRel(val uint32) uint32 {
+	for {
+		old := arch.LoadUint32Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint32AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) MinAcqRel(val uint32) uint32 {
+	for {
+		old := arch.LoadUint32Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint32AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Int64
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) Inc() int64 {
+	return arch.AddInt64AcqRel(&a.v, 1)
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) Dec() int64 {
+	return arch.AddInt64AcqRel(&a.v, -1)
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64) IncRelaxed() int64 {
+	return arch.AddInt64Relaxed(&a.v, 1)
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64) DecRelaxed() int64 {
+	return arch.AddInt64Relaxed(&a.v, -1)
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) IncAcquire() int64 {
+	return arch.AddInt64Acquire(&a.v, 1)
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) DecAcquire() int64 {
+	return arch.AddInt64Acquire(&a.v, -1)
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int64) IncRelease() int64 {
+	return arch.AddInt64Release(&a.v, 1)
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int64) DecRelease() int64 {
+	return arch.AddInt64Release(&a.v, -1)
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) IncAcqRel() int64 {
+	return arch.AddInt64AcqRel(&a.v, 1)
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) DecAcqRel() int64 {
+	return arch.AddInt64AcqRel(&a.v, -1)
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchAdd(delta int64) int64 {
+	return arch.AddInt64AcqRel(&a.v, delta) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchSub(delta int64) int64 {
+	return arch.AddInt64AcqRel(&a.v, -delta) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchInc() int64 {
+	return arch.AddInt64AcqRel(&a.v, 1) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchDec() int64 {
+	return arch.AddInt64AcqRel(&a.v, -1) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64) FetchAddRelaxed(delta int64) int64 {
+	return arch.AddInt64Relaxed(&a.v, delta) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64) FetchSubRelaxed(delta int64) int64 {
+	return arch.AddInt64Relaxed(&a.v, -delta) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64) FetchIncRelaxed() int64 {
+	return arch.AddInt64Relaxed(&a.v, 1) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64) FetchDecRelaxed() int64 {
+	return arch.AddInt64Relaxed(&a.v, -1) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) FetchAddAcquire(delta int64) int64 {
+	return arch.AddInt64Acquire(&a.v, delta) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) FetchSubAcquire(delta int64) int64 {
+	return arch.AddInt64Acquire(&a.v, -delta) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) FetchIncAcquire() int64 {
+	return arch.AddInt64Acquire(&a.v, 1) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) FetchDecAcquire() int64 {
+	return arch.AddInt64Acquire(&a.v, -1) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchAddRelease(delta int64) int64 {
+	return arch.AddInt64Release(&a.v, delta) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchSubRelease(delta int64) int64 {
+	return arch.AddInt64Release(&a.v, -delta) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchIncRelease() int64 {
+	return arch.AddInt64Release(&a.v, 1) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchDecRelease() int64 {
+	return arch.AddInt64Release(&a.v, -1) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchAddAcqRel(delta int64) int64 {
+	return arch.AddInt64AcqRel(&a.v, delta) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchSubAcqRel(delta int64) int64 {
+	return arch.AddInt64AcqRel(&a.v, -delta) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchIncAcqRel() int64 {
+	return arch.AddInt64AcqRel(&a.v, 1) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) FetchDecAcqRel() int64 {
+	return arch.AddInt64AcqRel(&a.v, -1) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) MaxAcquire(val int64) int64 {
+	for {
+		old := arch.LoadInt64Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasInt64Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Int64) MinAcquire(val int64) int64 {
+	for {
+		old := arch.LoadInt64Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasInt64Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Int64) MaxRelease(val int64) int64 {
+	for {
+		old := arch.LoadInt64Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasInt64Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Int64) MinRelease(val int64) int64 {
+	for {
+		old := arch.LoadInt64Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasInt64Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) MaxAcqRel(val int64) int64 {
+	for {
+		old := arch.LoadInt64Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasInt64AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64) MinAcqRel(val int64) int64 {
+	for {
+		old := arch.LoadInt64Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasInt64AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Uint64
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) Inc() uint64 {
+	return arch.AddUint64AcqRel(&a.v, 1)
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) Dec() uint64 {
+	return arch.AddUint64AcqRel(&a.v, ^uint64(0))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) IncRelaxed() uint64 {
+	return arch.AddUint64Relaxed(&a.v, 1)
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) DecRelaxed() uint64 {
+	return arch.AddUint64Relaxed(&a.v, ^uint64(0))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) IncAcquire() uint64 {
+	return arch.AddUint64Acquire(&a.v, 1)
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) DecAcquire() uint64 {
+	return arch.AddUint64Acquire(&a.v, ^uint64(0))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint64) IncRelease() uint64 {
+	return arch.AddUint64Release(&a.v, 1)
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint64) DecRelease() uint64 {
+	return arch.AddUint64Release(&a.v, ^uint64(0))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) IncAcqRel() uint64 {
+	return arch.AddUint64AcqRel(&a.v, 1)
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) DecAcqRel() uint64 {
+	return arch.AddUint64AcqRel(&a.v, ^uint64(0))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchAdd(delta uint64) uint64 {
+	return arch.AddUint64AcqRel(&a.v, delta) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchSub(delta uint64) uint64 {
+	return arch.AddUint64AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchInc() uint64 {
+	return arch.AddUint64AcqRel(&a.v, 1) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchDec() uint64 {
+	return arch.AddUint64AcqRel(&a.v, ^uint64(0)) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchAddRelaxed(delta uint64) uint64 {
+	return arch.AddUint64Relaxed(&a.v, delta) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchSubRelaxed(delta uint64) uint64 {
+	return arch.AddUint64Relaxed(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchIncRelaxed() uint64 {
+	return arch.AddUint64Relaxed(&a.v, 1) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchDecRelaxed() uint64 {
+	return arch.AddUint64Relaxed(&a.v, ^uint64(0)) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchAddAcquire(delta uint64) uint64 {
+	return arch.AddUint64Acquire(&a.v, delta) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchSubAcquire(delta uint64) uint64 {
+	return arch.AddUint64Acquire(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchIncAcquire() uint64 {
+	return arch.AddUint64Acquire(&a.v, 1) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchDecAcquire() uint64 {
+	return arch.AddUint64Acquire(&a.v, ^uint64(0)) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchAddRelease(delta uint64) uint64 {
+	return arch.AddUint64Release(&a.v, delta) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchSubRelease(delta uint64) uint64 {
+	return arch.AddUint64Release(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchIncRelease() uint64 {
+	return arch.AddUint64Release(&a.v, 1) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchDecRelease() uint64 {
+	return arch.AddUint64Release(&a.v, ^uint64(0)) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchAddAcqRel(delta uint64) uint64 {
+	return arch.AddUint64AcqRel(&a.v, delta) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchSubAcqRel(delta uint64) uint64 {
+	return arch.AddUint64AcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchIncAcqRel() uint64 {
+	return arch.AddUint64AcqRel(&a.v, 1) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) FetchDecAcqRel() uint64 {
+	return arch.AddUint64AcqRel(&a.v, ^uint64(0)) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) MaxAcquire(val uint64) uint64 {
+	for {
+		old := arch.LoadUint64Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint64Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) MinAcquire(val uint64) uint64 {
+	for {
+		old := arch.LoadUint64Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint64Acquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uint64) MaxRelease(val uint64) uint64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint64Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uint64) MinRelease(val uint64) uint64 {
+	for {
+		old := arch.LoadUint64Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint64Release(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) MaxAcqRel(val uint64) uint64 {
+	for {
+		old := arch.LoadUint64Acquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint64AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) MinAcqRel(val uint64) uint64 {
+	for {
+		old := arch.LoadUint64Acquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint64AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Uintptr
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) Inc() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, 1)
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) Dec() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, ^uintptr(0))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) IncRelaxed() uintptr {
+	return arch.AddUintptrRelaxed(&a.v, 1)
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) DecRelaxed() uintptr {
+	return arch.AddUintptrRelaxed(&a.v, ^uintptr(0))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) IncAcquire() uintptr {
+	return arch.AddUintptrAcquire(&a.v, 1)
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) DecAcquire() uintptr {
+	return arch.AddUintptrAcquire(&a.v, ^uintptr(0))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) IncRelease() uintptr {
+	return arch.AddUintptrRelease(&a.v, 1)
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) DecRelease() uintptr {
+	return arch.AddUintptrRelease(&a.v, ^uintptr(0))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) IncAcqRel() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, 1)
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) DecAcqRel() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, ^uintptr(0))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchAdd(delta uintptr) uintptr {
+	return arch.AddUintptrAcqRel(&a.v, delta) - delta
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchSub(delta uintptr) uintptr {
+	return arch.AddUintptrAcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchInc() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, 1) - 1
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchDec() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, ^uintptr(0)) + 1
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchAddRelaxed(delta uintptr) uintptr {
+	return arch.AddUintptrRelaxed(&a.v, delta) - delta
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchSubRelaxed(delta uintptr) uintptr {
+	return arch.AddUintptrRelaxed(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchIncRelaxed() uintptr {
+	return arch.AddUintptrRelaxed(&a.v, 1) - 1
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchDecRelaxed() uintptr {
+	return arch.AddUintptrRelaxed(&a.v, ^uintptr(0)) + 1
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchAddAcquire(delta uintptr) uintptr {
+	return arch.AddUintptrAcquire(&a.v, delta) - delta
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchSubAcquire(delta uintptr) uintptr {
+	return arch.AddUintptrAcquire(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchIncAcquire() uintptr {
+	return arch.AddUintptrAcquire(&a.v, 1) - 1
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchDecAcquire() uintptr {
+	return arch.AddUintptrAcquire(&a.v, ^uintptr(0)) + 1
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchAddRelease(delta uintptr) uintptr {
+	return arch.AddUintptrRelease(&a.v, delta) - delta
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchSubRelease(delta uintptr) uintptr {
+	return arch.AddUintptrRelease(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchIncRelease() uintptr {
+	return arch.AddUintptrRelease(&a.v, 1) - 1
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchDecRelease() uintptr {
+	return arch.AddUintptrRelease(&a.v, ^uintptr(0)) + 1
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchAddAcqRel(delta uintptr) uintptr {
+	return arch.AddUintptrAcqRel(&a.v, delta) - delta
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchSubAcqRel(delta uintptr) uintptr {
+	return arch.AddUintptrAcqRel(&a.v, ^(delta-1)) + delta
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchIncAcqRel() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, 1) - 1
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) FetchDecAcqRel() uintptr {
+	return arch.AddUintptrAcqRel(&a.v, ^uintptr(0)) + 1
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) MaxAcquire(val uintptr) uintptr {
+	for {
+		old := arch.LoadUintptrAcquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUintptrAcquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) MinAcquire(val uintptr) uintptr {
+	for {
+		old := arch.LoadUintptrAcquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUintptrAcquire(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) MaxRelease(val uintptr) uintptr {
+	for {
+		old := arch.LoadUintptrRelaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUintptrRelease(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) MinRelease(val uintptr) uintptr {
+	for {
+		old := arch.LoadUintptrRelaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUintptrRelease(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) MaxAcqRel(val uintptr) uintptr {
+	for {
+		old := arch.LoadUintptrAcquire(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUintptrAcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) MinAcqRel(val uintptr) uintptr {
+	for {
+		old := arch.LoadUintptrAcquire(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUintptrAcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// =============================================================================
+// Int32Of
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) Inc() T {
+	return T(AcqRel.AddInt32(&a.v, 1))
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) Dec() T {
+	return T(AcqRel.SubInt32(&a.v, 1))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchAdd(delta T) T {
+	return T(AcqRel.FetchAddInt32(&a.v, int32(delta)))
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchSub(delta T) T {
+	return T(AcqRel.FetchSubInt32(&a.v, int32(delta)))
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchInc() T {
+	return T(AcqRel.FetchIncInt32(&a.v))
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchDec() T {
+	return T(AcqRel.FetchDecInt32(&a.v))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncRelaxed() T {
+	return T(Relaxed.AddInt32(&a.v, 1))
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecRelaxed() T {
+	return T(Relaxed.SubInt32(&a.v, 1))
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchAddRelaxed(delta T) T {
+	return T(Relaxed.FetchAddInt32(&a.v, int32(delta)))
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchSubRelaxed(delta T) T {
+	return T(Relaxed.FetchSubInt32(&a.v, int32(delta)))
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchIncRelaxed() T {
+	return T(Relaxed.FetchIncInt32(&a.v))
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchDecRelaxed() T {
+	return T(Relaxed.FetchDecInt32(&a.v))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncAcquire() T {
+	return T(Acquire.AddInt32(&a.v, 1))
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecAcquire() T {
+	return T(Acquire.SubInt32(&a.v, 1))
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchAddAcquire(delta T) T {
+	return T(Acquire.FetchAddInt32(&a.v, int32(delta)))
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchSubAcquire(delta T) T {
+	return T(Acquire.FetchSubInt32(&a.v, int32(delta)))
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchIncAcquire() T {
+	return T(Acquire.FetchIncInt32(&a.v))
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchDecAcquire() T {
+	return T(Acquire.FetchDecInt32(&a.v))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncRelease() T {
+	return T(Release.AddInt32(&a.v, 1))
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecRelease() T {
+	return T(Release.SubInt32(&a.v, 1))
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchAddRelease(delta T) T {
+	return T(Release.FetchAddInt32(&a.v, int32(delta)))
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchSubRelease(delta T) T {
+	return T(Release.FetchSubInt32(&a.v, int32(delta)))
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchIncRelease() T {
+	return T(Release.FetchIncInt32(&a.v))
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchDecRelease() T {
+	return T(Release.FetchDecInt32(&a.v))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncAcqRel() T {
+	return T(AcqRel.AddInt32(&a.v, 1))
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecAcqRel() T {
+	return T(AcqRel.SubInt32(&a.v, 1))
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchAddAcqRel(delta T) T {
+	return T(AcqRel.FetchAddInt32(&a.v, int32(delta)))
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchSubAcqRel(delta T) T {
+	return T(AcqRel.FetchSubInt32(&a.v, int32(delta)))
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchIncAcqRel() T {
+	return T(AcqRel.FetchIncInt32(&a.v))
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchDecAcqRel() T {
+	return T(AcqRel.FetchDecInt32(&a.v))
+}
+
+// =============================================================================
+// Uint32Of
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Inc() T {
+	return T(AcqRel.AddUint32(&a.v, 1))
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Dec() T {
+	return T(AcqRel.SubUint32(&a.v, 1))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchAdd(delta T) T {
+	return T(AcqRel.FetchAddUint32(&a.v, uint32(delta)))
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchSub(delta T) T {
+	return T(AcqRel.FetchSubUint32(&a.v, uint32(delta)))
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchInc() T {
+	return T(AcqRel.FetchIncUint32(&a.v))
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchDec() T {
+	return T(AcqRel.FetchDecUint32(&a.v))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncRelaxed() T {
+	return T(Relaxed.AddUint32(&a.v, 1))
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecRelaxed() T {
+	return T(Relaxed.SubUint32(&a.v, 1))
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchAddRelaxed(delta T) T {
+	return T(Relaxed.FetchAddUint32(&a.v, uint32(delta)))
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchSubRelaxed(delta T) T {
+	return T(Relaxed.FetchSubUint32(&a.v, uint32(delta)))
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchIncRelaxed() T {
+	return T(Relaxed.FetchIncUint32(&a.v))
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchDecRelaxed() T {
+	return T(Relaxed.FetchDecUint32(&a.v))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncAcquire() T {
+	return T(Acquire.AddUint32(&a.v, 1))
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecAcquire() T {
+	return T(Acquire.SubUint32(&a.v, 1))
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchAddAcquire(delta T) T {
+	return T(Acquire.FetchAddUint32(&a.v, uint32(delta)))
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchSubAcquire(delta T) T {
+	return T(Acquire.FetchSubUint32(&a.v, uint32(delta)))
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchIncAcquire() T {
+	return T(Acquire.FetchIncUint32(&a.v))
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchDecAcquire() T {
+	return T(Acquire.FetchDecUint32(&a.v))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncRelease() T {
+	return T(Release.AddUint32(&a.v, 1))
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecRelease() T {
+	return T(Release.SubUint32(&a.v, 1))
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchAddRelease(delta T) T {
+	return T(Release.FetchAddUint32(&a.v, uint32(delta)))
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchSubRelease(delta T) T {
+	return T(Release.FetchSubUint32(&a.v, uint32(delta)))
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchIncRelease() T {
+	return T(Release.FetchIncUint32(&a.v))
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchDecRelease() T {
+	return T(Release.FetchDecUint32(&a.v))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncAcqRel() T {
+	return T(AcqRel.AddUint32(&a.v, 1))
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecAcqRel() T {
+	return T(AcqRel.SubUint32(&a.v, 1))
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchAddAcqRel(delta T) T {
+	return T(AcqRel.FetchAddUint32(&a.v, uint32(delta)))
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchSubAcqRel(delta T) T {
+	return T(AcqRel.FetchSubUint32(&a.v, uint32(delta)))
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchIncAcqRel() T {
+	return T(AcqRel.FetchIncUint32(&a.v))
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchDecAcqRel() T {
+	return T(AcqRel.FetchDecUint32(&a.v))
+}
+
+// =============================================================================
+// Int64Of
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Inc() T {
+	return T(AcqRel.AddInt64(&a.v, 1))
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) Dec() T {
+	return T(AcqRel.SubInt64(&a.v, 1))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchAdd(delta T) T {
+	return T(AcqRel.FetchAddInt64(&a.v, int64(delta)))
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchSub(delta T) T {
+	return T(AcqRel.FetchSubInt64(&a.v, int64(delta)))
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchInc() T {
+	return T(AcqRel.FetchIncInt64(&a.v))
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchDec() T {
+	return T(AcqRel.FetchDecInt64(&a.v))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncRelaxed() T {
+	return T(Relaxed.AddInt64(&a.v, 1))
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecRelaxed() T {
+	return T(Relaxed.SubInt64(&a.v, 1))
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchAddRelaxed(delta T) T {
+	return T(Relaxed.FetchAddInt64(&a.v, int64(delta)))
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchSubRelaxed(delta T) T {
+	return T(Relaxed.FetchSubInt64(&a.v, int64(delta)))
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchIncRelaxed() T {
+	return T(Relaxed.FetchIncInt64(&a.v))
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchDecRelaxed() T {
+	return T(Relaxed.FetchDecInt64(&a.v))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncAcquire() T {
+	return T(Acquire.AddInt64(&a.v, 1))
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecAcquire() T {
+	return T(Acquire.SubInt64(&a.v, 1))
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchAddAcquire(delta T) T {
+	return T(Acquire.FetchAddInt64(&a.v, int64(delta)))
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchSubAcquire(delta T) T {
+	return T(Acquire.FetchSubInt64(&a.v, int64(delta)))
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchIncAcquire() T {
+	return T(Acquire.FetchIncInt64(&a.v))
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchDecAcquire() T {
+	return T(Acquire.FetchDecInt64(&a.v))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncRelease() T {
+	return T(Release.AddInt64(&a.v, 1))
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecRelease() T {
+	return T(Release.SubInt64(&a.v, 1))
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchAddRelease(delta T) T {
+	return T(Release.FetchAddInt64(&a.v, int64(delta)))
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchSubRelease(delta T) T {
+	return T(Release.FetchSubInt64(&a.v, int64(delta)))
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchIncRelease() T {
+	return T(Release.FetchIncInt64(&a.v))
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchDecRelease() T {
+	return T(Release.FetchDecInt64(&a.v))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncAcqRel() T {
+	return T(AcqRel.AddInt64(&a.v, 1))
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecAcqRel() T {
+	return T(AcqRel.SubInt64(&a.v, 1))
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchAddAcqRel(delta T) T {
+	return T(AcqRel.FetchAddInt64(&a.v, int64(delta)))
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchSubAcqRel(delta T) T {
+	return T(AcqRel.FetchSubInt64(&a.v, int64(delta)))
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchIncAcqRel() T {
+	return T(AcqRel.FetchIncInt64(&a.v))
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchDecAcqRel() T {
+	return T(AcqRel.FetchDecInt64(&a.v))
+}
+
+// =============================================================================
+// Uint64Of
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Inc() T {
+	return T(AcqRel.AddUint64(&a.v, 1))
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Dec() T {
+	return T(AcqRel.SubUint64(&a.v, 1))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchAdd(delta T) T {
+	return T(AcqRel.FetchAddUint64(&a.v, uint64(delta)))
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchSub(delta T) T {
+	return T(AcqRel.FetchSubUint64(&a.v, uint64(delta)))
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchInc() T {
+	return T(AcqRel.FetchIncUint64(&a.v))
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchDec() T {
+	return T(AcqRel.FetchDecUint64(&a.v))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncRelaxed() T {
+	return T(Relaxed.AddUint64(&a.v, 1))
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecRelaxed() T {
+	return T(Relaxed.SubUint64(&a.v, 1))
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchAddRelaxed(delta T) T {
+	return T(Relaxed.FetchAddUint64(&a.v, uint64(delta)))
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchSubRelaxed(delta T) T {
+	return T(Relaxed.FetchSubUint64(&a.v, uint64(delta)))
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchIncRelaxed() T {
+	return T(Relaxed.FetchIncUint64(&a.v))
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchDecRelaxed() T {
+	return T(Relaxed.FetchDecUint64(&a.v))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncAcquire() T {
+	return T(Acquire.AddUint64(&a.v, 1))
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecAcquire() T {
+	return T(Acquire.SubUint64(&a.v, 1))
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchAddAcquire(delta T) T {
+	return T(Acquire.FetchAddUint64(&a.v, uint64(delta)))
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchSubAcquire(delta T) T {
+	return T(Acquire.FetchSubUint64(&a.v, uint64(delta)))
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchIncAcquire() T {
+	return T(Acquire.FetchIncUint64(&a.v))
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchDecAcquire() T {
+	return T(Acquire.FetchDecUint64(&a.v))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncRelease() T {
+	return T(Release.AddUint64(&a.v, 1))
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecRelease() T {
+	return T(Release.SubUint64(&a.v, 1))
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchAddRelease(delta T) T {
+	return T(Release.FetchAddUint64(&a.v, uint64(delta)))
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchSubRelease(delta T) T {
+	return T(Release.FetchSubUint64(&a.v, uint64(delta)))
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchIncRelease() T {
+	return T(Release.FetchIncUint64(&a.v))
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchDecRelease() T {
+	return T(Release.FetchDecUint64(&a.v))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncAcqRel() T {
+	return T(AcqRel.AddUint64(&a.v, 1))
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecAcqRel() T {
+	return T(AcqRel.SubUint64(&a.v, 1))
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchAddAcqRel(delta T) T {
+	return T(AcqRel.FetchAddUint64(&a.v, uint64(delta)))
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchSubAcqRel(delta T) T {
+	return T(AcqRel.FetchSubUint64(&a.v, uint64(delta)))
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchIncAcqRel() T {
+	return T(AcqRel.FetchIncUint64(&a.v))
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchDecAcqRel() T {
+	return T(AcqRel.FetchDecUint64(&a.v))
+}
+
+// =============================================================================
+// UintptrOf
+// =============================================================================
+
+// Inc atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Inc() T {
+	return T(AcqRel.AddUintptr(&a.v, 1))
+}
+
+// Dec atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Dec() T {
+	return T(AcqRel.SubUintptr(&a.v, 1))
+}
+
+// FetchAdd atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchAdd(delta T) T {
+	return T(AcqRel.FetchAddUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchSub atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchSub(delta T) T {
+	return T(AcqRel.FetchSubUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchInc atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchInc() T {
+	return T(AcqRel.FetchIncUintptr(&a.v))
+}
+
+// FetchDec atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchDec() T {
+	return T(AcqRel.FetchDecUintptr(&a.v))
+}
+
+// IncRelaxed atomically adds 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncRelaxed() T {
+	return T(Relaxed.AddUintptr(&a.v, 1))
+}
+
+// DecRelaxed atomically subtracts 1 and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecRelaxed() T {
+	return T(Relaxed.SubUintptr(&a.v, 1))
+}
+
+// FetchAddRelaxed atomically adds delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchAddRelaxed(delta T) T {
+	return T(Relaxed.FetchAddUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchSubRelaxed atomically subtracts delta and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchSubRelaxed(delta T) T {
+	return T(Relaxed.FetchSubUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchIncRelaxed atomically adds 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchIncRelaxed() T {
+	return T(Relaxed.FetchIncUintptr(&a.v))
+}
+
+// FetchDecRelaxed atomically subtracts 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchDecRelaxed() T {
+	return T(Relaxed.FetchDecUintptr(&a.v))
+}
+
+// IncAcquire atomically adds 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncAcquire() T {
+	return T(Acquire.AddUintptr(&a.v, 1))
+}
+
+// DecAcquire atomically subtracts 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecAcquire() T {
+	return T(Acquire.SubUintptr(&a.v, 1))
+}
+
+// FetchAddAcquire atomically adds delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchAddAcquire(delta T) T {
+	return T(Acquire.FetchAddUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchSubAcquire atomically subtracts delta and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchSubAcquire(delta T) T {
+	return T(Acquire.FetchSubUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchIncAcquire atomically adds 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchIncAcquire() T {
+	return T(Acquire.FetchIncUintptr(&a.v))
+}
+
+// FetchDecAcquire atomically subtracts 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchDecAcquire() T {
+	return T(Acquire.FetchDecUintptr(&a.v))
+}
+
+// IncRelease atomically adds 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncRelease() T {
+	return T(Release.AddUintptr(&a.v, 1))
+}
+
+// DecRelease atomically subtracts 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecRelease() T {
+	return T(Release.SubUintptr(&a.v, 1))
+}
+
+// FetchAddRelease atomically adds delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchAddRelease(delta T) T {
+	return T(Release.FetchAddUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchSubRelease atomically subtracts delta and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchSubRelease(delta T) T {
+	return T(Release.FetchSubUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchIncRelease atomically adds 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchIncRelease() T {
+	return T(Release.FetchIncUintptr(&a.v))
+}
+
+// FetchDecRelease atomically subtracts 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchDecRelease() T {
+	return T(Release.FetchDecUintptr(&a.v))
+}
+
+// IncAcqRel atomically adds 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncAcqRel() T {
+	return T(AcqRel.AddUintptr(&a.v, 1))
+}
+
+// DecAcqRel atomically subtracts 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecAcqRel() T {
+	return T(AcqRel.SubUintptr(&a.v, 1))
+}
+
+// FetchAddAcqRel atomically adds delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchAddAcqRel(delta T) T {
+	return T(AcqRel.FetchAddUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchSubAcqRel atomically subtracts delta and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchSubAcqRel(delta T) T {
+	return T(AcqRel.FetchSubUintptr(&a.v, uintptr(delta)))
+}
+
+// FetchIncAcqRel atomically adds 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchIncAcqRel() T {
+	return T(AcqRel.FetchIncUintptr(&a.v))
+}
+
+// FetchDecAcqRel atomically subtracts 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchDecAcqRel() T {
+	return T(AcqRel.FetchDecUintptr(&a.v))
+}
+
+// =============================================================================
+// Uint128
+// =============================================================================
+
+// AddAcquire atomically adds (deltaLo, deltaHi) and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) AddAcquire(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo = oldLo + deltaLo
+		newHi = oldHi + deltaHi
+		if newLo < oldLo {
+			newHi++
+		}
+		if arch.CasUint128Acquire(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return newLo, newHi
+		}
+	}
+}
+
+// SubAcquire atomically subtracts (deltaLo, deltaHi) and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) SubAcquire(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo = oldLo - deltaLo
+		newHi = oldHi - deltaHi
+		if newLo > oldLo {
+			newHi--
+		}
+		if arch.CasUint128Acquire(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return newLo, newHi
+		}
+	}
+}
+
+// IncAcquire atomically increments by 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) IncAcquire() (newLo, newHi uint64) {
+	return a.AddAcquire(1, 0)
+}
+
+// DecAcquire atomically decrements by 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) DecAcquire() (newLo, newHi uint64) {
+	return a.SubAcquire(1, 0)
+}
+
+// AddRelease atomically adds (deltaLo, deltaHi) and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) AddRelease(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo = oldLo + deltaLo
+		newHi = oldHi + deltaHi
+		if newLo < oldLo {
+			newHi++
+		}
+		if arch.CasUint128Release(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return newLo, newHi
+		}
+	}
+}
+
+// SubRelease atomically subtracts (deltaLo, deltaHi) and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) SubRelease(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo = oldLo - deltaLo
+		newHi = oldHi - deltaHi
+		if newLo > oldLo {
+			newHi--
+		}
+		if arch.CasUint128Release(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return newLo, newHi
+		}
+	}
+}
+
+// IncRelease atomically increments by 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) IncRelease() (newLo, newHi uint64) {
+	return a.AddRelease(1, 0)
+}
+
+// DecRelease atomically decrements by 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) DecRelease() (newLo, newHi uint64) {
+	return a.SubRelease(1, 0)
+}
+
+// AddAcqRel atomically adds (deltaLo, deltaHi) and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) AddAcqRel(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo = oldLo + deltaLo
+		newHi = oldHi + deltaHi
+		if newLo < oldLo {
+			newHi++
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return newLo, newHi
+		}
+	}
+}
+
+// SubAcqRel atomically subtracts (deltaLo, deltaHi) and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) SubAcqRel(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo = oldLo - deltaLo
+		newHi = oldHi - deltaHi
+		if newLo > oldLo {
+			newHi--
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return newLo, newHi
+		}
+	}
+}
+
+// IncAcqRel atomically increments by 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) IncAcqRel() (newLo, newHi uint64) {
+	return a.AddAcqRel(1, 0)
+}
+
+// DecAcqRel atomically decrements by 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) DecAcqRel() (newLo, newHi uint64) {
+	return a.SubAcqRel(1, 0)
+}
+
+// FetchAdd atomically adds (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchAdd(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + deltaLo
+		newHi := hi + deltaHi
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchSub atomically subtracts (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchSub(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - deltaLo
+		newHi := hi - deltaHi
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchInc atomically increments by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchInc() (oldLo, oldHi uint64) {
+	return a.FetchAdd(1, 0)
+}
+
+// FetchDec atomically decrements by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchDec() (oldLo, oldHi uint64) {
+	return a.FetchSub(1, 0)
+}
+
+// FetchAddRelaxed atomically adds (deltaLo, deltaHi) and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchAddRelaxed(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + deltaLo
+		newHi := hi + deltaHi
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128Relaxed(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchSubRelaxed atomically subtracts (deltaLo, deltaHi) and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchSubRelaxed(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - deltaLo
+		newHi := hi - deltaHi
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128Relaxed(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchIncRelaxed atomically increments by 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchIncRelaxed() (oldLo, oldHi uint64) {
+	return a.FetchAddRelaxed(1, 0)
+}
+
+// FetchDecRelaxed atomically decrements by 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchDecRelaxed() (oldLo, oldHi uint64) {
+	return a.FetchSubRelaxed(1, 0)
+}
+
+// FetchAddAcquire atomically adds (deltaLo, deltaHi) and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchAddAcquire(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + deltaLo
+		newHi := hi + deltaHi
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128Acquire(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchSubAcquire atomically subtracts (deltaLo, deltaHi) and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchSubAcquire(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - deltaLo
+		newHi := hi - deltaHi
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128Acquire(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchIncAcquire atomically increments by 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchIncAcquire() (oldLo, oldHi uint64) {
+	return a.FetchAddAcquire(1, 0)
+}
+
+// FetchDecAcquire atomically decrements by 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchDecAcquire() (oldLo, oldHi uint64) {
+	return a.FetchSubAcquire(1, 0)
+}
+
+// FetchAddRelease atomically adds (deltaLo, deltaHi) and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchAddRelease(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + deltaLo
+		newHi := hi + deltaHi
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128Release(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchSubRelease atomically subtracts (deltaLo, deltaHi) and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchSubRelease(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - deltaLo
+		newHi := hi - deltaHi
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128Release(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchIncRelease atomically increments by 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchIncRelease() (oldLo, oldHi uint64) {
+	return a.FetchAddRelease(1, 0)
+}
+
+// FetchDecRelease atomically decrements by 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchDecRelease() (oldLo, oldHi uint64) {
+	return a.FetchSubRelease(1, 0)
+}
+
+// FetchAddAcqRel atomically adds (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchAddAcqRel(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + deltaLo
+		newHi := hi + deltaHi
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchSubAcqRel atomically subtracts (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchSubAcqRel(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - deltaLo
+		newHi := hi - deltaHi
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return lo, hi
+		}
+	}
+}
+
+// FetchIncAcqRel atomically increments by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchIncAcqRel() (oldLo, oldHi uint64) {
+	return a.FetchAddAcqRel(1, 0)
+}
+
+// FetchDecAcqRel atomically decrements by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) FetchDecAcqRel() (oldLo, oldHi uint64) {
+	return a.FetchSubAcqRel(1, 0)
+}
+
+// =============================================================================
+// Int128
+// =============================================================================
+
+// AddAcquire atomically adds (deltaLo, deltaHi) and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) AddAcquire(deltaLo, deltaHi int64) (lo, hi int64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := oldLo + uint64(deltaLo)
+		newHi := oldHi + uint64(deltaHi)
+		if newLo < oldLo {
+			newHi++
+		}
+		if arch.CasUint128Acquire(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return int64(newLo), int64(newHi)
+		}
+	}
+}
+
+// SubAcquire atomically subtracts (deltaLo, deltaHi) and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) SubAcquire(deltaLo, deltaHi int64) (lo, hi int64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := oldLo - uint64(deltaLo)
+		newHi := oldHi - uint64(deltaHi)
+		if newLo > oldLo {
+			newHi--
+		}
+		if arch.CasUint128Acquire(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return int64(newLo), int64(newHi)
+		}
+	}
+}
+
+// IncAcquire atomically increments by 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) IncAcquire() (lo, hi int64) {
+	return a.AddAcquire(1, 0)
+}
+
+// DecAcquire atomically decrements by 1 and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) DecAcquire() (lo, hi int64) {
+	return a.SubAcquire(1, 0)
+}
+
+// AddRelease atomically adds (deltaLo, deltaHi) and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int128) AddRelease(deltaLo, deltaHi int64) (lo, hi int64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := oldLo + uint64(deltaLo)
+		newHi := oldHi + uint64(deltaHi)
+		if newLo < oldLo {
+			newHi++
+		}
+		if arch.CasUint128Release(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return int64(newLo), int64(newHi)
+		}
+	}
+}
+
+// SubRelease atomically subtracts (deltaLo, deltaHi) and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int128) SubRelease(deltaLo, deltaHi int64) (lo, hi int64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := oldLo - uint64(deltaLo)
+		newHi := oldHi - uint64(deltaHi)
+		if newLo > oldLo {
+			newHi--
+		}
+		if arch.CasUint128Release(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return int64(newLo), int64(newHi)
+		}
+	}
+}
+
+// IncRelease atomically increments by 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int128) IncRelease() (lo, hi int64) {
+	return a.AddRelease(1, 0)
+}
+
+// DecRelease atomically decrements by 1 and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Int128) DecRelease() (lo, hi int64) {
+	return a.SubRelease(1, 0)
+}
+
+// AddAcqRel atomically adds (deltaLo, deltaHi) and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) AddAcqRel(deltaLo, deltaHi int64) (lo, hi int64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := oldLo + uint64(deltaLo)
+		newHi := oldHi + uint64(deltaHi)
+		if newLo < oldLo {
+			newHi++
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return int64(newLo), int64(newHi)
+		}
+	}
+}
+
+// SubAcqRel atomically subtracts (deltaLo, deltaHi) and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) SubAcqRel(deltaLo, deltaHi int64) (lo, hi int64) {
+	for {
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := oldLo - uint64(deltaLo)
+		newHi := oldHi - uint64(deltaHi)
+		if newLo > oldLo {
+			newHi--
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi) {
+			return int64(newLo), int64(newHi)
+		}
+	}
+}
+
+// IncAcqRel atomically increments by 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) IncAcqRel() (lo, hi int64) {
+	return a.AddAcqRel(1, 0)
+}
+
+// DecAcqRel atomically decrements by 1 and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) DecAcqRel() (lo, hi int64) {
+	return a.SubAcqRel(1, 0)
+}
+
+// FetchAdd atomically adds (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchAdd(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + uint64(deltaLo)
+		newHi := hi + uint64(deltaHi)
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchSub atomically subtracts (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchSub(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - uint64(deltaLo)
+		newHi := hi - uint64(deltaHi)
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchInc atomically increments by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchInc() (oldLo, oldHi int64) {
+	return a.FetchAdd(1, 0)
+}
+
+// FetchDec atomically decrements by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchDec() (oldLo, oldHi int64) {
+	return a.FetchSub(1, 0)
+}
+
+// FetchAddRelaxed atomically adds (deltaLo, deltaHi) and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) FetchAddRelaxed(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + uint64(deltaLo)
+		newHi := hi + uint64(deltaHi)
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128Relaxed(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchSubRelaxed atomically subtracts (deltaLo, deltaHi) and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) FetchSubRelaxed(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - uint64(deltaLo)
+		newHi := hi - uint64(deltaHi)
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128Relaxed(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchIncRelaxed atomically increments by 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) FetchIncRelaxed() (oldLo, oldHi int64) {
+	return a.FetchAddRelaxed(1, 0)
+}
+
+// FetchDecRelaxed atomically decrements by 1 and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Int128) FetchDecRelaxed() (oldLo, oldHi int64) {
+	return a.FetchSubRelaxed(1, 0)
+}
+
+// FetchAddAcquire atomically adds (deltaLo, deltaHi) and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) FetchAddAcquire(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + uint64(deltaLo)
+		newHi := hi + uint64(deltaHi)
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128Acquire(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchSubAcquire atomically subtracts (deltaLo, deltaHi) and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) FetchSubAcquire(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - uint64(deltaLo)
+		newHi := hi - uint64(deltaHi)
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128Acquire(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchIncAcquire atomically increments by 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) FetchIncAcquire() (oldLo, oldHi int64) {
+	return a.FetchAddAcquire(1, 0)
+}
+
+// FetchDecAcquire atomically decrements by 1 and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Int128) FetchDecAcquire() (oldLo, oldHi int64) {
+	return a.FetchSubAcquire(1, 0)
+}
+
+// FetchAddRelease atomically adds (deltaLo, deltaHi) and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchAddRelease(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + uint64(deltaLo)
+		newHi := hi + uint64(deltaHi)
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128Release(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchSubRelease atomically subtracts (deltaLo, deltaHi) and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchSubRelease(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - uint64(deltaLo)
+		newHi := hi - uint64(deltaHi)
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128Release(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchIncRelease atomically increments by 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchIncRelease() (oldLo, oldHi int64) {
+	return a.FetchAddRelease(1, 0)
+}
+
+// FetchDecRelease atomically decrements by 1 and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchDecRelease() (oldLo, oldHi int64) {
+	return a.FetchSubRelease(1, 0)
+}
+
+// FetchAddAcqRel atomically adds (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchAddAcqRel(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo + uint64(deltaLo)
+		newHi := hi + uint64(deltaHi)
+		if newLo < lo {
+			newHi++
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchSubAcqRel atomically subtracts (deltaLo, deltaHi) and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchSubAcqRel(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	for {
+		lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+		newLo := lo - uint64(deltaLo)
+		newHi := hi - uint64(deltaHi)
+		if newLo > lo {
+			newHi--
+		}
+		if arch.CasUint128AcqRel(a.v.slot(), lo, hi, newLo, newHi) {
+			return int64(lo), int64(hi)
+		}
+	}
+}
+
+// FetchIncAcqRel atomically increments by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchIncAcqRel() (oldLo, oldHi int64) {
+	return a.FetchAddAcqRel(1, 0)
+}
+
+// FetchDecAcqRel atomically decrements by 1 and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Int128) FetchDecAcqRel() (oldLo, oldHi int64) {
+	return a.FetchSubAcqRel(1, 0)
+}
+
+// =============================================================================
+// MemoryOrder Int8
+// =============================================================================
+
+// SubInt8 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubInt8(addr *int8, delta int8) (new int8) {
+	return o.AddInt8(addr, -delta)
+}
+
+// IncInt8 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncInt8(addr *int8) (new int8) {
+	return o.AddInt8(addr, 1)
+}
+
+// DecInt8 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecInt8(addr *int8) (new int8) {
+	return o.SubInt8(addr, 1)
+}
+
+// FetchAddInt8 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddInt8(addr *int8, delta int8) (old int8) {
+	return o.AddInt8(addr, delta) - delta
+}
+
+// FetchSubInt8 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubInt8(addr *int8, delta int8) (old int8) {
+	return o.SubInt8(addr, delta) + delta
+}
+
+// FetchIncInt8 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncInt8(addr *int8) (old int8) {
+	return o.AddInt8(addr, 1) - 1
+}
+
+// FetchDecInt8 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecInt8(addr *int8) (old int8) {
+	return o.SubInt8(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Uint8
+// =============================================================================
+
+// SubUint8 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubUint8(addr *uint8, delta uint8) (new uint8) {
+	return o.AddUint8(addr, ^(delta - 1))
+}
+
+// IncUint8 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncUint8(addr *uint8) (new uint8) {
+	return o.AddUint8(addr, 1)
+}
+
+// DecUint8 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecUint8(addr *uint8) (new uint8) {
+	return o.SubUint8(addr, 1)
+}
+
+// FetchAddUint8 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddUint8(addr *uint8, delta uint8) (old uint8) {
+	return o.AddUint8(addr, delta) - delta
+}
+
+// FetchSubUint8 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubUint8(addr *uint8, delta uint8) (old uint8) {
+	return o.SubUint8(addr, delta) + delta
+}
+
+// FetchIncUint8 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncUint8(addr *uint8) (old uint8) {
+	return o.AddUint8(addr, 1) - 1
+}
+
+// FetchDecUint8 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecUint8(addr *uint8) (old uint8) {
+	return o.SubUint8(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Int16
+// =============================================================================
+
+// SubInt16 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubInt16(addr *int16, delta int16) (new int16) {
+	return o.AddInt16(addr, -delta)
+}
+
+// IncInt16 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncInt16(addr *int16) (new int16) {
+	return o.AddInt16(addr, 1)
+}
+
+// DecInt16 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecInt16(addr *int16) (new int16) {
+	return o.SubInt16(addr, 1)
+}
+
+// FetchAddInt16 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddInt16(addr *int16, delta int16) (old int16) {
+	return o.AddInt16(addr, delta) - delta
+}
+
+// FetchSubInt16 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubInt16(addr *int16, delta int16) (old int16) {
+	return o.SubInt16(addr, delta) + delta
+}
+
+// FetchIncInt16 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncInt16(addr *int16) (old int16) {
+	return o.AddInt16(addr, 1) - 1
+}
+
+// FetchDecInt16 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecInt16(addr *int16) (old int16) {
+	return o.SubInt16(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Uint16
+// =============================================================================
+
+// SubUint16 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubUint16(addr *uint16, delta uint16) (new uint16) {
+	return o.AddUint16(addr, ^(delta - 1))
+}
+
+// IncUint16 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncUint16(addr *uint16) (new uint16) {
+	return o.AddUint16(addr, 1)
+}
+
+// DecUint16 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecUint16(addr *uint16) (new uint16) {
+	return o.SubUint16(addr, 1)
+}
+
+// FetchAddUint16 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddUint16(addr *uint16, delta uint16) (old uint16) {
+	return o.AddUint16(addr, delta) - delta
+}
+
+// FetchSubUint16 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubUint16(addr *uint16, delta uint16) (old uint16) {
+	return o.SubUint16(addr, delta) + delta
+}
+
+// FetchIncUint16 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncUint16(addr *uint16) (old uint16) {
+	return o.AddUint16(addr, 1) - 1
+}
+
+// FetchDecUint16 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecUint16(addr *uint16) (old uint16) {
+	return o.SubUint16(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Int32
+// =============================================================================
+
+// SubInt32 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubInt32(addr *int32, delta int32) (new int32) {
+	return o.AddInt32(addr, -delta)
+}
+
+// IncInt32 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncInt32(addr *int32) (new int32) {
+	return o.AddInt32(addr, 1)
+}
+
+// DecInt32 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecInt32(addr *int32) (new int32) {
+	return o.SubInt32(addr, 1)
+}
+
+// FetchAddInt32 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddInt32(addr *int32, delta int32) (old int32) {
+	return o.AddInt32(addr, delta) - delta
+}
+
+// FetchSubInt32 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubInt32(addr *int32, delta int32) (old int32) {
+	return o.SubInt32(addr, delta) + delta
+}
+
+// FetchIncInt32 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncInt32(addr *int32) (old int32) {
+	return o.AddInt32(addr, 1) - 1
+}
+
+// FetchDecInt32 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecInt32(addr *int32) (old int32) {
+	return o.SubInt32(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Uint32
+// =============================================================================
+
+// SubUint32 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubUint32(addr *uint32, delta uint32) (new uint32) {
+	return o.AddUint32(addr, ^(delta - 1))
+}
+
+// IncUint32 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncUint32(addr *uint32) (new uint32) {
+	return o.AddUint32(addr, 1)
+}
+
+// DecUint32 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecUint32(addr *uint32) (new uint32) {
+	return o.SubUint32(addr, 1)
+}
+
+// FetchAddUint32 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddUint32(addr *uint32, delta uint32) (old uint32) {
+	return o.AddUint32(addr, delta) - delta
+}
+
+// FetchSubUint32 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubUint32(addr *uint32, delta uint32) (old uint32) {
+	return o.SubUint32(addr, delta) + delta
+}
+
+// FetchIncUint32 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncUint32(addr *uint32) (old uint32) {
+	return o.AddUint32(addr, 1) - 1
+}
+
+// FetchDecUint32 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecUint32(addr *uint32) (old uint32) {
+	return o.SubUint32(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Int64
+// =============================================================================
+
+// SubInt64 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubInt64(addr *int64, delta int64) (new int64) {
+	return o.AddInt64(addr, -delta)
+}
+
+// IncInt64 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncInt64(addr *int64) (new int64) {
+	return o.AddInt64(addr, 1)
+}
+
+// DecInt64 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecInt64(addr *int64) (new int64) {
+	return o.SubInt64(addr, 1)
+}
+
+// FetchAddInt64 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddInt64(addr *int64, delta int64) (old int64) {
+	return o.AddInt64(addr, delta) - delta
+}
+
+// FetchSubInt64 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubInt64(addr *int64, delta int64) (old int64) {
+	return o.SubInt64(addr, delta) + delta
+}
+
+// FetchIncInt64 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncInt64(addr *int64) (old int64) {
+	return o.AddInt64(addr, 1) - 1
+}
+
+// FetchDecInt64 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecInt64(addr *int64) (old int64) {
+	return o.SubInt64(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Uint64
+// =============================================================================
+
+// SubUint64 atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubUint64(addr *uint64, delta uint64) (new uint64) {
+	return o.AddUint64(addr, ^(delta - 1))
+}
+
+// IncUint64 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncUint64(addr *uint64) (new uint64) {
+	return o.AddUint64(addr, 1)
+}
+
+// DecUint64 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecUint64(addr *uint64) (new uint64) {
+	return o.SubUint64(addr, 1)
+}
+
+// FetchAddUint64 atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddUint64(addr *uint64, delta uint64) (old uint64) {
+	return o.AddUint64(addr, delta) - delta
+}
+
+// FetchSubUint64 atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubUint64(addr *uint64, delta uint64) (old uint64) {
+	return o.SubUint64(addr, delta) + delta
+}
+
+// FetchIncUint64 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncUint64(addr *uint64) (old uint64) {
+	return o.AddUint64(addr, 1) - 1
+}
+
+// FetchDecUint64 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecUint64(addr *uint64) (old uint64) {
+	return o.SubUint64(addr, 1) + 1
+}
+
+// =============================================================================
+// MemoryOrder Uintptr
+// =============================================================================
+
+// SubUintptr atomically subtracts delta from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubUintptr(addr *uintptr, delta uintptr) (new uintptr) {
+	return o.AddUintptr(addr, ^(delta - 1))
+}
+
+// IncUintptr atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncUintptr(addr *uintptr) (new uintptr) {
+	return o.AddUintptr(addr, 1)
+}
+
+// DecUintptr atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecUintptr(addr *uintptr) (new uintptr) {
+	return o.SubUintptr(addr, 1)
+}
+
+// FetchAddUintptr atomically adds delta to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddUintptr(addr *uintptr, delta uintptr) (old uintptr) {
+	return o.AddUintptr(addr, delta) - delta
+}
+
+// FetchSubUintptr atomically subtracts delta from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubUintptr(addr *uintptr, delta uintptr) (old uintptr) {
+	return o.SubUintptr(addr, delta) + delta
+}
+
+// FetchIncUintptr atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncUintptr(addr *uintptr) (old uintptr) {
+	return o.AddUintptr(addr, 1) - 1
+}
+
+// FetchDecUintptr atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecUintptr(addr *uintptr) (old uintptr) {
+	return o.SubUintptr(addr, 1) + 1
+}
+
+// =============================================================================
+// Uint32 single-bit operations
+// =============================================================================
+
+// BitSet atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitSet(bit uint) (wasSet bool) {
+	return arch.BitSetUint32AcqRel(&a.v, bit)
+}
+
+// BitSetRelaxed atomically sets bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) BitSetRelaxed(bit uint) (wasSet bool) {
+	return arch.BitSetUint32Relaxed(&a.v, bit)
+}
+
+// BitSetAcquire atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) BitSetAcquire(bit uint) (wasSet bool) {
+	return arch.BitSetUint32Acquire(&a.v, bit)
+}
+
+// BitSetRelease atomically sets bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitSetRelease(bit uint) (wasSet bool) {
+	return arch.BitSetUint32Release(&a.v, bit)
+}
+
+// BitSetAcqRel atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitSetAcqRel(bit uint) (wasSet bool) {
+	return arch.BitSetUint32AcqRel(&a.v, bit)
+}
+
+// BitClear atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitClear(bit uint) (wasSet bool) {
+	return arch.BitClearUint32AcqRel(&a.v, bit)
+}
+
+// BitClearRelaxed atomically clears bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) BitClearRelaxed(bit uint) (wasSet bool) {
+	return arch.BitClearUint32Relaxed(&a.v, bit)
+}
+
+// BitClearAcquire atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) BitClearAcquire(bit uint) (wasSet bool) {
+	return arch.BitClearUint32Acquire(&a.v, bit)
+}
+
+// BitClearRelease atomically clears bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitClearRelease(bit uint) (wasSet bool) {
+	return arch.BitClearUint32Release(&a.v, bit)
+}
+
+// BitClearAcqRel atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitClearAcqRel(bit uint) (wasSet bool) {
+	return arch.BitClearUint32AcqRel(&a.v, bit)
+}
+
+// BitToggle atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitToggle(bit uint) (wasSet bool) {
+	return arch.BitToggleUint32AcqRel(&a.v, bit)
+}
+
+// BitToggleRelaxed atomically flips bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32) BitToggleRelaxed(bit uint) (wasSet bool) {
+	return arch.BitToggleUint32Relaxed(&a.v, bit)
+}
+
+// BitToggleAcquire atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32) BitToggleAcquire(bit uint) (wasSet bool) {
+	return arch.BitToggleUint32Acquire(&a.v, bit)
+}
+
+// BitToggleRelease atomically flips bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitToggleRelease(bit uint) (wasSet bool) {
+	return arch.BitToggleUint32Release(&a.v, bit)
+}
+
+// BitToggleAcqRel atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32) BitToggleAcqRel(bit uint) (wasSet bool) {
+	return arch.BitToggleUint32AcqRel(&a.v, bit)
+}
+
+// BitSetUint32 atomically sets bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitSetUint32(addr *uint32, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitSetUint32Relaxed(addr, bit)
+	case Acquire:
+		return arch.BitSetUint32Acquire(addr, bit)
+	case Release:
+		return arch.BitSetUint32Release(addr, bit)
+	default:
+		return arch.BitSetUint32AcqRel(addr, bit)
+	}
+}
+
+// BitClearUint32 atomically clears bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitClearUint32(addr *uint32, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitClearUint32Relaxed(addr, bit)
+	case Acquire:
+		return arch.BitClearUint32Acquire(addr, bit)
+	case Release:
+		return arch.BitClearUint32Release(addr, bit)
+	default:
+		return arch.BitClearUint32AcqRel(addr, bit)
+	}
+}
+
+// BitToggleUint32 atomically flips bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitToggleUint32(addr *uint32, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitToggleUint32Relaxed(addr, bit)
+	case Acquire:
+		return arch.BitToggleUint32Acquire(addr, bit)
+	case Release:
+		return arch.BitToggleUint32Release(addr, bit)
+	default:
+		return arch.BitToggleUint32AcqRel(addr, bit)
+	}
+}
+
+// =============================================================================
+// Uint64 single-bit operations
+// =============================================================================
+
+// BitSet atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitSet(bit uint) (wasSet bool) {
+	return arch.BitSetUint64AcqRel(&a.v, bit)
+}
+
+// BitSetRelaxed atomically sets bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) BitSetRelaxed(bit uint) (wasSet bool) {
+	return arch.BitSetUint64Relaxed(&a.v, bit)
+}
+
+// BitSetAcquire atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) BitSetAcquire(bit uint) (wasSet bool) {
+	return arch.BitSetUint64Acquire(&a.v, bit)
+}
+
+// BitSetRelease atomically sets bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitSetRelease(bit uint) (wasSet bool) {
+	return arch.BitSetUint64Release(&a.v, bit)
+}
+
+// BitSetAcqRel atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitSetAcqRel(bit uint) (wasSet bool) {
+	return arch.BitSetUint64AcqRel(&a.v, bit)
+}
+
+// BitClear atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitClear(bit uint) (wasSet bool) {
+	return arch.BitClearUint64AcqRel(&a.v, bit)
+}
+
+// BitClearRelaxed atomically clears bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) BitClearRelaxed(bit uint) (wasSet bool) {
+	return arch.BitClearUint64Relaxed(&a.v, bit)
+}
+
+// BitClearAcquire atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) BitClearAcquire(bit uint) (wasSet bool) {
+	return arch.BitClearUint64Acquire(&a.v, bit)
+}
+
+// BitClearRelease atomically clears bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitClearRelease(bit uint) (wasSet bool) {
+	return arch.BitClearUint64Release(&a.v, bit)
+}
+
+// BitClearAcqRel atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitClearAcqRel(bit uint) (wasSet bool) {
+	return arch.BitClearUint64AcqRel(&a.v, bit)
+}
+
+// BitToggle atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitToggle(bit uint) (wasSet bool) {
+	return arch.BitToggleUint64AcqRel(&a.v, bit)
+}
+
+// BitToggleRelaxed atomically flips bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64) BitToggleRelaxed(bit uint) (wasSet bool) {
+	return arch.BitToggleUint64Relaxed(&a.v, bit)
+}
+
+// BitToggleAcquire atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64) BitToggleAcquire(bit uint) (wasSet bool) {
+	return arch.BitToggleUint64Acquire(&a.v, bit)
+}
+
+// BitToggleRelease atomically flips bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitToggleRelease(bit uint) (wasSet bool) {
+	return arch.BitToggleUint64Release(&a.v, bit)
+}
+
+// BitToggleAcqRel atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64) BitToggleAcqRel(bit uint) (wasSet bool) {
+	return arch.BitToggleUint64AcqRel(&a.v, bit)
+}
+
+// BitSetUint64 atomically sets bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitSetUint64(addr *uint64, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitSetUint64Relaxed(addr, bit)
+	case Acquire:
+		return arch.BitSetUint64Acquire(addr, bit)
+	case Release:
+		return arch.BitSetUint64Release(addr, bit)
+	default:
+		return arch.BitSetUint64AcqRel(addr, bit)
+	}
+}
+
+// BitClearUint64 atomically clears bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitClearUint64(addr *uint64, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitClearUint64Relaxed(addr, bit)
+	case Acquire:
+		return arch.BitClearUint64Acquire(addr, bit)
+	case Release:
+		return arch.BitClearUint64Release(addr, bit)
+	default:
+		return arch.BitClearUint64AcqRel(addr, bit)
+	}
+}
+
+// BitToggleUint64 atomically flips bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitToggleUint64(addr *uint64, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitToggleUint64Relaxed(addr, bit)
+	case Acquire:
+		return arch.BitToggleUint64Acquire(addr, bit)
+	case Release:
+		return arch.BitToggleUint64Release(addr, bit)
+	default:
+		return arch.BitToggleUint64AcqRel(addr, bit)
+	}
+}
+
+// =============================================================================
+// Uintptr single-bit operations
+// =============================================================================
+
+// BitSet atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitSet(bit uint) (wasSet bool) {
+	return arch.BitSetUintptrAcqRel(&a.v, bit)
+}
+
+// BitSetRelaxed atomically sets bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitSetRelaxed(bit uint) (wasSet bool) {
+	return arch.BitSetUintptrRelaxed(&a.v, bit)
+}
+
+// BitSetAcquire atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitSetAcquire(bit uint) (wasSet bool) {
+	return arch.BitSetUintptrAcquire(&a.v, bit)
+}
+
+// BitSetRelease atomically sets bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitSetRelease(bit uint) (wasSet bool) {
+	return arch.BitSetUintptrRelease(&a.v, bit)
+}
+
+// BitSetAcqRel atomically sets bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitSetAcqRel(bit uint) (wasSet bool) {
+	return arch.BitSetUintptrAcqRel(&a.v, bit)
+}
+
+// BitClear atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitClear(bit uint) (wasSet bool) {
+	return arch.BitClearUintptrAcqRel(&a.v, bit)
+}
+
+// BitClearRelaxed atomically clears bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitClearRelaxed(bit uint) (wasSet bool) {
+	return arch.BitClearUintptrRelaxed(&a.v, bit)
+}
+
+// BitClearAcquire atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitClearAcquire(bit uint) (wasSet bool) {
+	return arch.BitClearUintptrAcquire(&a.v, bit)
+}
+
+// BitClearRelease atomically clears bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitClearRelease(bit uint) (wasSet bool) {
+	return arch.BitClearUintptrRelease(&a.v, bit)
+}
+
+// BitClearAcqRel atomically clears bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitClearAcqRel(bit uint) (wasSet bool) {
+	return arch.BitClearUintptrAcqRel(&a.v, bit)
+}
+
+// BitToggle atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitToggle(bit uint) (wasSet bool) {
+	return arch.BitToggleUintptrAcqRel(&a.v, bit)
+}
+
+// BitToggleRelaxed atomically flips bit (modulo the width) and reports whether it was
+// set before, with relaxed ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitToggleRelaxed(bit uint) (wasSet bool) {
+	return arch.BitToggleUintptrRelaxed(&a.v, bit)
+}
+
+// BitToggleAcquire atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitToggleAcquire(bit uint) (wasSet bool) {
+	return arch.BitToggleUintptrAcquire(&a.v, bit)
+}
+
+// BitToggleRelease atomically flips bit (modulo the width) and reports whether it was
+// set before, with release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitToggleRelease(bit uint) (wasSet bool) {
+	return arch.BitToggleUintptrRelease(&a.v, bit)
+}
+
+// BitToggleAcqRel atomically flips bit (modulo the width) and reports whether it was
+// set before, with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uintptr) BitToggleAcqRel(bit uint) (wasSet bool) {
+	return arch.BitToggleUintptrAcqRel(&a.v, bit)
+}
+
+// BitSetUintptr atomically sets bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitSetUintptr(addr *uintptr, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitSetUintptrRelaxed(addr, bit)
+	case Acquire:
+		return arch.BitSetUintptrAcquire(addr, bit)
+	case Release:
+		return arch.BitSetUintptrRelease(addr, bit)
+	default:
+		return arch.BitSetUintptrAcqRel(addr, bit)
+	}
+}
+
+// BitClearUintptr atomically clears bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitClearUintptr(addr *uintptr, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitClearUintptrRelaxed(addr, bit)
+	case Acquire:
+		return arch.BitClearUintptrAcquire(addr, bit)
+	case Release:
+		return arch.BitClearUintptrRelease(addr, bit)
+	default:
+		return arch.BitClearUintptrAcqRel(addr, bit)
+	}
+}
+
+// BitToggleUintptr atomically flips bit (modulo the width) of *addr and reports whether
+// it was set before. Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) BitToggleUintptr(addr *uintptr, bit uint) (wasSet bool) {
+	switch o {
+	case Relaxed:
+		return arch.BitToggleUintptrRelaxed(addr, bit)
+	case Acquire:
+		return arch.BitToggleUintptrAcquire(addr, bit)
+	case Release:
+		return arch.BitToggleUintptrRelease(addr, bit)
+	default:
+		return arch.BitToggleUintptrAcqRel(addr, bit)
+	}
+}
+
+// =============================================================================
+// Int8 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) AddIfBelow(delta, limit int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) AddIfBelowRelaxed(delta, limit int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Relaxed(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) AddIfBelowAcquire(delta, limit int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Acquire(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) AddIfBelowRelease(delta, limit int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Release(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) AddIfBelowAcqRel(delta, limit int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) SubIfAtLeast(delta, floor int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) SubIfAtLeastRelaxed(delta, floor int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Relaxed(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) SubIfAtLeastAcquire(delta, floor int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Acquire(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) SubIfAtLeastRelease(delta, floor int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Release(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int8) SubIfAtLeastAcqRel(delta, floor int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int8) IncIfNonZero() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int8) IncIfNonZeroRelaxed() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Relaxed(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int8) IncIfNonZeroAcquire() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Acquire(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int8) IncIfNonZeroRelease() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Release(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int8) IncIfNonZeroAcqRel() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) DecIfPositive() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) DecIfPositiveRelaxed() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Relaxed(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) DecIfPositiveAcquire() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Acquire(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) DecIfPositiveRelease() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Release(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) DecIfPositiveAcqRel() (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) AddChecked(delta int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) AddCheckedRelaxed(delta int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Relaxed(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) AddCheckedAcquire(delta int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Acquire(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) AddCheckedRelease(delta int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8Release(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int8) AddCheckedAcqRel(delta int8) (old int8, ok bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int8) AddSaturating(delta int8) (old int8, exact bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int8) AddSaturatingRelaxed(delta int8) (old int8, exact bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int8(arch.CaxUint8Relaxed(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int8) AddSaturatingAcquire(delta int8) (old int8, exact bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int8(arch.CaxUint8Acquire(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int8) AddSaturatingRelease(delta int8) (old int8, exact bool) {
+	old = int8(arch.LoadUint8Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int8(arch.CaxUint8Release(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int8) AddSaturatingAcqRel(delta int8) (old int8, exact bool) {
+	old = int8(arch.LoadUint8Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int8(arch.CaxUint8AcqRel(&a.v, uint8(old), uint8(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uint8 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) AddIfBelow(delta, limit uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) AddIfBelowRelaxed(delta, limit uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) AddIfBelowAcquire(delta, limit uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) AddIfBelowRelease(delta, limit uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) AddIfBelowAcqRel(delta, limit uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) SubIfAtLeast(delta, floor uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) SubIfAtLeastRelaxed(delta, floor uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) SubIfAtLeastAcquire(delta, floor uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) SubIfAtLeastRelease(delta, floor uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint8) SubIfAtLeastAcqRel(delta, floor uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint8) IncIfNonZero() (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint8) IncIfNonZeroRelaxed() (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint8) IncIfNonZeroAcquire() (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint8) IncIfNonZeroRelease() (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint8) IncIfNonZeroAcqRel() (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) DecIfPositive() (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) DecIfPositiveRelaxed() (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) DecIfPositiveAcquire() (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) DecIfPositiveRelease() (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) DecIfPositiveAcqRel() (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) AddChecked(delta uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) AddCheckedRelaxed(delta uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) AddCheckedAcquire(delta uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) AddCheckedRelease(delta uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint8) AddCheckedAcqRel(delta uint8) (old uint8, ok bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint8) AddSaturating(delta uint8) (old uint8, exact bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint8) AddSaturatingRelaxed(delta uint8) (old uint8, exact bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint8Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint8) AddSaturatingAcquire(delta uint8) (old uint8, exact bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint8Acquire(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint8) AddSaturatingRelease(delta uint8) (old uint8, exact bool) {
+	old = arch.LoadUint8Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint8Release(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint8) AddSaturatingAcqRel(delta uint8) (old uint8, exact bool) {
+	old = arch.LoadUint8Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint8AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Int16 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) AddIfBelow(delta, limit int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) AddIfBelowRelaxed(delta, limit int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Relaxed(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) AddIfBelowAcquire(delta, limit int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Acquire(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) AddIfBelowRelease(delta, limit int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Release(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) AddIfBelowAcqRel(delta, limit int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) SubIfAtLeast(delta, floor int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) SubIfAtLeastRelaxed(delta, floor int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Relaxed(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) SubIfAtLeastAcquire(delta, floor int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Acquire(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) SubIfAtLeastRelease(delta, floor int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Release(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int16) SubIfAtLeastAcqRel(delta, floor int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int16) IncIfNonZero() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int16) IncIfNonZeroRelaxed() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Relaxed(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int16) IncIfNonZeroAcquire() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Acquire(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int16) IncIfNonZeroRelease() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Release(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int16) IncIfNonZeroAcqRel() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) DecIfPositive() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) DecIfPositiveRelaxed() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Relaxed(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) DecIfPositiveAcquire() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Acquire(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) DecIfPositiveRelease() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Release(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) DecIfPositiveAcqRel() (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) AddChecked(delta int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) AddCheckedRelaxed(delta int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Relaxed(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) AddCheckedAcquire(delta int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Acquire(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) AddCheckedRelease(delta int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16Release(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int16) AddCheckedAcqRel(delta int16) (old int16, ok bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int16) AddSaturating(delta int16) (old int16, exact bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int16) AddSaturatingRelaxed(delta int16) (old int16, exact bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int16(arch.CaxUint16Relaxed(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int16) AddSaturatingAcquire(delta int16) (old int16, exact bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int16(arch.CaxUint16Acquire(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int16) AddSaturatingRelease(delta int16) (old int16, exact bool) {
+	old = int16(arch.LoadUint16Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int16(arch.CaxUint16Release(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int16) AddSaturatingAcqRel(delta int16) (old int16, exact bool) {
+	old = int16(arch.LoadUint16Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := int16(arch.CaxUint16AcqRel(&a.v, uint16(old), uint16(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uint16 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) AddIfBelow(delta, limit uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) AddIfBelowRelaxed(delta, limit uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) AddIfBelowAcquire(delta, limit uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) AddIfBelowRelease(delta, limit uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) AddIfBelowAcqRel(delta, limit uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) SubIfAtLeast(delta, floor uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) SubIfAtLeastRelaxed(delta, floor uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) SubIfAtLeastAcquire(delta, floor uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) SubIfAtLeastRelease(delta, floor uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint16) SubIfAtLeastAcqRel(delta, floor uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint16) IncIfNonZero() (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint16) IncIfNonZeroRelaxed() (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint16) IncIfNonZeroAcquire() (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint16) IncIfNonZeroRelease() (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint16) IncIfNonZeroAcqRel() (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) DecIfPositive() (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) DecIfPositiveRelaxed() (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) DecIfPositiveAcquire() (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) DecIfPositiveRelease() (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) DecIfPositiveAcqRel() (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) AddChecked(delta uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) AddCheckedRelaxed(delta uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) AddCheckedAcquire(delta uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) AddCheckedRelease(delta uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint16) AddCheckedAcqRel(delta uint16) (old uint16, ok bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint16) AddSaturating(delta uint16) (old uint16, exact bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint16) AddSaturatingRelaxed(delta uint16) (old uint16, exact bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint16Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint16) AddSaturatingAcquire(delta uint16) (old uint16, exact bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint16Acquire(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint16) AddSaturatingRelease(delta uint16) (old uint16, exact bool) {
+	old = arch.LoadUint16Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint16Release(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint16) AddSaturatingAcqRel(delta uint16) (old uint16, exact bool) {
+	old = arch.LoadUint16Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint16AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Int32 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) AddIfBelow(delta, limit int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) AddIfBelowRelaxed(delta, limit int32) (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) AddIfBelowAcquire(delta, limit int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) AddIfBelowRelease(delta, limit int32) (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) AddIfBelowAcqRel(delta, limit int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) SubIfAtLeast(delta, floor int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) SubIfAtLeastRelaxed(delta, floor int32) (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) SubIfAtLeastAcquire(delta, floor int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) SubIfAtLeastRelease(delta, floor int32) (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32) SubIfAtLeastAcqRel(delta, floor int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32) IncIfNonZero() (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32) IncIfNonZeroRelaxed() (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32) IncIfNonZeroAcquire() (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32) IncIfNonZeroRelease() (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32) IncIfNonZeroAcqRel() (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) DecIfPositive() (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) DecIfPositiveRelaxed() (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) DecIfPositiveAcquire() (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) DecIfPositiveRelease() (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) DecIfPositiveAcqRel() (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) AddChecked(delta int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) AddCheckedRelaxed(delta int32) (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) AddCheckedAcquire(delta int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) AddCheckedRelease(delta int32) (old int32, ok bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32) AddCheckedAcqRel(delta int32) (old int32, ok bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32) AddSaturating(delta int32) (old int32, exact bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32) AddSaturatingRelaxed(delta int32) (old int32, exact bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32) AddSaturatingAcquire(delta int32) (old int32, exact bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32) AddSaturatingRelease(delta int32) (old int32, exact bool) {
+	old = arch.LoadInt32Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt32Release(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32) AddSaturatingAcqRel(delta int32) (old int32, exact bool) {
+	old = arch.LoadInt32Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uint32 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) AddIfBelow(delta, limit uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) AddIfBelowRelaxed(delta, limit uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) AddIfBelowAcquire(delta, limit uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) AddIfBelowRelease(delta, limit uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) AddIfBelowAcqRel(delta, limit uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) SubIfAtLeast(delta, floor uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) SubIfAtLeastRelaxed(delta, floor uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) SubIfAtLeastAcquire(delta, floor uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) SubIfAtLeastRelease(delta, floor uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32) SubIfAtLeastAcqRel(delta, floor uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32) IncIfNonZero() (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32) IncIfNonZeroRelaxed() (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32) IncIfNonZeroAcquire() (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32) IncIfNonZeroRelease() (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32) IncIfNonZeroAcqRel() (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) DecIfPositive() (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) DecIfPositiveRelaxed() (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) DecIfPositiveAcquire() (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) DecIfPositiveRelease() (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) DecIfPositiveAcqRel() (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) AddChecked(delta uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) AddCheckedRelaxed(delta uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) AddCheckedAcquire(delta uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) AddCheckedRelease(delta uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32) AddCheckedAcqRel(delta uint32) (old uint32, ok bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32) AddSaturating(delta uint32) (old uint32, exact bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32) AddSaturatingRelaxed(delta uint32) (old uint32, exact bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint32Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32) AddSaturatingAcquire(delta uint32) (old uint32, exact bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint32Acquire(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32) AddSaturatingRelease(delta uint32) (old uint32, exact bool) {
+	old = arch.LoadUint32Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint32Release(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32) AddSaturatingAcqRel(delta uint32) (old uint32, exact bool) {
+	old = arch.LoadUint32Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint32AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Int64 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) AddIfBelow(delta, limit int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) AddIfBelowRelaxed(delta, limit int64) (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) AddIfBelowAcquire(delta, limit int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) AddIfBelowRelease(delta, limit int64) (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) AddIfBelowAcqRel(delta, limit int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) SubIfAtLeast(delta, floor int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) SubIfAtLeastRelaxed(delta, floor int64) (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) SubIfAtLeastAcquire(delta, floor int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) SubIfAtLeastRelease(delta, floor int64) (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64) SubIfAtLeastAcqRel(delta, floor int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64) IncIfNonZero() (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64) IncIfNonZeroRelaxed() (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64) IncIfNonZeroAcquire() (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64) IncIfNonZeroRelease() (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64) IncIfNonZeroAcqRel() (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) DecIfPositive() (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) DecIfPositiveRelaxed() (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) DecIfPositiveAcquire() (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) DecIfPositiveRelease() (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) DecIfPositiveAcqRel() (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) AddChecked(delta int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) AddCheckedRelaxed(delta int64) (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) AddCheckedAcquire(delta int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) AddCheckedRelease(delta int64) (old int64, ok bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64) AddCheckedAcqRel(delta int64) (old int64, ok bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64) AddSaturating(delta int64) (old int64, exact bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64) AddSaturatingRelaxed(delta int64) (old int64, exact bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64) AddSaturatingAcquire(delta int64) (old int64, exact bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64) AddSaturatingRelease(delta int64) (old int64, exact bool) {
+	old = arch.LoadInt64Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt64Release(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64) AddSaturatingAcqRel(delta int64) (old int64, exact bool) {
+	old = arch.LoadInt64Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxInt64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uint64 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) AddIfBelow(delta, limit uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) AddIfBelowRelaxed(delta, limit uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) AddIfBelowAcquire(delta, limit uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) AddIfBelowRelease(delta, limit uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) AddIfBelowAcqRel(delta, limit uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) SubIfAtLeast(delta, floor uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) SubIfAtLeastRelaxed(delta, floor uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) SubIfAtLeastAcquire(delta, floor uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) SubIfAtLeastRelease(delta, floor uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64) SubIfAtLeastAcqRel(delta, floor uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64) IncIfNonZero() (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64) IncIfNonZeroRelaxed() (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64) IncIfNonZeroAcquire() (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64) IncIfNonZeroRelease() (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64) IncIfNonZeroAcqRel() (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) DecIfPositive() (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) DecIfPositiveRelaxed() (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) DecIfPositiveAcquire() (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) DecIfPositiveRelease() (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) DecIfPositiveAcqRel() (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) AddChecked(delta uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) AddCheckedRelaxed(delta uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) AddCheckedAcquire(delta uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) AddCheckedRelease(delta uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64Release(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64) AddCheckedAcqRel(delta uint64) (old uint64, ok bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64) AddSaturating(delta uint64) (old uint64, exact bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64) AddSaturatingRelaxed(delta uint64) (old uint64, exact bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint64Relaxed(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64) AddSaturatingAcquire(delta uint64) (old uint64, exact bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint64Acquire(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64) AddSaturatingRelease(delta uint64) (old uint64, exact bool) {
+	old = arch.LoadUint64Relaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint64Release(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64) AddSaturatingAcqRel(delta uint64) (old uint64, exact bool) {
+	old = arch.LoadUint64Acquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUint64AcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uintptr conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) AddIfBelow(delta, limit uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) AddIfBelowRelaxed(delta, limit uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) AddIfBelowAcquire(delta, limit uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) AddIfBelowRelease(delta, limit uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelease(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) AddIfBelowAcqRel(delta, limit uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) SubIfAtLeast(delta, floor uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) SubIfAtLeastRelaxed(delta, floor uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) SubIfAtLeastAcquire(delta, floor uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) SubIfAtLeastRelease(delta, floor uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelease(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uintptr) SubIfAtLeastAcqRel(delta, floor uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uintptr) IncIfNonZero() (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uintptr) IncIfNonZeroRelaxed() (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uintptr) IncIfNonZeroAcquire() (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uintptr) IncIfNonZeroRelease() (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelease(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uintptr) IncIfNonZeroAcqRel() (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) DecIfPositive() (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) DecIfPositiveRelaxed() (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) DecIfPositiveAcquire() (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) DecIfPositiveRelease() (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelease(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) DecIfPositiveAcqRel() (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) AddChecked(delta uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) AddCheckedRelaxed(delta uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelaxed(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) AddCheckedAcquire(delta uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcquire(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) AddCheckedRelease(delta uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrRelease(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uintptr) AddCheckedAcqRel(delta uintptr) (old uintptr, ok bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uintptr) AddSaturating(delta uintptr) (old uintptr, exact bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uintptr) AddSaturatingRelaxed(delta uintptr) (old uintptr, exact bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUintptrRelaxed(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uintptr) AddSaturatingAcquire(delta uintptr) (old uintptr, exact bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUintptrAcquire(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uintptr) AddSaturatingRelease(delta uintptr) (old uintptr, exact bool) {
+	old = arch.LoadUintptrRelaxed(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUintptrRelease(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uintptr) AddSaturatingAcqRel(delta uintptr) (old uintptr, exact bool) {
+	old = arch.LoadUintptrAcquire(&a.v)
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := arch.CaxUintptrAcqRel(&a.v, old, new)
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Int32Of[T] conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddIfBelow(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddIfBelowRelaxed(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Relaxed(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddIfBelowAcquire(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Acquire(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddIfBelowRelease(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Release(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddIfBelowAcqRel(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) SubIfAtLeast(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) SubIfAtLeastRelaxed(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Relaxed(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) SubIfAtLeastAcquire(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Acquire(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) SubIfAtLeastRelease(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Release(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int32Of[T]) SubIfAtLeastAcqRel(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncIfNonZero() (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncIfNonZeroRelaxed() (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Relaxed(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncIfNonZeroAcquire() (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Acquire(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncIfNonZeroRelease() (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Release(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncIfNonZeroAcqRel() (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecIfPositive() (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecIfPositiveRelaxed() (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Relaxed(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecIfPositiveAcquire() (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Acquire(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecIfPositiveRelease() (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Release(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecIfPositiveAcqRel() (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddChecked(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddCheckedRelaxed(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Relaxed(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddCheckedAcquire(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Acquire(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddCheckedRelease(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32Release(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddCheckedAcqRel(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddSaturating(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddSaturatingRelaxed(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt32Relaxed(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddSaturatingAcquire(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt32Acquire(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddSaturatingRelease(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt32Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt32Release(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddSaturatingAcqRel(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt32Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt32AcqRel(&a.v, int32(old), int32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uint32Of[T] conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddIfBelow(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddIfBelowRelaxed(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Relaxed(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddIfBelowAcquire(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Acquire(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddIfBelowRelease(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Release(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddIfBelowAcqRel(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubIfAtLeast(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubIfAtLeastRelaxed(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Relaxed(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubIfAtLeastAcquire(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Acquire(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubIfAtLeastRelease(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Release(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubIfAtLeastAcqRel(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncIfNonZero() (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncIfNonZeroRelaxed() (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Relaxed(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncIfNonZeroAcquire() (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Acquire(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncIfNonZeroRelease() (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Release(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncIfNonZeroAcqRel() (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecIfPositive() (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecIfPositiveRelaxed() (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Relaxed(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecIfPositiveAcquire() (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Acquire(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecIfPositiveRelease() (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Release(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecIfPositiveAcqRel() (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddChecked(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddCheckedRelaxed(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Relaxed(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddCheckedAcquire(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Acquire(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddCheckedRelease(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32Release(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddCheckedAcqRel(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddSaturating(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddSaturatingRelaxed(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint32Relaxed(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddSaturatingAcquire(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint32Acquire(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddSaturatingRelease(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint32Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint32Release(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddSaturatingAcqRel(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint32Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Int64Of[T] conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddIfBelow(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddIfBelowRelaxed(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Relaxed(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddIfBelowAcquire(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Acquire(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddIfBelowRelease(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Release(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddIfBelowAcqRel(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubIfAtLeast(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubIfAtLeastRelaxed(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Relaxed(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubIfAtLeastAcquire(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Acquire(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubIfAtLeastRelease(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Release(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubIfAtLeastAcqRel(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncIfNonZero() (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncIfNonZeroRelaxed() (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Relaxed(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncIfNonZeroAcquire() (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Acquire(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncIfNonZeroRelease() (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Release(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncIfNonZeroAcqRel() (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecIfPositive() (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecIfPositiveRelaxed() (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Relaxed(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecIfPositiveAcquire() (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Acquire(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecIfPositiveRelease() (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Release(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecIfPositiveAcqRel() (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddChecked(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddCheckedRelaxed(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Relaxed(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddCheckedAcquire(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Acquire(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddCheckedRelease(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64Release(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddCheckedAcqRel(delta T) (old T, ok bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddSaturating(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddSaturatingRelaxed(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt64Relaxed(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddSaturatingAcquire(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt64Acquire(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddSaturatingRelease(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt64Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt64Release(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddSaturatingAcqRel(delta T) (old T, exact bool) {
+	old = T(arch.LoadInt64Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxInt64AcqRel(&a.v, int64(old), int64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uint64Of[T] conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddIfBelow(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddIfBelowRelaxed(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Relaxed(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddIfBelowAcquire(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Acquire(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddIfBelowRelease(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Release(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddIfBelowAcqRel(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubIfAtLeast(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubIfAtLeastRelaxed(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Relaxed(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubIfAtLeastAcquire(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Acquire(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubIfAtLeastRelease(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Release(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubIfAtLeastAcqRel(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncIfNonZero() (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncIfNonZeroRelaxed() (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Relaxed(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncIfNonZeroAcquire() (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Acquire(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncIfNonZeroRelease() (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Release(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncIfNonZeroAcqRel() (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecIfPositive() (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecIfPositiveRelaxed() (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Relaxed(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecIfPositiveAcquire() (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Acquire(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecIfPositiveRelease() (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Release(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecIfPositiveAcqRel() (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddChecked(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddCheckedRelaxed(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Relaxed(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddCheckedAcquire(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Acquire(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddCheckedRelease(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64Release(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddCheckedAcqRel(delta T) (old T, ok bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddSaturating(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddSaturatingRelaxed(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint64Relaxed(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddSaturatingAcquire(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint64Acquire(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddSaturatingRelease(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint64Relaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint64Release(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddSaturatingAcqRel(delta T) (old T, exact bool) {
+	old = T(arch.LoadUint64Acquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// UintptrOf[T] conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddIfBelow(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddIfBelowRelaxed(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelaxed(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddIfBelowAcquire(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcquire(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddIfBelowRelease(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelease(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddIfBelowAcqRel(delta, limit T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := addBelow(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubIfAtLeast(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubIfAtLeastRelaxed(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelaxed(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubIfAtLeastAcquire(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcquire(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubIfAtLeastRelease(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelease(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubIfAtLeastAcqRel(delta, floor T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := subAtLeast(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncIfNonZero() (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncIfNonZeroRelaxed() (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelaxed(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncIfNonZeroAcquire() (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcquire(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncIfNonZeroRelease() (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelease(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncIfNonZeroAcqRel() (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := incNonZero(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecIfPositive() (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecIfPositiveRelaxed() (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelaxed(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecIfPositiveAcquire() (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcquire(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecIfPositiveRelease() (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelease(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecIfPositiveAcqRel() (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := decPositive(old)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddChecked(delta T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddCheckedRelaxed(delta T) (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelaxed(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddCheckedAcquire(delta T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcquire(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddCheckedRelease(delta T) (old T, ok bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrRelease(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddCheckedAcqRel(delta T) (old T, ok bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, ok := addChecked(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddSaturating(delta T) (old T, exact bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddSaturatingRelaxed(delta T) (old T, exact bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUintptrRelaxed(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddSaturatingAcquire(delta T) (old T, exact bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUintptrAcquire(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddSaturatingRelease(delta T) (old T, exact bool) {
+	old = T(arch.LoadUintptrRelaxed(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUintptrRelease(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddSaturatingAcqRel(delta T) (old T, exact bool) {
+	old = T(arch.LoadUintptrAcquire(&a.v))
+	for {
+		new, exact := addSaturating(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Uint128 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) AddIfBelow(delta, limit U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) AddIfBelowRelaxed(delta, limit U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) AddIfBelowAcquire(delta, limit U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) AddIfBelowRelease(delta, limit U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Release(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) AddIfBelowAcqRel(delta, limit U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) SubIfAtLeast(delta, floor U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) SubIfAtLeastRelaxed(delta, floor U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) SubIfAtLeastAcquire(delta, floor U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) SubIfAtLeastRelease(delta, floor U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Release(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Uint128) SubIfAtLeastAcqRel(delta, floor U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint128) IncIfNonZero() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint128) IncIfNonZeroRelaxed() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint128) IncIfNonZeroAcquire() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint128) IncIfNonZeroRelease() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Release(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Uint128) IncIfNonZeroAcqRel() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) DecIfPositive() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) DecIfPositiveRelaxed() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) DecIfPositiveAcquire() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) DecIfPositiveRelease() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Release(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) DecIfPositiveAcqRel() (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) AddChecked(delta U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) AddCheckedRelaxed(delta U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) AddCheckedAcquire(delta U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) AddCheckedRelease(delta U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128Release(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Uint128) AddCheckedAcqRel(delta U128) (old U128, ok bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint128) AddSaturating(delta U128) (old U128, exact bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint128) AddSaturatingRelaxed(delta U128) (old U128, exact bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := u128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint128) AddSaturatingAcquire(delta U128) (old U128, exact bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := u128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint128) AddSaturatingRelease(delta U128) (old U128, exact bool) {
+	old = u128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := u128(arch.CaxUint128Release(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Uint128) AddSaturatingAcqRel(delta U128) (old U128, exact bool) {
+	old = u128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := u128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Int128 conditional arithmetic
+// =============================================================================
+
+// AddIfBelow atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) AddIfBelow(delta, limit I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelaxed atomically adds delta if the sum stays below limit, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) AddIfBelowRelaxed(delta, limit I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcquire atomically adds delta if the sum stays below limit, with acquire ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) AddIfBelowAcquire(delta, limit I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowRelease atomically adds delta if the sum stays below limit, with release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) AddIfBelowRelease(delta, limit I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Release(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddIfBelowAcqRel atomically adds delta if the sum stays below limit, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A sum that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) AddIfBelowAcqRel(delta, limit I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addBelow128(old, delta, limit)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeast atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) SubIfAtLeast(delta, floor I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelaxed atomically subtracts delta if the difference stays at or above floor, with relaxed ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) SubIfAtLeastRelaxed(delta, floor I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcquire atomically subtracts delta if the difference stays at or above floor, with acquire ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) SubIfAtLeastAcquire(delta, floor I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastRelease atomically subtracts delta if the difference stays at or above floor, with release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) SubIfAtLeastRelease(delta, floor I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Release(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// SubIfAtLeastAcqRel atomically subtracts delta if the difference stays at or above floor, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. A difference that overflows never applies.
+//
+//go:nosplit
+func (a *Int128) SubIfAtLeastAcqRel(delta, floor I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := subAtLeast128(old, delta, floor)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZero atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int128) IncIfNonZero() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelaxed atomically adds 1 unless the value is 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int128) IncIfNonZeroRelaxed() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcquire atomically adds 1 unless the value is 0, with acquire ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int128) IncIfNonZeroAcquire() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroRelease atomically adds 1 unless the value is 0, with release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int128) IncIfNonZeroRelease() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Release(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// IncIfNonZeroAcqRel atomically adds 1 unless the value is 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied. It does not apply at the maximum value.
+//
+//go:nosplit
+func (a *Int128) IncIfNonZeroAcqRel() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := incNonZero128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositive atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) DecIfPositive() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelaxed atomically subtracts 1 if the value is greater than 0, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) DecIfPositiveRelaxed() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcquire atomically subtracts 1 if the value is greater than 0, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) DecIfPositiveAcquire() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveRelease atomically subtracts 1 if the value is greater than 0, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) DecIfPositiveRelease() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Release(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// DecIfPositiveAcqRel atomically subtracts 1 if the value is greater than 0, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) DecIfPositiveAcqRel() (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := decPositive128(old)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddChecked atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) AddChecked(delta I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelaxed atomically adds delta unless the sum overflows, with relaxed ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) AddCheckedRelaxed(delta I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcquire atomically adds delta unless the sum overflows, with acquire ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) AddCheckedAcquire(delta I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedRelease atomically adds delta unless the sum overflows, with release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) AddCheckedRelease(delta I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128Release(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddCheckedAcqRel atomically adds delta unless the sum overflows, with acquire-release ordering.
+// It returns the observed value and reports whether it applied.
+//
+//go:nosplit
+func (a *Int128) AddCheckedAcqRel(delta I128) (old I128, ok bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, ok := addChecked128(old, delta)
+		if !ok {
+			return old, false
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, true
+		}
+		old = prev
+	}
+}
+
+// AddSaturating atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int128) AddSaturating(delta I128) (old I128, exact bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelaxed atomically adds delta, clamping the sum to the range of the type, with relaxed ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int128) AddSaturatingRelaxed(delta I128) (old I128, exact bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := i128(arch.CaxUint128Relaxed(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcquire atomically adds delta, clamping the sum to the range of the type, with acquire ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int128) AddSaturatingAcquire(delta I128) (old I128, exact bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := i128(arch.CaxUint128Acquire(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingRelease atomically adds delta, clamping the sum to the range of the type, with release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int128) AddSaturatingRelease(delta I128) (old I128, exact bool) {
+	old = i128(arch.LoadUint128Relaxed(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := i128(arch.CaxUint128Release(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// AddSaturatingAcqRel atomically adds delta, clamping the sum to the range of the type, with acquire-release ordering.
+// It returns the observed value and reports whether the sum was exact.
+//
+//go:nosplit
+func (a *Int128) AddSaturatingAcqRel(delta I128) (old I128, exact bool) {
+	old = i128(arch.LoadUint128Acquire(a.v.slot()))
+	for {
+		new, exact := addSaturating128(old, delta)
+		if new == old {
+			return old, exact
+		}
+		prev := i128(arch.CaxUint128AcqRel(a.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi)))
+		if prev == old {
+			return old, exact
+		}
+		old = prev
+	}
+}
+
+// =============================================================================
+// Int8 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int8) LoadSeqCst() int8 {
+	return int8(arch.LoadUint8SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int8) StoreSeqCst(val int8) {
+	arch.StoreUint8SeqCst(&a.v, uint8(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) SwapSeqCst(new int8) int8 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) CompareAndSwapSeqCst(old, new int8) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) CompareExchangeSeqCst(old, new int8) int8 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) AddSeqCst(delta int8) int8 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) SubSeqCst(delta int8) int8 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) IncSeqCst() int8 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) DecSeqCst() int8 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) FetchAddSeqCst(delta int8) int8 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) FetchSubSeqCst(delta int8) int8 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) FetchIncSeqCst() int8 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) FetchDecSeqCst() int8 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) AddIfBelowSeqCst(delta, limit int8) (old int8, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) SubIfAtLeastSeqCst(delta, floor int8) (old int8, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) IncIfNonZeroSeqCst() (old int8, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) DecIfPositiveSeqCst() (old int8, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) AddCheckedSeqCst(delta int8) (old int8, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) AddSaturatingSeqCst(delta int8) (old int8, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) AndSeqCst(mask int8) int8 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) OrSeqCst(mask int8) int8 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) XorSeqCst(mask int8) int8 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) MaxSeqCst(val int8) int8 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int8) MinSeqCst(val int8) int8 {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Uint8 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint8) LoadSeqCst() uint8 {
+	return arch.LoadUint8SeqCst(&a.v)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint8) StoreSeqCst(val uint8) {
+	arch.StoreUint8SeqCst(&a.v, val)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) SwapSeqCst(new uint8) uint8 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) CompareAndSwapSeqCst(old, new uint8) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) CompareExchangeSeqCst(old, new uint8) uint8 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) AddSeqCst(delta uint8) uint8 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) SubSeqCst(delta uint8) uint8 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) IncSeqCst() uint8 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) DecSeqCst() uint8 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) FetchAddSeqCst(delta uint8) uint8 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) FetchSubSeqCst(delta uint8) uint8 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) FetchIncSeqCst() uint8 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) FetchDecSeqCst() uint8 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) AddIfBelowSeqCst(delta, limit uint8) (old uint8, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) SubIfAtLeastSeqCst(delta, floor uint8) (old uint8, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) IncIfNonZeroSeqCst() (old uint8, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) DecIfPositiveSeqCst() (old uint8, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) AddCheckedSeqCst(delta uint8) (old uint8, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) AddSaturatingSeqCst(delta uint8) (old uint8, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) AndSeqCst(mask uint8) uint8 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) OrSeqCst(mask uint8) uint8 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) XorSeqCst(mask uint8) uint8 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) MaxSeqCst(val uint8) uint8 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint8) MinSeqCst(val uint8) uint8 {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Int16 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int16) LoadSeqCst() int16 {
+	return int16(arch.LoadUint16SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int16) StoreSeqCst(val int16) {
+	arch.StoreUint16SeqCst(&a.v, uint16(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) SwapSeqCst(new int16) int16 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) CompareAndSwapSeqCst(old, new int16) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) CompareExchangeSeqCst(old, new int16) int16 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) AddSeqCst(delta int16) int16 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) SubSeqCst(delta int16) int16 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) IncSeqCst() int16 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) DecSeqCst() int16 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) FetchAddSeqCst(delta int16) int16 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) FetchSubSeqCst(delta int16) int16 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) FetchIncSeqCst() int16 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) FetchDecSeqCst() int16 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) AddIfBelowSeqCst(delta, limit int16) (old int16, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) SubIfAtLeastSeqCst(delta, floor int16) (old int16, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) IncIfNonZeroSeqCst() (old int16, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) DecIfPositiveSeqCst() (old int16, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) AddCheckedSeqCst(delta int16) (old int16, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) AddSaturatingSeqCst(delta int16) (old int16, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) AndSeqCst(mask int16) int16 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) OrSeqCst(mask int16) int16 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) XorSeqCst(mask int16) int16 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) MaxSeqCst(val int16) int16 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int16) MinSeqCst(val int16) int16 {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Uint16 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint16) LoadSeqCst() uint16 {
+	return arch.LoadUint16SeqCst(&a.v)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint16) StoreSeqCst(val uint16) {
+	arch.StoreUint16SeqCst(&a.v, val)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) SwapSeqCst(new uint16) uint16 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) CompareAndSwapSeqCst(old, new uint16) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) CompareExchangeSeqCst(old, new uint16) uint16 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) AddSeqCst(delta uint16) uint16 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) SubSeqCst(delta uint16) uint16 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) IncSeqCst() uint16 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) DecSeqCst() uint16 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) FetchAddSeqCst(delta uint16) uint16 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) FetchSubSeqCst(delta uint16) uint16 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) FetchIncSeqCst() uint16 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) FetchDecSeqCst() uint16 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) AddIfBelowSeqCst(delta, limit uint16) (old uint16, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) SubIfAtLeastSeqCst(delta, floor uint16) (old uint16, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) IncIfNonZeroSeqCst() (old uint16, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) DecIfPositiveSeqCst() (old uint16, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) AddCheckedSeqCst(delta uint16) (old uint16, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) AddSaturatingSeqCst(delta uint16) (old uint16, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) AndSeqCst(mask uint16) uint16 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) OrSeqCst(mask uint16) uint16 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) XorSeqCst(mask uint16) uint16 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) MaxSeqCst(val uint16) uint16 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint16) MinSeqCst(val uint16) uint16 {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Int32 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int32) LoadSeqCst() int32 {
+	return arch.LoadInt32SeqCst(&a.v)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int32) StoreSeqCst(val int32) {
+	arch.StoreInt32SeqCst(&a.v, val)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) SwapSeqCst(new int32) int32 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) CompareAndSwapSeqCst(old, new int32) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) CompareExchangeSeqCst(old, new int32) int32 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) AddSeqCst(delta int32) int32 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) SubSeqCst(delta int32) int32 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) IncSeqCst() int32 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) DecSeqCst() int32 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) FetchAddSeqCst(delta int32) int32 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) FetchSubSeqCst(delta int32) int32 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) FetchIncSeqCst() int32 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) FetchDecSeqCst() int32 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) AddIfBelowSeqCst(delta, limit int32) (old int32, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) SubIfAtLeastSeqCst(delta, floor int32) (old int32, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) IncIfNonZeroSeqCst() (old int32, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) DecIfPositiveSeqCst() (old int32, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) AddCheckedSeqCst(delta int32) (old int32, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) AddSaturatingSeqCst(delta int32) (old int32, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) AndSeqCst(mask int32) int32 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) OrSeqCst(mask int32) int32 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) XorSeqCst(mask int32) int32 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) MaxSeqCst(val int32) int32 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) MinSeqCst(val int32) int32 {
+	return a.MinAcqRel(val)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) UpdateSeqCst(f func(old int32) int32) (old, new int32) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32) TryUpdateSeqCst(f func(old int32) (int32, bool)) (old, new int32, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Uint32 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint32) LoadSeqCst() uint32 {
+	return arch.LoadUint32SeqCst(&a.v)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint32) StoreSeqCst(val uint32) {
+	arch.StoreUint32SeqCst(&a.v, val)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) SwapSeqCst(new uint32) uint32 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) CompareAndSwapSeqCst(old, new uint32) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) CompareExchangeSeqCst(old, new uint32) uint32 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) AddSeqCst(delta uint32) uint32 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) SubSeqCst(delta uint32) uint32 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) IncSeqCst() uint32 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) DecSeqCst() uint32 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) FetchAddSeqCst(delta uint32) uint32 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) FetchSubSeqCst(delta uint32) uint32 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) FetchIncSeqCst() uint32 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) FetchDecSeqCst() uint32 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) AddIfBelowSeqCst(delta, limit uint32) (old uint32, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) SubIfAtLeastSeqCst(delta, floor uint32) (old uint32, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) IncIfNonZeroSeqCst() (old uint32, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) DecIfPositiveSeqCst() (old uint32, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) AddCheckedSeqCst(delta uint32) (old uint32, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) AddSaturatingSeqCst(delta uint32) (old uint32, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) AndSeqCst(mask uint32) uint32 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) OrSeqCst(mask uint32) uint32 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) XorSeqCst(mask uint32) uint32 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) MaxSeqCst(val uint32) uint32 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) MinSeqCst(val uint32) uint32 {
+	return a.MinAcqRel(val)
+}
+
+// BitSetSeqCst is BitSetAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) BitSetSeqCst(bit uint) (wasSet bool) {
+	return a.BitSetAcqRel(bit)
+}
+
+// BitClearSeqCst is BitClearAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) BitClearSeqCst(bit uint) (wasSet bool) {
+	return a.BitClearAcqRel(bit)
+}
+
+// BitToggleSeqCst is BitToggleAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) BitToggleSeqCst(bit uint) (wasSet bool) {
+	return a.BitToggleAcqRel(bit)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) UpdateSeqCst(f func(old uint32) uint32) (old, new uint32) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32) TryUpdateSeqCst(f func(old uint32) (uint32, bool)) (old, new uint32, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Int64 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int64) LoadSeqCst() int64 {
+	return arch.LoadInt64SeqCst(&a.v)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int64) StoreSeqCst(val int64) {
+	arch.StoreInt64SeqCst(&a.v, val)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) SwapSeqCst(new int64) int64 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) CompareAndSwapSeqCst(old, new int64) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) CompareExchangeSeqCst(old, new int64) int64 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) AddSeqCst(delta int64) int64 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) SubSeqCst(delta int64) int64 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) IncSeqCst() int64 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) DecSeqCst() int64 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) FetchAddSeqCst(delta int64) int64 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) FetchSubSeqCst(delta int64) int64 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) FetchIncSeqCst() int64 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) FetchDecSeqCst() int64 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) AddIfBelowSeqCst(delta, limit int64) (old int64, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) SubIfAtLeastSeqCst(delta, floor int64) (old int64, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) IncIfNonZeroSeqCst() (old int64, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) DecIfPositiveSeqCst() (old int64, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) AddCheckedSeqCst(delta int64) (old int64, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) AddSaturatingSeqCst(delta int64) (old int64, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) AndSeqCst(mask int64) int64 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) OrSeqCst(mask int64) int64 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) XorSeqCst(mask int64) int64 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) MaxSeqCst(val int64) int64 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) MinSeqCst(val int64) int64 {
+	return a.MinAcqRel(val)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) UpdateSeqCst(f func(old int64) int64) (old, new int64) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64) TryUpdateSeqCst(f func(old int64) (int64, bool)) (old, new int64, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Uint64 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint64) LoadSeqCst() uint64 {
+	return arch.LoadUint64SeqCst(&a.v)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint64) StoreSeqCst(val uint64) {
+	arch.StoreUint64SeqCst(&a.v, val)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) SwapSeqCst(new uint64) uint64 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) CompareAndSwapSeqCst(old, new uint64) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) CompareExchangeSeqCst(old, new uint64) uint64 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) AddSeqCst(delta uint64) uint64 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) SubSeqCst(delta uint64) uint64 {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) IncSeqCst() uint64 {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) DecSeqCst() uint64 {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) FetchAddSeqCst(delta uint64) uint64 {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) FetchSubSeqCst(delta uint64) uint64 {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) FetchIncSeqCst() uint64 {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) FetchDecSeqCst() uint64 {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) AddIfBelowSeqCst(delta, limit uint64) (old uint64, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) SubIfAtLeastSeqCst(delta, floor uint64) (old uint64, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) IncIfNonZeroSeqCst() (old uint64, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) DecIfPositiveSeqCst() (old uint64, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) AddCheckedSeqCst(delta uint64) (old uint64, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) AddSaturatingSeqCst(delta uint64) (old uint64, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) AndSeqCst(mask uint64) uint64 {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) OrSeqCst(mask uint64) uint64 {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) XorSeqCst(mask uint64) uint64 {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) MaxSeqCst(val uint64) uint64 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) MinSeqCst(val uint64) uint64 {
+	return a.MinAcqRel(val)
+}
+
+// BitSetSeqCst is BitSetAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) BitSetSeqCst(bit uint) (wasSet bool) {
+	return a.BitSetAcqRel(bit)
+}
+
+// BitClearSeqCst is BitClearAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) BitClearSeqCst(bit uint) (wasSet bool) {
+	return a.BitClearAcqRel(bit)
+}
+
+// BitToggleSeqCst is BitToggleAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) BitToggleSeqCst(bit uint) (wasSet bool) {
+	return a.BitToggleAcqRel(bit)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) UpdateSeqCst(f func(old uint64) uint64) (old, new uint64) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64) TryUpdateSeqCst(f func(old uint64) (uint64, bool)) (old, new uint64, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Uintptr SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uintptr) LoadSeqCst() uintptr {
+	return arch.LoadUintptrSeqCst(&a.v)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uintptr) StoreSeqCst(val uintptr) {
+	arch.StoreUintptrSeqCst(&a.v, val)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) SwapSeqCst(new uintptr) uintptr {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) CompareAndSwapSeqCst(old, new uintptr) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) CompareExchangeSeqCst(old, new uintptr) uintptr {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) AddSeqCst(delta uintptr) uintptr {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) SubSeqCst(delta uintptr) uintptr {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) IncSeqCst() uintptr {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) DecSeqCst() uintptr {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) FetchAddSeqCst(delta uintptr) uintptr {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) FetchSubSeqCst(delta uintptr) uintptr {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) FetchIncSeqCst() uintptr {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) FetchDecSeqCst() uintptr {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) AddIfBelowSeqCst(delta, limit uintptr) (old uintptr, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) SubIfAtLeastSeqCst(delta, floor uintptr) (old uintptr, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) IncIfNonZeroSeqCst() (old uintptr, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) DecIfPositiveSeqCst() (old uintptr, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) AddCheckedSeqCst(delta uintptr) (old uintptr, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) AddSaturatingSeqCst(delta uintptr) (old uintptr, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) AndSeqCst(mask uintptr) uintptr {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) OrSeqCst(mask uintptr) uintptr {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) XorSeqCst(mask uintptr) uintptr {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) MaxSeqCst(val uintptr) uintptr {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) MinSeqCst(val uintptr) uintptr {
+	return a.MinAcqRel(val)
+}
+
+// BitSetSeqCst is BitSetAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) BitSetSeqCst(bit uint) (wasSet bool) {
+	return a.BitSetAcqRel(bit)
+}
+
+// BitClearSeqCst is BitClearAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) BitClearSeqCst(bit uint) (wasSet bool) {
+	return a.BitClearAcqRel(bit)
+}
+
+// BitToggleSeqCst is BitToggleAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) BitToggleSeqCst(bit uint) (wasSet bool) {
+	return a.BitToggleAcqRel(bit)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) UpdateSeqCst(f func(old uintptr) uintptr) (old, new uintptr) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uintptr) TryUpdateSeqCst(f func(old uintptr) (uintptr, bool)) (old, new uintptr, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Int32Of[T] SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) LoadSeqCst() T {
+	return T(arch.LoadInt32SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int32Of[T]) StoreSeqCst(val T) {
+	arch.StoreInt32SeqCst(&a.v, int32(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) SwapSeqCst(new T) T {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) CompareAndSwapSeqCst(old, new T) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) CompareExchangeSeqCst(old, new T) T {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddSeqCst(delta T) T {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) SubSeqCst(delta T) T {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncSeqCst() T {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecSeqCst() T {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchAddSeqCst(delta T) T {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchSubSeqCst(delta T) T {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchIncSeqCst() T {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) FetchDecSeqCst() T {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddIfBelowSeqCst(delta, limit T) (old T, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) SubIfAtLeastSeqCst(delta, floor T) (old T, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) IncIfNonZeroSeqCst() (old T, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) DecIfPositiveSeqCst() (old T, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddCheckedSeqCst(delta T) (old T, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) AddSaturatingSeqCst(delta T) (old T, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) AndSeqCst(mask T) T {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) OrSeqCst(mask T) T {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) XorSeqCst(mask T) T {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) MaxSeqCst(val T) T {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int32Of[T]) MinSeqCst(val T) T {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Uint32Of[T] SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) LoadSeqCst() T {
+	return T(arch.LoadUint32SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) StoreSeqCst(val T) {
+	arch.StoreUint32SeqCst(&a.v, uint32(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SwapSeqCst(new T) T {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareAndSwapSeqCst(old, new T) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareExchangeSeqCst(old, new T) T {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddSeqCst(delta T) T {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubSeqCst(delta T) T {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncSeqCst() T {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecSeqCst() T {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchAddSeqCst(delta T) T {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchSubSeqCst(delta T) T {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchIncSeqCst() T {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) FetchDecSeqCst() T {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddIfBelowSeqCst(delta, limit T) (old T, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubIfAtLeastSeqCst(delta, floor T) (old T, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) IncIfNonZeroSeqCst() (old T, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) DecIfPositiveSeqCst() (old T, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddCheckedSeqCst(delta T) (old T, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddSaturatingSeqCst(delta T) (old T, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AndSeqCst(mask T) T {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) OrSeqCst(mask T) T {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) XorSeqCst(mask T) T {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MaxSeqCst(val T) T {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MinSeqCst(val T) T {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Int64Of[T] SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) LoadSeqCst() T {
+	return T(arch.LoadInt64SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int64Of[T]) StoreSeqCst(val T) {
+	arch.StoreInt64SeqCst(&a.v, int64(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) SwapSeqCst(new T) T {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareAndSwapSeqCst(old, new T) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) CompareExchangeSeqCst(old, new T) T {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddSeqCst(delta T) T {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubSeqCst(delta T) T {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncSeqCst() T {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecSeqCst() T {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchAddSeqCst(delta T) T {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchSubSeqCst(delta T) T {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchIncSeqCst() T {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) FetchDecSeqCst() T {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddIfBelowSeqCst(delta, limit T) (old T, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) SubIfAtLeastSeqCst(delta, floor T) (old T, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) IncIfNonZeroSeqCst() (old T, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) DecIfPositiveSeqCst() (old T, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddCheckedSeqCst(delta T) (old T, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) AddSaturatingSeqCst(delta T) (old T, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) AndSeqCst(mask T) T {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) OrSeqCst(mask T) T {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) XorSeqCst(mask T) T {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) MaxSeqCst(val T) T {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int64Of[T]) MinSeqCst(val T) T {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Uint64Of[T] SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) LoadSeqCst() T {
+	return T(arch.LoadUint64SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) StoreSeqCst(val T) {
+	arch.StoreUint64SeqCst(&a.v, uint64(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SwapSeqCst(new T) T {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareAndSwapSeqCst(old, new T) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareExchangeSeqCst(old, new T) T {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddSeqCst(delta T) T {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubSeqCst(delta T) T {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncSeqCst() T {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecSeqCst() T {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchAddSeqCst(delta T) T {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchSubSeqCst(delta T) T {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchIncSeqCst() T {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) FetchDecSeqCst() T {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddIfBelowSeqCst(delta, limit T) (old T, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubIfAtLeastSeqCst(delta, floor T) (old T, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) IncIfNonZeroSeqCst() (old T, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) DecIfPositiveSeqCst() (old T, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddCheckedSeqCst(delta T) (old T, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddSaturatingSeqCst(delta T) (old T, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AndSeqCst(mask T) T {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) OrSeqCst(mask T) T {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) XorSeqCst(mask T) T {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MaxSeqCst(val T) T {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MinSeqCst(val T) T {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// UintptrOf[T] SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) LoadSeqCst() T {
+	return T(arch.LoadUintptrSeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) StoreSeqCst(val T) {
+	arch.StoreUintptrSeqCst(&a.v, uintptr(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SwapSeqCst(new T) T {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareAndSwapSeqCst(old, new T) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareExchangeSeqCst(old, new T) T {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddSeqCst(delta T) T {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubSeqCst(delta T) T {
+	return a.SubAcqRel(delta)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncSeqCst() T {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecSeqCst() T {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchAddSeqCst(delta T) T {
+	return a.FetchAddAcqRel(delta)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchSubSeqCst(delta T) T {
+	return a.FetchSubAcqRel(delta)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchIncSeqCst() T {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) FetchDecSeqCst() T {
+	return a.FetchDecAcqRel()
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddIfBelowSeqCst(delta, limit T) (old T, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubIfAtLeastSeqCst(delta, floor T) (old T, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) IncIfNonZeroSeqCst() (old T, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) DecIfPositiveSeqCst() (old T, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddCheckedSeqCst(delta T) (old T, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddSaturatingSeqCst(delta T) (old T, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AndSeqCst(mask T) T {
+	return a.AndAcqRel(mask)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) OrSeqCst(mask T) T {
+	return a.OrAcqRel(mask)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) XorSeqCst(mask T) T {
+	return a.XorAcqRel(mask)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MaxSeqCst(val T) T {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MinSeqCst(val T) T {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Float32 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Float32) LoadSeqCst() float32 {
+	return math.Float32frombits(arch.LoadUint32SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Float32) StoreSeqCst(val float32) {
+	arch.StoreUint32SeqCst(&a.v, math.Float32bits(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float32) SwapSeqCst(new float32) float32 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float32) CompareAndSwapSeqCst(old, new float32) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float32) CompareExchangeSeqCst(old, new float32) float32 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float32) AddSeqCst(delta float32) float32 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float32) SubSeqCst(delta float32) float32 {
+	return a.SubAcqRel(delta)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float32) MaxSeqCst(val float32) float32 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float32) MinSeqCst(val float32) float32 {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Float64 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Float64) LoadSeqCst() float64 {
+	return math.Float64frombits(arch.LoadUint64SeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Float64) StoreSeqCst(val float64) {
+	arch.StoreUint64SeqCst(&a.v, math.Float64bits(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float64) SwapSeqCst(new float64) float64 {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float64) CompareAndSwapSeqCst(old, new float64) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float64) CompareExchangeSeqCst(old, new float64) float64 {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float64) AddSeqCst(delta float64) float64 {
+	return a.AddAcqRel(delta)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float64) SubSeqCst(delta float64) float64 {
+	return a.SubAcqRel(delta)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float64) MaxSeqCst(val float64) float64 {
+	return a.MaxAcqRel(val)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Float64) MinSeqCst(val float64) float64 {
+	return a.MinAcqRel(val)
+}
+
+// =============================================================================
+// Bool SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Bool) LoadSeqCst() bool {
+	return arch.LoadUint32SeqCst(&a.v) != 0
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Bool) StoreSeqCst(val bool) {
+	arch.StoreUint32SeqCst(&a.v, b2u(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Bool) SwapSeqCst(new bool) bool {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Bool) CompareAndSwapSeqCst(old, new bool) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// ToggleSeqCst is ToggleAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Bool) ToggleSeqCst() bool {
+	return a.ToggleAcqRel()
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Bool) AndSeqCst(val bool) bool {
+	return a.AndAcqRel(val)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Bool) OrSeqCst(val bool) bool {
+	return a.OrAcqRel(val)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Bool) UpdateSeqCst(f func(old bool) bool) (old, new bool) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Bool) TryUpdateSeqCst(f func(old bool) (bool, bool)) (old, new bool, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Pointer[T] SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Pointer[T]) LoadSeqCst() *T {
+	return (*T)(arch.LoadPointerSeqCst(&a.v))
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Pointer[T]) StoreSeqCst(val *T) {
+	arch.StorePointerSeqCst(&a.v, unsafe.Pointer(val))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Pointer[T]) SwapSeqCst(new *T) *T {
+	return a.SwapAcqRel(new)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Pointer[T]) CompareAndSwapSeqCst(old, new *T) bool {
+	return a.CompareAndSwapAcqRel(old, new)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Pointer[T]) CompareExchangeSeqCst(old, new *T) *T {
+	return a.CompareExchangeAcqRel(old, new)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Pointer[T]) UpdateSeqCst(f func(old *T) *T) (old, new *T) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Pointer[T]) TryUpdateSeqCst(f func(old *T) (*T, bool)) (old, new *T, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Uint128 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint128) LoadSeqCst() (lo, hi uint64) {
+	return arch.LoadUint128SeqCst(a.v.slot())
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Uint128) StoreSeqCst(lo, hi uint64) {
+	arch.StoreUint128SeqCst(a.v.slot(), lo, hi)
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) SwapSeqCst(newLo, newHi uint64) (oldLo, oldHi uint64) {
+	return a.SwapAcqRel(newLo, newHi)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) CompareAndSwapSeqCst(oldLo, oldHi, newLo, newHi uint64) bool {
+	return a.CompareAndSwapAcqRel(oldLo, oldHi, newLo, newHi)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) CompareExchangeSeqCst(oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
+	return a.CompareExchangeAcqRel(oldLo, oldHi, newLo, newHi)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) AddSeqCst(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	return a.AddAcqRel(deltaLo, deltaHi)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) SubSeqCst(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	return a.SubAcqRel(deltaLo, deltaHi)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) IncSeqCst() (newLo, newHi uint64) {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) DecSeqCst() (newLo, newHi uint64) {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) FetchAddSeqCst(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	return a.FetchAddAcqRel(deltaLo, deltaHi)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) FetchSubSeqCst(deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	return a.FetchSubAcqRel(deltaLo, deltaHi)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) FetchIncSeqCst() (oldLo, oldHi uint64) {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) FetchDecSeqCst() (oldLo, oldHi uint64) {
+	return a.FetchDecAcqRel()
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) AndSeqCst(lo, hi uint64) (oldLo, oldHi uint64) {
+	return a.AndAcqRel(lo, hi)
+}
+
+// AndNotSeqCst is AndNotAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) AndNotSeqCst(lo, hi uint64) (oldLo, oldHi uint64) {
+	return a.AndNotAcqRel(lo, hi)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) OrSeqCst(lo, hi uint64) (oldLo, oldHi uint64) {
+	return a.OrAcqRel(lo, hi)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) XorSeqCst(lo, hi uint64) (oldLo, oldHi uint64) {
+	return a.XorAcqRel(lo, hi)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) MaxSeqCst(lo, hi uint64) (oldLo, oldHi uint64) {
+	return a.MaxAcqRel(lo, hi)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) MinSeqCst(lo, hi uint64) (oldLo, oldHi uint64) {
+	return a.MinAcqRel(lo, hi)
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) AddIfBelowSeqCst(delta, limit U128) (old U128, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) SubIfAtLeastSeqCst(delta, floor U128) (old U128, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) IncIfNonZeroSeqCst() (old U128, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) DecIfPositiveSeqCst() (old U128, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) AddCheckedSeqCst(delta U128) (old U128, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) AddSaturatingSeqCst(delta U128) (old U128, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) UpdateSeqCst(f func(old U128) U128) (old, new U128) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Uint128) TryUpdateSeqCst(f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
+
+// =============================================================================
+// Int128 SeqCst
+// =============================================================================
+
+// LoadSeqCst atomically loads and returns the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int128) LoadSeqCst() (lo, hi int64) {
+	ulo, uhi := arch.LoadUint128SeqCst(a.v.slot())
+	return int64(ulo), int64(uhi)
+}
+
+// StoreSeqCst atomically stores the value with sequentially consistent ordering.
+//
+//go:nosplit
+func (a *Int128) StoreSeqCst(lo, hi int64) {
+	arch.StoreUint128SeqCst(a.v.slot(), uint64(lo), uint64(hi))
+}
+
+// SwapSeqCst is SwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) SwapSeqCst(newLo, newHi int64) (oldLo, oldHi int64) {
+	return a.SwapAcqRel(newLo, newHi)
+}
+
+// CompareAndSwapSeqCst is CompareAndSwapAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) CompareAndSwapSeqCst(oldLo, oldHi, newLo, newHi int64) bool {
+	return a.CompareAndSwapAcqRel(oldLo, oldHi, newLo, newHi)
+}
+
+// CompareExchangeSeqCst is CompareExchangeAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) CompareExchangeSeqCst(oldLo, oldHi, newLo, newHi int64) (lo, hi int64) {
+	return a.CompareExchangeAcqRel(oldLo, oldHi, newLo, newHi)
+}
+
+// AddSeqCst is AddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) AddSeqCst(deltaLo, deltaHi int64) (lo, hi int64) {
+	return a.AddAcqRel(deltaLo, deltaHi)
+}
+
+// SubSeqCst is SubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) SubSeqCst(deltaLo, deltaHi int64) (lo, hi int64) {
+	return a.SubAcqRel(deltaLo, deltaHi)
+}
+
+// IncSeqCst is IncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) IncSeqCst() (lo, hi int64) {
+	return a.IncAcqRel()
+}
+
+// DecSeqCst is DecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) DecSeqCst() (lo, hi int64) {
+	return a.DecAcqRel()
+}
+
+// FetchAddSeqCst is FetchAddAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) FetchAddSeqCst(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	return a.FetchAddAcqRel(deltaLo, deltaHi)
+}
+
+// FetchSubSeqCst is FetchSubAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) FetchSubSeqCst(deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	return a.FetchSubAcqRel(deltaLo, deltaHi)
+}
+
+// FetchIncSeqCst is FetchIncAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) FetchIncSeqCst() (oldLo, oldHi int64) {
+	return a.FetchIncAcqRel()
+}
+
+// FetchDecSeqCst is FetchDecAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) FetchDecSeqCst() (oldLo, oldHi int64) {
+	return a.FetchDecAcqRel()
+}
+
+// AndSeqCst is AndAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) AndSeqCst(lo, hi int64) (oldLo, oldHi int64) {
+	return a.AndAcqRel(lo, hi)
+}
+
+// AndNotSeqCst is AndNotAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) AndNotSeqCst(lo, hi int64) (oldLo, oldHi int64) {
+	return a.AndNotAcqRel(lo, hi)
+}
+
+// OrSeqCst is OrAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) OrSeqCst(lo, hi int64) (oldLo, oldHi int64) {
+	return a.OrAcqRel(lo, hi)
+}
+
+// XorSeqCst is XorAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) XorSeqCst(lo, hi int64) (oldLo, oldHi int64) {
+	return a.XorAcqRel(lo, hi)
+}
+
+// MaxSeqCst is MaxAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) MaxSeqCst(lo, hi int64) (oldLo, oldHi int64) {
+	return a.MaxAcqRel(lo, hi)
+}
+
+// MinSeqCst is MinAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) MinSeqCst(lo, hi int64) (oldLo, oldHi int64) {
+	return a.MinAcqRel(lo, hi)
+}
+
+// AddIfBelowSeqCst is AddIfBelowAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) AddIfBelowSeqCst(delta, limit I128) (old I128, ok bool) {
+	return a.AddIfBelowAcqRel(delta, limit)
+}
+
+// SubIfAtLeastSeqCst is SubIfAtLeastAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) SubIfAtLeastSeqCst(delta, floor I128) (old I128, ok bool) {
+	return a.SubIfAtLeastAcqRel(delta, floor)
+}
+
+// IncIfNonZeroSeqCst is IncIfNonZeroAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) IncIfNonZeroSeqCst() (old I128, ok bool) {
+	return a.IncIfNonZeroAcqRel()
+}
+
+// DecIfPositiveSeqCst is DecIfPositiveAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) DecIfPositiveSeqCst() (old I128, ok bool) {
+	return a.DecIfPositiveAcqRel()
+}
+
+// AddCheckedSeqCst is AddCheckedAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) AddCheckedSeqCst(delta I128) (old I128, ok bool) {
+	return a.AddCheckedAcqRel(delta)
+}
+
+// AddSaturatingSeqCst is AddSaturatingAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) AddSaturatingSeqCst(delta I128) (old I128, exact bool) {
+	return a.AddSaturatingAcqRel(delta)
+}
+
+// UpdateSeqCst is UpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) UpdateSeqCst(f func(old I128) I128) (old, new I128) {
+	return a.UpdateAcqRel(f)
+}
+
+// TryUpdateSeqCst is TryUpdateAcqRel with sequentially consistent ordering; the
+// acquire-release instructions already provide it.
+//
+//go:nosplit
+func (a *Int128) TryUpdateSeqCst(f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	return a.TryUpdateAcqRel(f)
+}
@@ -0,0 +1,281 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"reflect"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Operation × Ordering Matrix Tests
+// =============================================================================
+
+func TestOperationOrderingMatrix(t *testing.T) {
+	types := []any{
+		new(atomix.Int8), new(atomix.Uint8), new(atomix.Int16), new(atomix.Uint16),
+		new(atomix.Int32), new(atomix.Uint32), new(atomix.Int64), new(atomix.Uint64),
+		new(atomix.Uintptr),
+		new(atomix.Int32Of[int32]), new(atomix.Uint32Of[uint32]),
+		new(atomix.Int64Of[int64]), new(atomix.Uint64Of[uint64]),
+		new(atomix.UintptrOf[uintptr]),
+		new(atomix.Uint128), new(atomix.Int128),
+	}
+	rmw := []string{"Swap", "CompareAndSwap", "CompareExchange", "Add", "Sub", "Inc", "Dec",
+		"FetchAdd", "FetchSub", "FetchInc", "FetchDec", "And", "Or", "Xor", "Max", "Min"}
+	var want []string
+	for _, op := range rmw {
+		for _, ord := range []string{"", "Relaxed", "Acquire", "Release", "AcqRel", "SeqCst"} {
+			want = append(want, op+ord)
+		}
+	}
+	want = append(want, "Load", "LoadRelaxed", "LoadAcquire", "LoadSeqCst", "Store", "StoreRelaxed", "StoreRelease", "StoreSeqCst")
+	for _, v := range types {
+		typ := reflect.TypeOf(v)
+		for _, name := range want {
+			if _, ok := typ.MethodByName(name); !ok {
+				t.Errorf("%v lacks %s", typ.Elem(), name)
+			}
+		}
+	}
+	for _, v := range []any{new(atomix.Uint32), new(atomix.Uint64), new(atomix.Uintptr)} {
+		typ := reflect.TypeOf(v)
+		for _, op := range []string{"BitSet", "BitClear", "BitToggle"} {
+			for _, ord := range []string{"", "Relaxed", "Acquire", "Release", "AcqRel", "SeqCst"} {
+				if _, ok := typ.MethodByName(op + ord); !ok {
+					t.Errorf("%v lacks %s%s", typ.Elem(), op, ord)
+				}
+			}
+		}
+	}
+
+	order := reflect.TypeOf(atomix.Relaxed)
+	for _, typ := range []string{"Int8", "Uint8", "Int16", "Uint16", "Int32", "Uint32", "Int64", "Uint64", "Uintptr"} {
+		for _, op := range append([]string{"Load", "Store"}, rmw...) {
+			if _, ok := order.MethodByName(op + typ); !ok {
+				t.Errorf("MemoryOrder lacks %s%s", op, typ)
+			}
+		}
+	}
+	wide := []string{
+		"Load", "Store", "Swap", "CompareAndSwap", "CompareExchange",
+		"Add", "Sub", "Inc", "Dec", "FetchAdd", "FetchSub", "FetchInc", "FetchDec",
+		"And", "AndNot", "Or", "Xor", "Max", "Min", "Update", "TryUpdate",
+	}
+	for _, typ := range []string{"Uint128", "Int128", "Uint128At", "Int128At"} {
+		for _, op := range wide {
+			if _, ok := order.MethodByName(op + typ); !ok {
+				t.Errorf("MemoryOrder lacks %s%s", op, typ)
+			}
+		}
+	}
+}
+
+func TestIncDecAllOrderings(t *testing.T) {
+	var a atomix.Int8
+	incs := []func() int8{a.Inc, a.IncRelaxed, a.IncAcquire, a.IncRelease, a.IncAcqRel}
+	decs := []func() int8{a.Dec, a.DecRelaxed, a.DecAcquire, a.DecRelease, a.DecAcqRel}
+	a.Store(126)
+	for i := range incs {
+		if got := incs[i](); got != 127 {
+			t.Fatalf("Int8 Inc[%d]: got %d", i, got)
+		}
+		if got := decs[i](); got != 126 {
+			t.Fatalf("Int8 Dec[%d]: got %d", i, got)
+		}
+	}
+
+	var u atomix.Uint32
+	if u.DecRelease() != ^uint32(0) || u.IncAcquire() != 0 {
+		t.Fatal("Uint32 Inc/Dec should wrap")
+	}
+
+	var p atomix.Uintptr
+	p.IncRelaxed()
+	p.IncAcqRel()
+	if p.Dec() != 1 {
+		t.Fatalf("Uintptr: got %d", p.Load())
+	}
+
+	type epoch uint64
+	var e atomix.Uint64Of[epoch]
+	if e.IncRelease() != 1 || e.Inc() != 2 || e.DecAcquire() != 1 {
+		t.Fatalf("Uint64Of Inc/Dec: got %d", e.Load())
+	}
+
+	_, u128 := atomix.PlaceAlignedUint128(make([]byte, 64), 0)
+	if lo, hi := u128.DecAcquire(); lo != ^uint64(0) || hi != ^uint64(0) {
+		t.Fatalf("Uint128 DecAcquire: got (%#x, %#x)", lo, hi)
+	}
+	if lo, hi := u128.IncRelease(); lo != 0 || hi != 0 {
+		t.Fatalf("Uint128 IncRelease: got (%#x, %#x)", lo, hi)
+	}
+	if lo, hi := u128.AddAcqRel(^uint64(0), 0); lo != ^uint64(0) || hi != 0 {
+		t.Fatalf("Uint128 AddAcqRel: got (%#x, %#x)", lo, hi)
+	}
+	if lo, hi := u128.AddRelease(1, 0); lo != 0 || hi != 1 {
+		t.Fatalf("Uint128 AddRelease carry: got (%#x, %#x)", lo, hi)
+	}
+	if lo, hi := u128.SubAcquire(1, 0); lo != ^uint64(0) || hi != 0 {
+		t.Fatalf("Uint128 SubAcquire borrow: got (%#x, %#x)", lo, hi)
+	}
+
+	_, i128 := atomix.PlaceAlignedInt128(make([]byte, 64), 0)
+	if lo, hi := i128.DecRelease(); lo != -1 || hi != -1 {
+		t.Fatalf("Int128 DecRelease: got (%d, %d)", lo, hi)
+	}
+	if lo, hi := i128.SubAcqRel(-2, -1); lo != 1 || hi != 0 {
+		t.Fatalf("Int128 SubAcqRel: got (%d, %d)", lo, hi)
+	}
+}
+
+func TestMaxMinAllOrderings(t *testing.T) {
+	var a atomix.Int16
+	maxes := []func(int16) int16{a.Max, a.MaxRelaxed, a.MaxAcquire, a.MaxRelease, a.MaxAcqRel}
+	mins := []func(int16) int16{a.Min, a.MinRelaxed, a.MinAcquire, a.MinRelease, a.MinAcqRel}
+	for i := range maxes {
+		a.Store(-5)
+		if old := maxes[i](3); old != -5 || a.Load() != 3 {
+			t.Fatalf("Max[%d]: old %d, stored %d", i, old, a.Load())
+		}
+		if old := maxes[i](-10); old != 3 || a.Load() != 3 {
+			t.Fatalf("Max[%d] no-op: old %d, stored %d", i, old, a.Load())
+		}
+		if old := mins[i](-7); old != 3 || a.Load() != -7 {
+			t.Fatalf("Min[%d]: old %d, stored %d", i, old, a.Load())
+		}
+		if old := mins[i](0); old != -7 || a.Load() != -7 {
+			t.Fatalf("Min[%d] no-op: old %d, stored %d", i, old, a.Load())
+		}
+	}
+
+	var u atomix.Uint64
+	u.Store(1 << 63)
+	if u.MinRelease(1); u.Load() != 1 {
+		t.Fatal("Uint64 MinRelease should compare unsigned")
+	}
+	if u.MaxAcquire(1 << 63); u.Load() != 1<<63 {
+		t.Fatal("Uint64 MaxAcquire should compare unsigned")
+	}
+}
+
+func TestMemoryOrderSubIncDec(t *testing.T) {
+	orders := []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel}
+	for _, o := range orders {
+		var i64 int64 = 10
+		if o.SubInt64(&i64, 3) != 7 || o.IncInt64(&i64) != 8 || o.DecInt64(&i64) != 7 {
+			t.Fatalf("%v Int64: got %d", o, i64)
+		}
+		var u32 uint32
+		if o.DecUint32(&u32) != ^uint32(0) || o.SubUint32(&u32, ^uint32(0)) != 0 || o.IncUint32(&u32) != 1 {
+			t.Fatalf("%v Uint32: got %d", o, u32)
+		}
+		var u8 uint8 = 1
+		if o.SubUint8(&u8, 2) != 0xff || o.IncUint8(&u8) != 0 {
+			t.Fatalf("%v Uint8: got %d", o, u8)
+		}
+		var i16 int16 = -1
+		if o.DecInt16(&i16) != -2 || o.SubInt16(&i16, -5) != 3 {
+			t.Fatalf("%v Int16: got %d", o, i16)
+		}
+		var up uintptr = 2
+		if o.SubUintptr(&up, 1) != 1 || o.DecUintptr(&up) != 0 || o.IncUintptr(&up) != 1 {
+			t.Fatalf("%v Uintptr: got %d", o, up)
+		}
+	}
+}
+
+func TestFetchAllOrderings(t *testing.T) {
+	var a atomix.Uint8
+	adds := []func(uint8) uint8{a.FetchAdd, a.FetchAddRelaxed, a.FetchAddAcquire, a.FetchAddRelease, a.FetchAddAcqRel, a.FetchAddSeqCst}
+	subs := []func(uint8) uint8{a.FetchSub, a.FetchSubRelaxed, a.FetchSubAcquire, a.FetchSubRelease, a.FetchSubAcqRel, a.FetchSubSeqCst}
+	incs := []func() uint8{a.FetchInc, a.FetchIncRelaxed, a.FetchIncAcquire, a.FetchIncRelease, a.FetchIncAcqRel, a.FetchIncSeqCst}
+	decs := []func() uint8{a.FetchDec, a.FetchDecRelaxed, a.FetchDecAcquire, a.FetchDecRelease, a.FetchDecAcqRel, a.FetchDecSeqCst}
+	for i := range adds {
+		a.Store(0xfe)
+		if old := adds[i](3); old != 0xfe || a.Load() != 1 {
+			t.Fatalf("FetchAdd #%d: old=%d new=%d, want 254 1", i, old, a.Load())
+		}
+		if old := subs[i](3); old != 1 || a.Load() != 0xfe {
+			t.Fatalf("FetchSub #%d: old=%d new=%d, want 1 254", i, old, a.Load())
+		}
+		if old := incs[i](); old != 0xfe || a.Load() != 0xff {
+			t.Fatalf("FetchInc #%d: old=%d new=%d, want 254 255", i, old, a.Load())
+		}
+		a.Store(0)
+		if old := decs[i](); old != 0 || a.Load() != 0xff {
+			t.Fatalf("FetchDec #%d: old=%d new=%d, want 0 255", i, old, a.Load())
+		}
+	}
+
+	var i32 atomix.Int32
+	i32.Store(-1)
+	if old := i32.FetchSubRelaxed(-5); old != -1 || i32.Load() != 4 {
+		t.Fatalf("Int32 FetchSub: old=%d new=%d", old, i32.Load())
+	}
+	var st atomix.Uint64Of[uint64]
+	if old := st.FetchIncAcquire(); old != 0 || st.FetchDecRelease() != 1 || st.Load() != 0 {
+		t.Fatalf("Uint64Of FetchInc/FetchDec: old=%d now=%d", old, st.Load())
+	}
+
+	_, u := atomix.PlaceAlignedUint128(make([]byte, 64), 0)
+	u.Store(^uint64(0), 0)
+	if lo, hi := u.FetchAddRelease(1, 0); lo != ^uint64(0) || hi != 0 {
+		t.Fatalf("Uint128 FetchAdd old: (%d, %d)", lo, hi)
+	}
+	if lo, hi := u.FetchDec(); lo != 0 || hi != 1 {
+		t.Fatalf("Uint128 FetchDec old: (%d, %d)", lo, hi)
+	}
+	if lo, hi := u.Load(); lo != ^uint64(0) || hi != 0 {
+		t.Fatalf("Uint128 after FetchDec: (%d, %d)", lo, hi)
+	}
+	_, s := atomix.PlaceAlignedInt128(make([]byte, 64), 0)
+	if lo, hi := s.FetchSubSeqCst(2, 0); lo != 0 || hi != 0 {
+		t.Fatalf("Int128 FetchSub old: (%d, %d)", lo, hi)
+	}
+	if lo, hi := s.FetchIncAcqRel(); lo != -2 || hi != -1 {
+		t.Fatalf("Int128 FetchInc old: (%d, %d), want (-2, -1)", lo, hi)
+	}
+}
+
+func TestMemoryOrderFetch(t *testing.T) {
+	orders := []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel, atomix.SeqCst}
+	for _, o := range orders {
+		var u32 uint32 = 1
+		if o.FetchSubUint32(&u32, 2) != 1 || o.FetchIncUint32(&u32) != ^uint32(0) || o.FetchAddUint32(&u32, 5) != 0 || o.FetchDecUint32(&u32) != 5 {
+			t.Fatalf("%v Uint32: got %d", o, u32)
+		}
+		var i8 int8 = 127
+		if o.FetchIncInt8(&i8) != 127 || o.FetchSubInt8(&i8, 1) != -128 || i8 != 127 {
+			t.Fatalf("%v Int8: got %d", o, i8)
+		}
+
+		_, u := atomix.PlaceAlignedUint128(make([]byte, 64), 0)
+		if lo, hi := o.AddUint128(u, ^uint64(0), 0); lo != ^uint64(0) || hi != 0 {
+			t.Fatalf("%v AddUint128 new: (%d, %d)", o, lo, hi)
+		}
+		if lo, hi := o.IncUint128(u); lo != 0 || hi != 1 {
+			t.Fatalf("%v IncUint128 new: (%d, %d)", o, lo, hi)
+		}
+		if lo, hi := o.FetchSubUint128(u, 1, 0); lo != 0 || hi != 1 {
+			t.Fatalf("%v FetchSubUint128 old: (%d, %d)", o, lo, hi)
+		}
+		if lo, hi := o.SubUint128(u, 0, 0); lo != ^uint64(0) || hi != 0 {
+			t.Fatalf("%v SubUint128 new: (%d, %d)", o, lo, hi)
+		}
+		_, s := atomix.PlaceAlignedInt128(make([]byte, 64), 0)
+		if lo, hi := o.DecInt128(s); lo != -1 || hi != -1 {
+			t.Fatalf("%v DecInt128 new: (%d, %d)", o, lo, hi)
+		}
+		if lo, hi := o.FetchAddInt128(s, 3, 0); lo != -1 || hi != -1 {
+			t.Fatalf("%v FetchAddInt128 old: (%d, %d)", o, lo, hi)
+		}
+		if lo, hi := o.FetchDecInt128(s); lo != 2 || hi != 0 {
+			t.Fatalf("%v FetchDecInt128 old: (%d, %d)", o, lo, hi)
+		}
+	}
+}
@@ -38,4 +38,15 @@ const (
 	// AcqRel combines Acquire and Release semantics. Use for read-modify-write
 	// operations in lock-free data structures.
 	AcqRel
+
+	// SeqCst adds a single total order over all SeqCst operations, so a
+	// SeqCst store is never reordered with a later SeqCst load. Use for
+	// Dekker-style handshakes ("store my flag, then load yours") where
+	// AcqRel permits both sides to miss each other's store.
+	//
+	// Loads and stores use dedicated sequences (an XCHG store on amd64,
+	// paired STLR/LDAR on arm64, fences on riscv64 and loong64).
+	// Read-modify-writes use the AcqRel instructions, which are already
+	// sequentially consistent on every supported architecture.
+	SeqCst
 )
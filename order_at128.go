@@ -0,0 +1,478 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "unsafe"
+
+// 128-bit operations on raw memory, for 16-byte slots in kernel-shared or
+// mmap'd buffers that cannot be typed as Uint128 or Int128. Each function
+// takes the slot address and panics unless it is 16-byte aligned.
+
+// at128 returns the 16-byte slot at addr, which must be 16-byte aligned.
+//
+//go:nosplit
+func at128(addr unsafe.Pointer) *[16]byte {
+	if uintptr(addr)&15 != 0 {
+		panic("atomix: 128-bit address is not 16-byte aligned")
+	}
+	return (*[16]byte)(addr)
+}
+
+// LoadUint128At atomically loads the 16 bytes at addr with the specified memory ordering.
+// Returns (lo, hi) where the full value is (hi << 64) | lo.
+// addr must be 16-byte aligned; LoadUint128At panics otherwise.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadUint128At(addr unsafe.Pointer) (lo, hi uint64) {
+	return o.load128(at128(addr))
+}
+
+// StoreUint128At atomically stores (lo, hi) to the 16 bytes at addr with the specified memory ordering.
+// addr must be 16-byte aligned; StoreUint128At panics otherwise.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreUint128At(addr unsafe.Pointer, lo, hi uint64) {
+	o.store128(at128(addr), lo, hi)
+}
+
+// SwapUint128At atomically stores (newLo, newHi) at addr and returns the old value.
+// addr must be 16-byte aligned; SwapUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapUint128At(addr unsafe.Pointer, newLo, newHi uint64) (oldLo, oldHi uint64) {
+	return o.swap128(at128(addr), newLo, newHi)
+}
+
+// CompareAndSwapUint128At atomically compares the value at addr with (oldLo, oldHi) and swaps if equal.
+// Returns true if the swap was performed.
+// addr must be 16-byte aligned; CompareAndSwapUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapUint128At(addr unsafe.Pointer, oldLo, oldHi, newLo, newHi uint64) (swapped bool) {
+	return o.cas128(at128(addr), oldLo, oldHi, newLo, newHi)
+}
+
+// CompareExchangeUint128At atomically compares the value at addr with (oldLo, oldHi) and swaps if equal.
+// Returns the previous value.
+// addr must be 16-byte aligned; CompareExchangeUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeUint128At(addr unsafe.Pointer, oldLo, oldHi, newLo, newHi uint64) (prevLo, prevHi uint64) {
+	return o.cax128(at128(addr), oldLo, oldHi, newLo, newHi)
+}
+
+// AddUint128At atomically adds (deltaLo, deltaHi) to the value at addr and returns the new value.
+// addr must be 16-byte aligned; AddUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddUint128At(addr unsafe.Pointer, deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	_, new := o.add128(at128(addr), U128{deltaLo, deltaHi}, false)
+	return new.Lo, new.Hi
+}
+
+// SubUint128At atomically subtracts (deltaLo, deltaHi) from the value at addr and returns the new value.
+// addr must be 16-byte aligned; SubUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubUint128At(addr unsafe.Pointer, deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	_, new := o.add128(at128(addr), U128{deltaLo, deltaHi}, true)
+	return new.Lo, new.Hi
+}
+
+// FetchAddUint128At atomically adds (deltaLo, deltaHi) to the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchAddUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddUint128At(addr unsafe.Pointer, deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	old, _ := o.add128(at128(addr), U128{deltaLo, deltaHi}, false)
+	return old.Lo, old.Hi
+}
+
+// FetchSubUint128At atomically subtracts (deltaLo, deltaHi) from the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchSubUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubUint128At(addr unsafe.Pointer, deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	old, _ := o.add128(at128(addr), U128{deltaLo, deltaHi}, true)
+	return old.Lo, old.Hi
+}
+
+// IncUint128At atomically adds 1 to the value at addr and returns the new value.
+// addr must be 16-byte aligned; IncUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncUint128At(addr unsafe.Pointer) (newLo, newHi uint64) {
+	return o.AddUint128At(addr, 1, 0)
+}
+
+// DecUint128At atomically subtracts 1 from the value at addr and returns the new value.
+// addr must be 16-byte aligned; DecUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecUint128At(addr unsafe.Pointer) (newLo, newHi uint64) {
+	return o.SubUint128At(addr, 1, 0)
+}
+
+// FetchIncUint128At atomically adds 1 to the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchIncUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncUint128At(addr unsafe.Pointer) (oldLo, oldHi uint64) {
+	return o.FetchAddUint128At(addr, 1, 0)
+}
+
+// FetchDecUint128At atomically subtracts 1 from the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchDecUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecUint128At(addr unsafe.Pointer) (oldLo, oldHi uint64) {
+	return o.FetchSubUint128At(addr, 1, 0)
+}
+
+// AndUint128At atomically performs *addr &= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; AndUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndUint128At(addr unsafe.Pointer, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.and128(at128(addr), lo, hi)
+}
+
+// AndNotUint128At atomically performs *addr &^= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; AndNotUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndNotUint128At(addr unsafe.Pointer, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.andNot128(at128(addr), lo, hi)
+}
+
+// OrUint128At atomically performs *addr |= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; OrUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrUint128At(addr unsafe.Pointer, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.or128(at128(addr), lo, hi)
+}
+
+// XorUint128At atomically performs *addr ^= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; XorUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorUint128At(addr unsafe.Pointer, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.xor128(at128(addr), lo, hi)
+}
+
+// MaxUint128At atomically stores the maximum of the value at addr and (lo, hi) and returns the old value. Comparison is unsigned.
+// addr must be 16-byte aligned; MaxUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxUint128At(addr unsafe.Pointer, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.max128(at128(addr), lo, hi, false)
+}
+
+// MinUint128At atomically stores the minimum of the value at addr and (lo, hi) and returns the old value. Comparison is unsigned.
+// addr must be 16-byte aligned; MinUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinUint128At(addr unsafe.Pointer, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.min128(at128(addr), lo, hi, false)
+}
+
+// UpdateUint128At atomically replaces the value at addr with f(value) and returns
+// the old and new values. f may run more than once under contention and must
+// be free of side effects; retries use CompareExchange and back off.
+// addr must be 16-byte aligned; UpdateUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateUint128At(addr unsafe.Pointer, f func(old U128) U128) (old, new U128) {
+	p := at128(addr)
+	var b backoff
+	lo, hi := o.load128(p)
+	old = U128{lo, hi}
+	for {
+		new = f(old)
+		lo, hi = o.cax128(p, old.Lo, old.Hi, new.Lo, new.Hi)
+		prev := U128{lo, hi}
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateUint128At is like UpdateUint128At, but f may decline by returning false, in
+// which case the value is left unchanged and (old, old, false) is returned.
+// addr must be 16-byte aligned; TryUpdateUint128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateUint128At(addr unsafe.Pointer, f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	p := at128(addr)
+	var b backoff
+	lo, hi := o.load128(p)
+	old = U128{lo, hi}
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		lo, hi = o.cax128(p, old.Lo, old.Hi, new.Lo, new.Hi)
+		prev := U128{lo, hi}
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// LoadInt128At atomically loads the 16 bytes at addr with the specified memory ordering.
+// Returns (lo, hi) where the full value is (hi << 64) | lo.
+// addr must be 16-byte aligned; LoadInt128At panics otherwise.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadInt128At(addr unsafe.Pointer) (lo, hi int64) {
+	ulo, uhi := o.load128(at128(addr))
+	return int64(ulo), int64(uhi)
+}
+
+// StoreInt128At atomically stores (lo, hi) to the 16 bytes at addr with the specified memory ordering.
+// addr must be 16-byte aligned; StoreInt128At panics otherwise.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreInt128At(addr unsafe.Pointer, lo, hi int64) {
+	o.store128(at128(addr), uint64(lo), uint64(hi))
+}
+
+// SwapInt128At atomically stores (newLo, newHi) at addr and returns the old value.
+// addr must be 16-byte aligned; SwapInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapInt128At(addr unsafe.Pointer, newLo, newHi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.swap128(at128(addr), uint64(newLo), uint64(newHi))
+	return int64(ulo), int64(uhi)
+}
+
+// CompareAndSwapInt128At atomically compares the value at addr with (oldLo, oldHi) and swaps if equal.
+// Returns true if the swap was performed.
+// addr must be 16-byte aligned; CompareAndSwapInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapInt128At(addr unsafe.Pointer, oldLo, oldHi, newLo, newHi int64) (swapped bool) {
+	return o.cas128(at128(addr), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+}
+
+// CompareExchangeInt128At atomically compares the value at addr with (oldLo, oldHi) and swaps if equal.
+// Returns the previous value.
+// addr must be 16-byte aligned; CompareExchangeInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeInt128At(addr unsafe.Pointer, oldLo, oldHi, newLo, newHi int64) (prevLo, prevHi int64) {
+	ulo, uhi := o.cax128(at128(addr), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+	return int64(ulo), int64(uhi)
+}
+
+// AddInt128At atomically adds (deltaLo, deltaHi) to the value at addr and returns the new value.
+// addr must be 16-byte aligned; AddInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddInt128At(addr unsafe.Pointer, deltaLo, deltaHi int64) (newLo, newHi int64) {
+	_, new := o.add128(at128(addr), U128{uint64(deltaLo), uint64(deltaHi)}, false)
+	return int64(new.Lo), int64(new.Hi)
+}
+
+// SubInt128At atomically subtracts (deltaLo, deltaHi) from the value at addr and returns the new value.
+// addr must be 16-byte aligned; SubInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubInt128At(addr unsafe.Pointer, deltaLo, deltaHi int64) (newLo, newHi int64) {
+	_, new := o.add128(at128(addr), U128{uint64(deltaLo), uint64(deltaHi)}, true)
+	return int64(new.Lo), int64(new.Hi)
+}
+
+// FetchAddInt128At atomically adds (deltaLo, deltaHi) to the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchAddInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddInt128At(addr unsafe.Pointer, deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	old, _ := o.add128(at128(addr), U128{uint64(deltaLo), uint64(deltaHi)}, false)
+	return int64(old.Lo), int64(old.Hi)
+}
+
+// FetchSubInt128At atomically subtracts (deltaLo, deltaHi) from the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchSubInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubInt128At(addr unsafe.Pointer, deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	old, _ := o.add128(at128(addr), U128{uint64(deltaLo), uint64(deltaHi)}, true)
+	return int64(old.Lo), int64(old.Hi)
+}
+
+// IncInt128At atomically adds 1 to the value at addr and returns the new value.
+// addr must be 16-byte aligned; IncInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncInt128At(addr unsafe.Pointer) (newLo, newHi int64) {
+	return o.AddInt128At(addr, 1, 0)
+}
+
+// DecInt128At atomically subtracts 1 from the value at addr and returns the new value.
+// addr must be 16-byte aligned; DecInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecInt128At(addr unsafe.Pointer) (newLo, newHi int64) {
+	return o.SubInt128At(addr, 1, 0)
+}
+
+// FetchIncInt128At atomically adds 1 to the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchIncInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncInt128At(addr unsafe.Pointer) (oldLo, oldHi int64) {
+	return o.FetchAddInt128At(addr, 1, 0)
+}
+
+// FetchDecInt128At atomically subtracts 1 from the value at addr and returns the old value.
+// addr must be 16-byte aligned; FetchDecInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecInt128At(addr unsafe.Pointer) (oldLo, oldHi int64) {
+	return o.FetchSubInt128At(addr, 1, 0)
+}
+
+// AndInt128At atomically performs *addr &= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; AndInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndInt128At(addr unsafe.Pointer, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.and128(at128(addr), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndNotInt128At atomically performs *addr &^= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; AndNotInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndNotInt128At(addr unsafe.Pointer, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.andNot128(at128(addr), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// OrInt128At atomically performs *addr |= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; OrInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrInt128At(addr unsafe.Pointer, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.or128(at128(addr), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// XorInt128At atomically performs *addr ^= (lo, hi) and returns the old value.
+// addr must be 16-byte aligned; XorInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorInt128At(addr unsafe.Pointer, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.xor128(at128(addr), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// MaxInt128At atomically stores the maximum of the value at addr and (lo, hi) and returns the old value. Comparison is signed.
+// addr must be 16-byte aligned; MaxInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxInt128At(addr unsafe.Pointer, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.max128(at128(addr), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MinInt128At atomically stores the minimum of the value at addr and (lo, hi) and returns the old value. Comparison is signed.
+// addr must be 16-byte aligned; MinInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinInt128At(addr unsafe.Pointer, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.min128(at128(addr), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// UpdateInt128At atomically replaces the value at addr with f(value) and returns
+// the old and new values. f may run more than once under contention and must
+// be free of side effects; retries use CompareExchange and back off.
+// addr must be 16-byte aligned; UpdateInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateInt128At(addr unsafe.Pointer, f func(old I128) I128) (old, new I128) {
+	p := at128(addr)
+	var b backoff
+	lo, hi := o.load128(p)
+	old = I128{lo, int64(hi)}
+	for {
+		new = f(old)
+		lo, hi = o.cax128(p, old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi))
+		prev := I128{lo, int64(hi)}
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateInt128At is like UpdateInt128At, but f may decline by returning false, in
+// which case the value is left unchanged and (old, old, false) is returned.
+// addr must be 16-byte aligned; TryUpdateInt128At panics otherwise.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateInt128At(addr unsafe.Pointer, f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	p := at128(addr)
+	var b backoff
+	lo, hi := o.load128(p)
+	old = I128{lo, int64(hi)}
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		lo, hi = o.cax128(p, old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi))
+		prev := I128{lo, int64(hi)}
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
@@ -12,10 +12,14 @@ import "code.hybscloud.com/atomix/internal/arch"
 //
 //go:nosplit
 func (o MemoryOrder) LoadBool(addr *uint32) bool {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		return arch.LoadUint32Relaxed(addr) != 0
+	case SeqCst:
+		return arch.LoadUint32SeqCst(addr) != 0
+	default:
+		return arch.LoadUint32Acquire(addr) != 0
 	}
-	return arch.LoadUint32Acquire(addr) != 0
 }
 
 // StoreBool atomically stores val to *addr with the specified memory ordering.
@@ -28,11 +32,14 @@ func (o MemoryOrder) StoreBool(addr *uint32, val bool) {
 	if val {
 		v = 1
 	}
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		arch.StoreUint32Relaxed(addr, v)
-		return
+	case SeqCst:
+		arch.StoreUint32SeqCst(addr, v)
+	default:
+		arch.StoreUint32Release(addr, v)
 	}
-	arch.StoreUint32Release(addr, v)
 }
 
 // SwapBool atomically stores new to *addr and returns the old value.
@@ -82,3 +89,76 @@ func (o MemoryOrder) CompareAndSwapBool(addr *uint32, old, new bool) (swapped bo
 		return arch.CasUint32AcqRel(addr, oldV, newV)
 	}
 }
+
+// ToggleBool atomically negates *addr and returns the old value.
+// addr points to a uint32 holding 0 (false) or 1 (true), as stored by StoreBool.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) ToggleBool(addr *uint32) (old bool) {
+	return o.BitToggleUint32(addr, 0)
+}
+
+// AndBool atomically performs *addr = *addr && val and returns the old value.
+// addr points to a uint32 holding 0 (false) or 1 (true), as stored by StoreBool.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndBool(addr *uint32, val bool) (old bool) {
+	if val {
+		return o.AddUint32(addr, 0) != 0
+	}
+	return o.BitClearUint32(addr, 0)
+}
+
+// OrBool atomically performs *addr = *addr || val and returns the old value.
+// addr points to a uint32 holding 0 (false) or 1 (true), as stored by StoreBool.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrBool(addr *uint32, val bool) (old bool) {
+	if val {
+		return o.BitSetUint32(addr, 0)
+	}
+	return o.AddUint32(addr, 0) != 0
+}
+
+// UpdateBool atomically replaces *addr with f(*addr) and returns the old and
+// new values. addr points to a uint32 where 0 is false and non-zero is true.
+// f may run more than once under contention and must be free of side
+// effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateBool(addr *uint32, f func(old bool) bool) (old, new bool) {
+	var b backoff
+	cur := o.LoadUint32(addr)
+	for {
+		old, new = cur != 0, f(cur != 0)
+		prev := o.CompareExchangeUint32(addr, cur, b2u(new))
+		if prev == cur {
+			return old, new
+		}
+		cur = prev
+		b.wait()
+	}
+}
+
+// TryUpdateBool is like UpdateBool, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateBool(addr *uint32, f func(old bool) (bool, bool)) (old, new bool, updated bool) {
+	var b backoff
+	cur := o.LoadUint32(addr)
+	for {
+		old = cur != 0
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := o.CompareExchangeUint32(addr, cur, b2u(new))
+		if prev == cur {
+			return old, new, true
+		}
+		cur = prev
+		b.wait()
+	}
+}
@@ -0,0 +1,116 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"math"
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// LoadFloat32 atomically loads *addr with the specified memory ordering.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadFloat32(addr *float32) float32 {
+	switch o {
+	case Relaxed:
+		return math.Float32frombits(arch.LoadUint32Relaxed((*uint32)(unsafe.Pointer(addr))))
+	case SeqCst:
+		return math.Float32frombits(arch.LoadUint32SeqCst((*uint32)(unsafe.Pointer(addr))))
+	default:
+		return math.Float32frombits(arch.LoadUint32Acquire((*uint32)(unsafe.Pointer(addr))))
+	}
+}
+
+// StoreFloat32 atomically stores val to *addr with the specified memory ordering.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreFloat32(addr *float32, val float32) {
+	switch o {
+	case Relaxed:
+		arch.StoreUint32Relaxed((*uint32)(unsafe.Pointer(addr)), math.Float32bits(val))
+	case SeqCst:
+		arch.StoreUint32SeqCst((*uint32)(unsafe.Pointer(addr)), math.Float32bits(val))
+	default:
+		arch.StoreUint32Release((*uint32)(unsafe.Pointer(addr)), math.Float32bits(val))
+	}
+}
+
+// SwapFloat32 atomically stores new to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapFloat32(addr *float32, new float32) (old float32) {
+	return math.Float32frombits(o.SwapUint32((*uint32)(unsafe.Pointer(addr)), math.Float32bits(new)))
+}
+
+// CompareAndSwapFloat32 atomically compares *addr with old and swaps if equal.
+// Returns true if the swap was performed. Values are compared bitwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapFloat32(addr *float32, old, new float32) (swapped bool) {
+	return o.CompareAndSwapUint32((*uint32)(unsafe.Pointer(addr)), math.Float32bits(old), math.Float32bits(new))
+}
+
+// CompareExchangeFloat32 atomically compares *addr with old and swaps if equal.
+// Returns the previous value (enables CAS loops without separate Load).
+// Values are compared bitwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeFloat32(addr *float32, old, new float32) (prev float32) {
+	return math.Float32frombits(o.CompareExchangeUint32((*uint32)(unsafe.Pointer(addr)), math.Float32bits(old), math.Float32bits(new)))
+}
+
+// AddFloat32 atomically adds delta to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddFloat32(addr *float32, delta float32) (new float32) {
+	p := (*uint32)(unsafe.Pointer(addr))
+	for {
+		old := arch.LoadUint32Relaxed(p)
+		new = math.Float32frombits(old) + delta
+		if o.CompareAndSwapUint32(p, old, math.Float32bits(new)) {
+			return new
+		}
+	}
+}
+
+// MaxFloat32 atomically stores max(*addr, val) and returns the old value.
+// See [Float32] for NaN and signed-zero semantics.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxFloat32(addr *float32, val float32) (old float32) {
+	p := (*uint32)(unsafe.Pointer(addr))
+	for {
+		cur := arch.LoadUint32Relaxed(p)
+		new := math.Float32bits(maxFloat32(math.Float32frombits(cur), val))
+		if new == cur || o.CompareAndSwapUint32(p, cur, new) {
+			return math.Float32frombits(cur)
+		}
+	}
+}
+
+// MinFloat32 atomically stores min(*addr, val) and returns the old value.
+// See [Float32] for NaN and signed-zero semantics.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinFloat32(addr *float32, val float32) (old float32) {
+	p := (*uint32)(unsafe.Pointer(addr))
+	for {
+		cur := arch.LoadUint32Relaxed(p)
+		new := math.Float32bits(minFloat32(math.Float32frombits(cur), val))
+		if new == cur || o.CompareAndSwapUint32(p, cur, new) {
+			return math.Float32frombits(cur)
+		}
+	}
+}
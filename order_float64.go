@@ -0,0 +1,116 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"math"
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// LoadFloat64 atomically loads *addr with the specified memory ordering.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadFloat64(addr *float64) float64 {
+	switch o {
+	case Relaxed:
+		return math.Float64frombits(arch.LoadUint64Relaxed((*uint64)(unsafe.Pointer(addr))))
+	case SeqCst:
+		return math.Float64frombits(arch.LoadUint64SeqCst((*uint64)(unsafe.Pointer(addr))))
+	default:
+		return math.Float64frombits(arch.LoadUint64Acquire((*uint64)(unsafe.Pointer(addr))))
+	}
+}
+
+// StoreFloat64 atomically stores val to *addr with the specified memory ordering.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreFloat64(addr *float64, val float64) {
+	switch o {
+	case Relaxed:
+		arch.StoreUint64Relaxed((*uint64)(unsafe.Pointer(addr)), math.Float64bits(val))
+	case SeqCst:
+		arch.StoreUint64SeqCst((*uint64)(unsafe.Pointer(addr)), math.Float64bits(val))
+	default:
+		arch.StoreUint64Release((*uint64)(unsafe.Pointer(addr)), math.Float64bits(val))
+	}
+}
+
+// SwapFloat64 atomically stores new to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapFloat64(addr *float64, new float64) (old float64) {
+	return math.Float64frombits(o.SwapUint64((*uint64)(unsafe.Pointer(addr)), math.Float64bits(new)))
+}
+
+// CompareAndSwapFloat64 atomically compares *addr with old and swaps if equal.
+// Returns true if the swap was performed. Values are compared bitwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapFloat64(addr *float64, old, new float64) (swapped bool) {
+	return o.CompareAndSwapUint64((*uint64)(unsafe.Pointer(addr)), math.Float64bits(old), math.Float64bits(new))
+}
+
+// CompareExchangeFloat64 atomically compares *addr with old and swaps if equal.
+// Returns the previous value (enables CAS loops without separate Load).
+// Values are compared bitwise.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeFloat64(addr *float64, old, new float64) (prev float64) {
+	return math.Float64frombits(o.CompareExchangeUint64((*uint64)(unsafe.Pointer(addr)), math.Float64bits(old), math.Float64bits(new)))
+}
+
+// AddFloat64 atomically adds delta to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddFloat64(addr *float64, delta float64) (new float64) {
+	p := (*uint64)(unsafe.Pointer(addr))
+	for {
+		old := arch.LoadUint64Relaxed(p)
+		new = math.Float64frombits(old) + delta
+		if o.CompareAndSwapUint64(p, old, math.Float64bits(new)) {
+			return new
+		}
+	}
+}
+
+// MaxFloat64 atomically stores max(*addr, val) and returns the old value.
+// See [Float64] for NaN and signed-zero semantics.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxFloat64(addr *float64, val float64) (old float64) {
+	p := (*uint64)(unsafe.Pointer(addr))
+	for {
+		cur := arch.LoadUint64Relaxed(p)
+		new := math.Float64bits(maxFloat64(math.Float64frombits(cur), val))
+		if new == cur || o.CompareAndSwapUint64(p, cur, new) {
+			return math.Float64frombits(cur)
+		}
+	}
+}
+
+// MinFloat64 atomically stores min(*addr, val) and returns the old value.
+// See [Float64] for NaN and signed-zero semantics.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinFloat64(addr *float64, val float64) (old float64) {
+	p := (*uint64)(unsafe.Pointer(addr))
+	for {
+		cur := arch.LoadUint64Relaxed(p)
+		new := math.Float64bits(minFloat64(math.Float64frombits(cur), val))
+		if new == cur || o.CompareAndSwapUint64(p, cur, new) {
+			return math.Float64frombits(cur)
+		}
+	}
+}
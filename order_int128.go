@@ -8,34 +8,39 @@ import "code.hybscloud.com/atomix/internal/arch"
 
 // LoadInt128 atomically loads *addr with the specified memory ordering.
 // Returns (lo, hi) where the full value is (hi << 64) | lo.
-// addr MUST be 16-byte aligned; use PlaceAlignedInt128 to ensure alignment.
 // Unknown orderings fallback to Acquire.
 //
 //go:nosplit
 func (o MemoryOrder) LoadInt128(addr *Int128) (lo, hi int64) {
-	if o == Relaxed {
-		ulo, uhi := arch.LoadUint128Relaxed(&addr.v)
+	switch o {
+	case Relaxed:
+		ulo, uhi := arch.LoadUint128Relaxed(addr.v.slot())
+		return int64(ulo), int64(uhi)
+	case SeqCst:
+		ulo, uhi := arch.LoadUint128SeqCst(addr.v.slot())
+		return int64(ulo), int64(uhi)
+	default:
+		ulo, uhi := arch.LoadUint128Acquire(addr.v.slot())
 		return int64(ulo), int64(uhi)
 	}
-	ulo, uhi := arch.LoadUint128Acquire(&addr.v)
-	return int64(ulo), int64(uhi)
 }
 
 // StoreInt128 atomically stores (lo, hi) to *addr with the specified memory ordering.
-// addr MUST be 16-byte aligned; use PlaceAlignedInt128 to ensure alignment.
 // Unknown orderings fallback to Release.
 //
 //go:nosplit
 func (o MemoryOrder) StoreInt128(addr *Int128, lo, hi int64) {
-	if o == Relaxed {
-		arch.StoreUint128Relaxed(&addr.v, uint64(lo), uint64(hi))
-		return
+	switch o {
+	case Relaxed:
+		arch.StoreUint128Relaxed(addr.v.slot(), uint64(lo), uint64(hi))
+	case SeqCst:
+		arch.StoreUint128SeqCst(addr.v.slot(), uint64(lo), uint64(hi))
+	default:
+		arch.StoreUint128Release(addr.v.slot(), uint64(lo), uint64(hi))
 	}
-	arch.StoreUint128Release(&addr.v, uint64(lo), uint64(hi))
 }
 
 // SwapInt128 atomically stores (newLo, newHi) to *addr and returns the old value.
-// addr MUST be 16-byte aligned; use PlaceAlignedInt128 to ensure alignment.
 // Unknown orderings fallback to AcqRel.
 //
 //go:nosplit
@@ -43,39 +48,37 @@ func (o MemoryOrder) SwapInt128(addr *Int128, newLo, newHi int64) (oldLo, oldHi
 	var ulo, uhi uint64
 	switch o {
 	case Relaxed:
-		ulo, uhi = arch.SwapUint128Relaxed(&addr.v, uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.SwapUint128Relaxed(addr.v.slot(), uint64(newLo), uint64(newHi))
 	case Acquire:
-		ulo, uhi = arch.SwapUint128Acquire(&addr.v, uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.SwapUint128Acquire(addr.v.slot(), uint64(newLo), uint64(newHi))
 	case Release:
-		ulo, uhi = arch.SwapUint128Release(&addr.v, uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.SwapUint128Release(addr.v.slot(), uint64(newLo), uint64(newHi))
 	default:
-		ulo, uhi = arch.SwapUint128AcqRel(&addr.v, uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.SwapUint128AcqRel(addr.v.slot(), uint64(newLo), uint64(newHi))
 	}
 	return int64(ulo), int64(uhi)
 }
 
 // CompareAndSwapInt128 atomically compares *addr with (oldLo, oldHi) and swaps if equal.
 // Returns true if the swap was performed.
-// addr MUST be 16-byte aligned; use PlaceAlignedInt128 to ensure alignment.
 // Unknown orderings fallback to AcqRel.
 //
 //go:nosplit
 func (o MemoryOrder) CompareAndSwapInt128(addr *Int128, oldLo, oldHi, newLo, newHi int64) (swapped bool) {
 	switch o {
 	case Relaxed:
-		return arch.CasUint128Relaxed(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		return arch.CasUint128Relaxed(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	case Acquire:
-		return arch.CasUint128Acquire(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		return arch.CasUint128Acquire(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	case Release:
-		return arch.CasUint128Release(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		return arch.CasUint128Release(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	default:
-		return arch.CasUint128AcqRel(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		return arch.CasUint128AcqRel(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	}
 }
 
 // CompareExchangeInt128 atomically compares *addr with (oldLo, oldHi) and swaps if equal.
 // Returns the previous value (enables CAS loops without separate Load).
-// addr MUST be 16-byte aligned; use PlaceAlignedInt128 to ensure alignment.
 // Unknown orderings fallback to AcqRel.
 //
 //go:nosplit
@@ -83,45 +86,177 @@ func (o MemoryOrder) CompareExchangeInt128(addr *Int128, oldLo, oldHi, newLo, ne
 	var ulo, uhi uint64
 	switch o {
 	case Relaxed:
-		ulo, uhi = arch.CaxUint128Relaxed(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.CaxUint128Relaxed(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	case Acquire:
-		ulo, uhi = arch.CaxUint128Acquire(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.CaxUint128Acquire(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	case Release:
-		ulo, uhi = arch.CaxUint128Release(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.CaxUint128Release(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	default:
-		ulo, uhi = arch.CaxUint128AcqRel(&addr.v, uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
+		ulo, uhi = arch.CaxUint128AcqRel(addr.v.slot(), uint64(oldLo), uint64(oldHi), uint64(newLo), uint64(newHi))
 	}
 	return int64(ulo), int64(uhi)
 }
 
-// AddInt128 atomically adds (deltaLo, deltaHi) to *addr and returns the old value.
-// addr MUST be 16-byte aligned; use PlaceAlignedInt128 to ensure alignment.
+// AddInt128 atomically adds (deltaLo, deltaHi) to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddInt128(addr *Int128, deltaLo, deltaHi int64) (newLo, newHi int64) {
+	_, new := o.add128(addr.v.slot(), U128{uint64(deltaLo), uint64(deltaHi)}, false)
+	return int64(new.Lo), int64(new.Hi)
+}
+
+// SubInt128 atomically subtracts (deltaLo, deltaHi) from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubInt128(addr *Int128, deltaLo, deltaHi int64) (newLo, newHi int64) {
+	_, new := o.add128(addr.v.slot(), U128{uint64(deltaLo), uint64(deltaHi)}, true)
+	return int64(new.Lo), int64(new.Hi)
+}
+
+// IncInt128 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncInt128(addr *Int128) (newLo, newHi int64) {
+	return o.AddInt128(addr, 1, 0)
+}
+
+// DecInt128 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecInt128(addr *Int128) (newLo, newHi int64) {
+	return o.SubInt128(addr, 1, 0)
+}
+
+// FetchAddInt128 atomically adds (deltaLo, deltaHi) to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddInt128(addr *Int128, deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	old, _ := o.add128(addr.v.slot(), U128{uint64(deltaLo), uint64(deltaHi)}, false)
+	return int64(old.Lo), int64(old.Hi)
+}
+
+// FetchSubInt128 atomically subtracts (deltaLo, deltaHi) from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubInt128(addr *Int128, deltaLo, deltaHi int64) (oldLo, oldHi int64) {
+	old, _ := o.add128(addr.v.slot(), U128{uint64(deltaLo), uint64(deltaHi)}, true)
+	return int64(old.Lo), int64(old.Hi)
+}
+
+// FetchIncInt128 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncInt128(addr *Int128) (oldLo, oldHi int64) {
+	return o.FetchAddInt128(addr, 1, 0)
+}
+
+// FetchDecInt128 atomically subtracts 1 from *addr and returns the old value.
 // Unknown orderings fallback to AcqRel.
 //
 //go:nosplit
-func (o MemoryOrder) AddInt128(addr *Int128, deltaLo, deltaHi int64) (oldLo, oldHi int64) {
-	if o == Relaxed {
-		for {
-			ulo, uhi := arch.LoadUint128Relaxed(&addr.v)
-			newLo := ulo + uint64(deltaLo)
-			newHi := uhi + uint64(deltaHi)
-			if newLo < ulo { // Carry
-				newHi++
-			}
-			if arch.CasUint128Relaxed(&addr.v, ulo, uhi, newLo, newHi) {
-				return int64(ulo), int64(uhi)
-			}
+func (o MemoryOrder) FetchDecInt128(addr *Int128) (oldLo, oldHi int64) {
+	return o.FetchSubInt128(addr, 1, 0)
+}
+
+// AndInt128 atomically performs *addr &= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndInt128(addr *Int128, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.and128(addr.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// AndNotInt128 atomically performs *addr &^= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndNotInt128(addr *Int128, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.andNot128(addr.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// OrInt128 atomically performs *addr |= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrInt128(addr *Int128, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.or128(addr.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// XorInt128 atomically performs *addr ^= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorInt128(addr *Int128, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.xor128(addr.v.slot(), uint64(lo), uint64(hi))
+	return int64(ulo), int64(uhi)
+}
+
+// MaxInt128 atomically stores the maximum of *addr and (lo, hi) and returns the old value. Comparison is signed.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxInt128(addr *Int128, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.max128(addr.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// MinInt128 atomically stores the minimum of *addr and (lo, hi) and returns the old value. Comparison is signed.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinInt128(addr *Int128, lo, hi int64) (oldLo, oldHi int64) {
+	ulo, uhi := o.min128(addr.v.slot(), uint64(lo), uint64(hi), true)
+	return int64(ulo), int64(uhi)
+}
+
+// UpdateInt128 atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateInt128(addr *Int128, f func(old I128) I128) (old, new I128) {
+	var b backoff
+	lo, hi := o.load128(addr.v.slot())
+	old = I128{lo, int64(hi)}
+	for {
+		new = f(old)
+		lo, hi = o.cax128(addr.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi))
+		prev := I128{lo, int64(hi)}
+		if prev == old {
+			return old, new
 		}
+		old = prev
+		b.wait()
 	}
+}
+
+// TryUpdateInt128 is like UpdateInt128, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateInt128(addr *Int128, f func(old I128) (I128, bool)) (old, new I128, updated bool) {
+	var b backoff
+	lo, hi := o.load128(addr.v.slot())
+	old = I128{lo, int64(hi)}
 	for {
-		ulo, uhi := arch.LoadUint128Relaxed(&addr.v)
-		newLo := ulo + uint64(deltaLo)
-		newHi := uhi + uint64(deltaHi)
-		if newLo < ulo { // Carry
-			newHi++
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
 		}
-		if arch.CasUint128AcqRel(&addr.v, ulo, uhi, newLo, newHi) {
-			return int64(ulo), int64(uhi)
+		lo, hi = o.cax128(addr.v.slot(), old.Lo, uint64(old.Hi), new.Lo, uint64(new.Hi))
+		prev := I128{lo, int64(hi)}
+		if prev == old {
+			return old, new, true
 		}
+		old = prev
+		b.wait()
 	}
 }
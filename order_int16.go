@@ -0,0 +1,204 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// LoadInt16 atomically loads *addr with the specified memory ordering.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadInt16(addr *int16) int16 {
+	switch o {
+	case Relaxed:
+		return int16(arch.LoadUint16Relaxed((*uint16)(unsafe.Pointer(addr))))
+	case SeqCst:
+		return int16(arch.LoadUint16SeqCst((*uint16)(unsafe.Pointer(addr))))
+	default:
+		return int16(arch.LoadUint16Acquire((*uint16)(unsafe.Pointer(addr))))
+	}
+}
+
+// StoreInt16 atomically stores val to *addr with the specified memory ordering.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreInt16(addr *int16, val int16) {
+	switch o {
+	case Relaxed:
+		arch.StoreUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(val))
+	case SeqCst:
+		arch.StoreUint16SeqCst((*uint16)(unsafe.Pointer(addr)), uint16(val))
+	default:
+		arch.StoreUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(val))
+	}
+}
+
+// SwapInt16 atomically stores new to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapInt16(addr *int16, new int16) (old int16) {
+	switch o {
+	case Relaxed:
+		return int16(arch.SwapUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(new)))
+	case Acquire:
+		return int16(arch.SwapUint16Acquire((*uint16)(unsafe.Pointer(addr)), uint16(new)))
+	case Release:
+		return int16(arch.SwapUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(new)))
+	default:
+		return int16(arch.SwapUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(new)))
+	}
+}
+
+// CompareAndSwapInt16 atomically compares *addr with old and swaps if equal.
+// Returns true if the swap was performed.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapInt16(addr *int16, old, new int16) (swapped bool) {
+	switch o {
+	case Relaxed:
+		return arch.CasUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new))
+	case Acquire:
+		return arch.CasUint16Acquire((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new))
+	case Release:
+		return arch.CasUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new))
+	default:
+		return arch.CasUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new))
+	}
+}
+
+// CompareExchangeInt16 atomically compares *addr with old and swaps if equal.
+// Returns the previous value (enables CAS loops without separate Load).
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeInt16(addr *int16, old, new int16) (prev int16) {
+	switch o {
+	case Relaxed:
+		return int16(arch.CaxUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new)))
+	case Acquire:
+		return int16(arch.CaxUint16Acquire((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new)))
+	case Release:
+		return int16(arch.CaxUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new)))
+	default:
+		return int16(arch.CaxUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(new)))
+	}
+}
+
+// AddInt16 atomically adds delta to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddInt16(addr *int16, delta int16) (new int16) {
+	switch o {
+	case Relaxed:
+		return int16(arch.AddUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(delta)))
+	case Acquire:
+		return int16(arch.AddUint16Acquire((*uint16)(unsafe.Pointer(addr)), uint16(delta)))
+	case Release:
+		return int16(arch.AddUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(delta)))
+	default:
+		return int16(arch.AddUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(delta)))
+	}
+}
+
+// AndInt16 atomically performs *addr &= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndInt16(addr *int16, mask int16) (old int16) {
+	switch o {
+	case Relaxed:
+		return int16(arch.AndUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	case Acquire:
+		return int16(arch.AndUint16Acquire((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	case Release:
+		return int16(arch.AndUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	default:
+		return int16(arch.AndUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	}
+}
+
+// OrInt16 atomically performs *addr |= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrInt16(addr *int16, mask int16) (old int16) {
+	switch o {
+	case Relaxed:
+		return int16(arch.OrUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	case Acquire:
+		return int16(arch.OrUint16Acquire((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	case Release:
+		return int16(arch.OrUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	default:
+		return int16(arch.OrUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	}
+}
+
+// XorInt16 atomically performs *addr ^= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorInt16(addr *int16, mask int16) (old int16) {
+	switch o {
+	case Relaxed:
+		return int16(arch.XorUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	case Acquire:
+		return int16(arch.XorUint16Acquire((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	case Release:
+		return int16(arch.XorUint16Release((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	default:
+		return int16(arch.XorUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(mask)))
+	}
+}
+
+// MaxInt16 atomically stores max(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxInt16(addr *int16, val int16) (old int16) {
+	if o == Relaxed {
+		for {
+			old = int16(arch.LoadUint16Relaxed((*uint16)(unsafe.Pointer(addr))))
+			if old >= val || arch.CasUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(val)) {
+				return old
+			}
+		}
+	}
+	for {
+		old = int16(arch.LoadUint16Relaxed((*uint16)(unsafe.Pointer(addr))))
+		if old >= val || arch.CasUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
+
+// MinInt16 atomically stores min(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinInt16(addr *int16, val int16) (old int16) {
+	if o == Relaxed {
+		for {
+			old = int16(arch.LoadUint16Relaxed((*uint16)(unsafe.Pointer(addr))))
+			if old <= val || arch.CasUint16Relaxed((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(val)) {
+				return old
+			}
+		}
+	}
+	for {
+		old = int16(arch.LoadUint16Relaxed((*uint16)(unsafe.Pointer(addr))))
+		if old <= val || arch.CasUint16AcqRel((*uint16)(unsafe.Pointer(addr)), uint16(old), uint16(val)) {
+			return old
+		}
+	}
+}
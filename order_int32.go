@@ -11,10 +11,14 @@ import "code.hybscloud.com/atomix/internal/arch"
 //
 //go:nosplit
 func (o MemoryOrder) LoadInt32(addr *int32) int32 {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		return arch.LoadInt32Relaxed(addr)
+	case SeqCst:
+		return arch.LoadInt32SeqCst(addr)
+	default:
+		return arch.LoadInt32Acquire(addr)
 	}
-	return arch.LoadInt32Acquire(addr)
 }
 
 // StoreInt32 atomically stores val to *addr with the specified memory ordering.
@@ -22,11 +26,14 @@ func (o MemoryOrder) LoadInt32(addr *int32) int32 {
 //
 //go:nosplit
 func (o MemoryOrder) StoreInt32(addr *int32, val int32) {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		arch.StoreInt32Relaxed(addr, val)
-		return
+	case SeqCst:
+		arch.StoreInt32SeqCst(addr, val)
+	default:
+		arch.StoreInt32Release(addr, val)
 	}
-	arch.StoreInt32Release(addr, val)
 }
 
 // SwapInt32 atomically stores new to *addr and returns the old value.
@@ -191,3 +198,41 @@ func (o MemoryOrder) MinInt32(addr *int32, val int32) (old int32) {
 		}
 	}
 }
+
+// UpdateInt32 atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateInt32(addr *int32, f func(old int32) int32) (old, new int32) {
+	var b backoff
+	old = o.LoadInt32(addr)
+	for {
+		new = f(old)
+		prev := o.CompareExchangeInt32(addr, old, new)
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateInt32 is like UpdateInt32, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateInt32(addr *int32, f func(old int32) (int32, bool)) (old, new int32, updated bool) {
+	var b backoff
+	old = o.LoadInt32(addr)
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := o.CompareExchangeInt32(addr, old, new)
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
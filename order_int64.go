@@ -11,10 +11,14 @@ import "code.hybscloud.com/atomix/internal/arch"
 //
 //go:nosplit
 func (o MemoryOrder) LoadInt64(addr *int64) int64 {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		return arch.LoadInt64Relaxed(addr)
+	case SeqCst:
+		return arch.LoadInt64SeqCst(addr)
+	default:
+		return arch.LoadInt64Acquire(addr)
 	}
-	return arch.LoadInt64Acquire(addr)
 }
 
 // StoreInt64 atomically stores val to *addr with the specified memory ordering.
@@ -22,11 +26,14 @@ func (o MemoryOrder) LoadInt64(addr *int64) int64 {
 //
 //go:nosplit
 func (o MemoryOrder) StoreInt64(addr *int64, val int64) {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		arch.StoreInt64Relaxed(addr, val)
-		return
+	case SeqCst:
+		arch.StoreInt64SeqCst(addr, val)
+	default:
+		arch.StoreInt64Release(addr, val)
 	}
-	arch.StoreInt64Release(addr, val)
 }
 
 // SwapInt64 atomically stores new to *addr and returns the old value.
@@ -191,3 +198,41 @@ func (o MemoryOrder) MinInt64(addr *int64, val int64) (old int64) {
 		}
 	}
 }
+
+// UpdateInt64 atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateInt64(addr *int64, f func(old int64) int64) (old, new int64) {
+	var b backoff
+	old = o.LoadInt64(addr)
+	for {
+		new = f(old)
+		prev := o.CompareExchangeInt64(addr, old, new)
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateInt64 is like UpdateInt64, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateInt64(addr *int64, f func(old int64) (int64, bool)) (old, new int64, updated bool) {
+	var b backoff
+	old = o.LoadInt64(addr)
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := o.CompareExchangeInt64(addr, old, new)
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
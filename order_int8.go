@@ -0,0 +1,204 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// LoadInt8 atomically loads *addr with the specified memory ordering.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadInt8(addr *int8) int8 {
+	switch o {
+	case Relaxed:
+		return int8(arch.LoadUint8Relaxed((*uint8)(unsafe.Pointer(addr))))
+	case SeqCst:
+		return int8(arch.LoadUint8SeqCst((*uint8)(unsafe.Pointer(addr))))
+	default:
+		return int8(arch.LoadUint8Acquire((*uint8)(unsafe.Pointer(addr))))
+	}
+}
+
+// StoreInt8 atomically stores val to *addr with the specified memory ordering.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreInt8(addr *int8, val int8) {
+	switch o {
+	case Relaxed:
+		arch.StoreUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(val))
+	case SeqCst:
+		arch.StoreUint8SeqCst((*uint8)(unsafe.Pointer(addr)), uint8(val))
+	default:
+		arch.StoreUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(val))
+	}
+}
+
+// SwapInt8 atomically stores new to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapInt8(addr *int8, new int8) (old int8) {
+	switch o {
+	case Relaxed:
+		return int8(arch.SwapUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(new)))
+	case Acquire:
+		return int8(arch.SwapUint8Acquire((*uint8)(unsafe.Pointer(addr)), uint8(new)))
+	case Release:
+		return int8(arch.SwapUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(new)))
+	default:
+		return int8(arch.SwapUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(new)))
+	}
+}
+
+// CompareAndSwapInt8 atomically compares *addr with old and swaps if equal.
+// Returns true if the swap was performed.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapInt8(addr *int8, old, new int8) (swapped bool) {
+	switch o {
+	case Relaxed:
+		return arch.CasUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new))
+	case Acquire:
+		return arch.CasUint8Acquire((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new))
+	case Release:
+		return arch.CasUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new))
+	default:
+		return arch.CasUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new))
+	}
+}
+
+// CompareExchangeInt8 atomically compares *addr with old and swaps if equal.
+// Returns the previous value (enables CAS loops without separate Load).
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeInt8(addr *int8, old, new int8) (prev int8) {
+	switch o {
+	case Relaxed:
+		return int8(arch.CaxUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new)))
+	case Acquire:
+		return int8(arch.CaxUint8Acquire((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new)))
+	case Release:
+		return int8(arch.CaxUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new)))
+	default:
+		return int8(arch.CaxUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(new)))
+	}
+}
+
+// AddInt8 atomically adds delta to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddInt8(addr *int8, delta int8) (new int8) {
+	switch o {
+	case Relaxed:
+		return int8(arch.AddUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(delta)))
+	case Acquire:
+		return int8(arch.AddUint8Acquire((*uint8)(unsafe.Pointer(addr)), uint8(delta)))
+	case Release:
+		return int8(arch.AddUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(delta)))
+	default:
+		return int8(arch.AddUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(delta)))
+	}
+}
+
+// AndInt8 atomically performs *addr &= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndInt8(addr *int8, mask int8) (old int8) {
+	switch o {
+	case Relaxed:
+		return int8(arch.AndUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	case Acquire:
+		return int8(arch.AndUint8Acquire((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	case Release:
+		return int8(arch.AndUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	default:
+		return int8(arch.AndUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	}
+}
+
+// OrInt8 atomically performs *addr |= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrInt8(addr *int8, mask int8) (old int8) {
+	switch o {
+	case Relaxed:
+		return int8(arch.OrUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	case Acquire:
+		return int8(arch.OrUint8Acquire((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	case Release:
+		return int8(arch.OrUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	default:
+		return int8(arch.OrUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	}
+}
+
+// XorInt8 atomically performs *addr ^= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorInt8(addr *int8, mask int8) (old int8) {
+	switch o {
+	case Relaxed:
+		return int8(arch.XorUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	case Acquire:
+		return int8(arch.XorUint8Acquire((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	case Release:
+		return int8(arch.XorUint8Release((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	default:
+		return int8(arch.XorUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(mask)))
+	}
+}
+
+// MaxInt8 atomically stores max(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxInt8(addr *int8, val int8) (old int8) {
+	if o == Relaxed {
+		for {
+			old = int8(arch.LoadUint8Relaxed((*uint8)(unsafe.Pointer(addr))))
+			if old >= val || arch.CasUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(val)) {
+				return old
+			}
+		}
+	}
+	for {
+		old = int8(arch.LoadUint8Relaxed((*uint8)(unsafe.Pointer(addr))))
+		if old >= val || arch.CasUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
+
+// MinInt8 atomically stores min(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinInt8(addr *int8, val int8) (old int8) {
+	if o == Relaxed {
+		for {
+			old = int8(arch.LoadUint8Relaxed((*uint8)(unsafe.Pointer(addr))))
+			if old <= val || arch.CasUint8Relaxed((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(val)) {
+				return old
+			}
+		}
+	}
+	for {
+		old = int8(arch.LoadUint8Relaxed((*uint8)(unsafe.Pointer(addr))))
+		if old <= val || arch.CasUint8AcqRel((*uint8)(unsafe.Pointer(addr)), uint8(old), uint8(val)) {
+			return old
+		}
+	}
+}
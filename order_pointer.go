@@ -15,10 +15,14 @@ import (
 //
 //go:nosplit
 func (o MemoryOrder) LoadPointer(addr *unsafe.Pointer) unsafe.Pointer {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		return arch.LoadPointerRelaxed(addr)
+	case SeqCst:
+		return arch.LoadPointerSeqCst(addr)
+	default:
+		return arch.LoadPointerAcquire(addr)
 	}
-	return arch.LoadPointerAcquire(addr)
 }
 
 // StorePointer atomically stores val to *addr with the specified memory ordering.
@@ -26,11 +30,14 @@ func (o MemoryOrder) LoadPointer(addr *unsafe.Pointer) unsafe.Pointer {
 //
 //go:nosplit
 func (o MemoryOrder) StorePointer(addr *unsafe.Pointer, val unsafe.Pointer) {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		arch.StorePointerRelaxed(addr, val)
-		return
+	case SeqCst:
+		arch.StorePointerSeqCst(addr, val)
+	default:
+		arch.StorePointerRelease(addr, val)
 	}
-	arch.StorePointerRelease(addr, val)
 }
 
 // SwapPointer atomically stores new to *addr and returns the old value.
@@ -85,3 +92,41 @@ func (o MemoryOrder) CompareExchangePointer(addr *unsafe.Pointer, old, new unsaf
 		return arch.CaxPointerAcqRel(addr, old, new)
 	}
 }
+
+// UpdatePointer atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdatePointer(addr *unsafe.Pointer, f func(old unsafe.Pointer) unsafe.Pointer) (old, new unsafe.Pointer) {
+	var b backoff
+	old = o.LoadPointer(addr)
+	for {
+		new = f(old)
+		prev := o.CompareExchangePointer(addr, old, new)
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdatePointer is like UpdatePointer, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdatePointer(addr *unsafe.Pointer, f func(old unsafe.Pointer) (unsafe.Pointer, bool)) (old, new unsafe.Pointer, updated bool) {
+	var b backoff
+	old = o.LoadPointer(addr)
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := o.CompareExchangePointer(addr, old, new)
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
@@ -279,14 +279,19 @@ func TestMemoryOrderUint128Add(t *testing.T) {
 
 	atomix.Relaxed.StoreUint128(v, 0, 0)
 
-	oldLo, oldHi := atomix.Relaxed.AddUint128(v, 1, 0)
-	if oldLo != 0 || oldHi != 0 {
-		t.Fatalf("AddUint128: got old=(%d, %d), want (0, 0)", oldLo, oldHi)
+	newLo, newHi := atomix.Relaxed.AddUint128(v, 1, 0)
+	if newLo != 1 || newHi != 0 {
+		t.Fatalf("AddUint128: got new=(%d, %d), want (1, 0)", newLo, newHi)
+	}
+
+	oldLo, oldHi := atomix.Relaxed.FetchAddUint128(v, 1, 0)
+	if oldLo != 1 || oldHi != 0 {
+		t.Fatalf("FetchAddUint128: got old=(%d, %d), want (1, 0)", oldLo, oldHi)
 	}
 
 	lo, hi := atomix.Relaxed.LoadUint128(v)
-	if lo != 1 || hi != 0 {
-		t.Fatalf("LoadUint128: got (%d, %d), want (1, 0)", lo, hi)
+	if lo != 2 || hi != 0 {
+		t.Fatalf("LoadUint128: got (%d, %d), want (2, 0)", lo, hi)
 	}
 }
 
@@ -753,26 +758,26 @@ func TestMemoryOrderAdd128Carry(t *testing.T) {
 	atomix.Relaxed.StoreUint128(vu, ^uint64(0), 0) // lo = max, hi = 0
 
 	// Adding 1 should carry to hi
-	oldLo, oldHi := atomix.Relaxed.AddUint128(vu, 1, 0)
+	oldLo, oldHi := atomix.Relaxed.FetchAddUint128(vu, 1, 0)
 	if oldLo != ^uint64(0) || oldHi != 0 {
-		t.Fatalf("AddUint128 old: got (%d, %d), want (max, 0)", oldLo, oldHi)
+		t.Fatalf("FetchAddUint128 old: got (%d, %d), want (max, 0)", oldLo, oldHi)
 	}
 
 	lo, hi := atomix.Relaxed.LoadUint128(vu)
 	if lo != 0 || hi != 1 {
-		t.Fatalf("AddUint128 carry: got (%d, %d), want (0, 1)", lo, hi)
+		t.Fatalf("FetchAddUint128 carry: got (%d, %d), want (0, 1)", lo, hi)
 	}
 
 	// Test with AcqRel ordering
 	atomix.Release.StoreUint128(vu, ^uint64(0), 0)
-	oldLo, oldHi = atomix.AcqRel.AddUint128(vu, 1, 0)
+	oldLo, oldHi = atomix.AcqRel.FetchAddUint128(vu, 1, 0)
 	if oldLo != ^uint64(0) || oldHi != 0 {
-		t.Fatalf("AddUint128 AcqRel old: got (%d, %d)", oldLo, oldHi)
+		t.Fatalf("FetchAddUint128 AcqRel old: got (%d, %d)", oldLo, oldHi)
 	}
 
 	lo, hi = atomix.Acquire.LoadUint128(vu)
 	if lo != 0 || hi != 1 {
-		t.Fatalf("AddUint128 AcqRel carry: got (%d, %d), want (0, 1)", lo, hi)
+		t.Fatalf("FetchAddUint128 AcqRel carry: got (%d, %d), want (0, 1)", lo, hi)
 	}
 
 	// Test Int128 carry
@@ -780,22 +785,22 @@ func TestMemoryOrderAdd128Carry(t *testing.T) {
 	_, vi := atomix.PlaceAlignedInt128(buf2, 0)
 	atomix.Relaxed.StoreInt128(vi, -1, 0) // lo = -1 (all bits set), hi = 0
 
-	oldLoI, oldHiI := atomix.Relaxed.AddInt128(vi, 1, 0)
+	oldLoI, oldHiI := atomix.Relaxed.FetchAddInt128(vi, 1, 0)
 	if oldLoI != -1 || oldHiI != 0 {
-		t.Fatalf("AddInt128 old: got (%d, %d)", oldLoI, oldHiI)
+		t.Fatalf("FetchAddInt128 old: got (%d, %d)", oldLoI, oldHiI)
 	}
 
 	loI, hiI := atomix.Relaxed.LoadInt128(vi)
 	if loI != 0 || hiI != 1 {
-		t.Fatalf("AddInt128 carry: got (%d, %d), want (0, 1)", loI, hiI)
+		t.Fatalf("FetchAddInt128 carry: got (%d, %d), want (0, 1)", loI, hiI)
 	}
 
 	// Test with AcqRel
 	atomix.Release.StoreInt128(vi, -1, 0)
-	atomix.AcqRel.AddInt128(vi, 1, 0)
+	atomix.AcqRel.FetchAddInt128(vi, 1, 0)
 	loI, hiI = atomix.Acquire.LoadInt128(vi)
 	if loI != 0 || hiI != 1 {
-		t.Fatalf("AddInt128 AcqRel carry: got (%d, %d)", loI, hiI)
+		t.Fatalf("FetchAddInt128 AcqRel carry: got (%d, %d)", loI, hiI)
 	}
 }
 
@@ -1250,3 +1255,105 @@ func TestMemoryOrderXorAllOrderings(t *testing.T) {
 		t.Fatalf("XorUintptr Release: got old=0x%X, v=0x%X, want 0xF0, 0xFF", oldptr, uptr)
 	}
 }
+
+// aligned16 returns a 16-byte aligned slot inside buf, as in a mmap'd ring.
+func aligned16(buf []byte) unsafe.Pointer {
+	off := (16 - uintptr(unsafe.Pointer(&buf[0]))&15) & 15
+	return unsafe.Pointer(&buf[off])
+}
+
+// Test the raw-memory 128-bit API against its alignment contract
+func TestMemoryOrderUint128At(t *testing.T) {
+	orders := []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel, atomix.SeqCst}
+	for _, o := range orders {
+		p := aligned16(make([]byte, 48))
+		o.StoreUint128At(p, ^uint64(0), 0)
+		if lo, hi := o.IncUint128At(p); lo != 0 || hi != 1 {
+			t.Fatalf("%v IncUint128At: (%#x, %#x)", o, lo, hi)
+		}
+		if lo, hi := o.FetchDecUint128At(p); lo != 0 || hi != 1 {
+			t.Fatalf("%v FetchDecUint128At old: (%#x, %#x)", o, lo, hi)
+		}
+		if lo, hi := o.SubUint128At(p, 0, 0); lo != ^uint64(0) || hi != 0 {
+			t.Fatalf("%v SubUint128At: (%#x, %#x)", o, lo, hi)
+		}
+		if !o.CompareAndSwapUint128At(p, ^uint64(0), 0, 5, 7) {
+			t.Fatalf("%v CompareAndSwapUint128At failed", o)
+		}
+		if lo, hi := o.CompareExchangeUint128At(p, 0, 0, 1, 1); lo != 5 || hi != 7 {
+			t.Fatalf("%v CompareExchangeUint128At prev: (%d, %d)", o, lo, hi)
+		}
+		if lo, hi := o.MaxUint128At(p, 0, 8); lo != 5 || hi != 7 {
+			t.Fatalf("%v MaxUint128At old: (%d, %d)", o, lo, hi)
+		}
+		if lo, hi := o.MinUint128At(p, 9, 7); lo != 0 || hi != 8 {
+			t.Fatalf("%v MinUint128At old: (%d, %d)", o, lo, hi)
+		}
+		o.OrUint128At(p, 0xF0, 0)
+		o.AndNotUint128At(p, 0x0F, 0)
+		o.XorUint128At(p, 0, 7)
+		if lo, hi := o.AndUint128At(p, ^uint64(0), ^uint64(0)); lo != 0xF0 || hi != 0 {
+			t.Fatalf("%v bitwise Uint128At: (%#x, %#x)", o, lo, hi)
+		}
+		if lo, hi := o.SwapUint128At(p, 1, 2); lo != 0xF0 || hi != 0 {
+			t.Fatalf("%v SwapUint128At old: (%#x, %#x)", o, lo, hi)
+		}
+		old, new := o.UpdateUint128At(p, func(v atomix.U128) atomix.U128 { return atomix.U128{Lo: v.Hi, Hi: v.Lo} })
+		if old != (atomix.U128{Lo: 1, Hi: 2}) || new != (atomix.U128{Lo: 2, Hi: 1}) {
+			t.Fatalf("%v UpdateUint128At: %v -> %v", o, old, new)
+		}
+		if _, _, ok := o.TryUpdateUint128At(p, func(v atomix.U128) (atomix.U128, bool) { return v, false }); ok {
+			t.Fatalf("%v TryUpdateUint128At applied a declined update", o)
+		}
+		if lo, hi := o.LoadUint128At(p); lo != 2 || hi != 1 {
+			t.Fatalf("%v LoadUint128At: (%d, %d)", o, lo, hi)
+		}
+	}
+}
+
+func TestMemoryOrderInt128At(t *testing.T) {
+	p := aligned16(make([]byte, 48))
+	atomix.Release.StoreInt128At(p, 0, 0)
+	if lo, hi := atomix.AcqRel.DecInt128At(p); lo != -1 || hi != -1 {
+		t.Fatalf("DecInt128At: (%d, %d)", lo, hi)
+	}
+	if lo, hi := atomix.Acquire.MaxInt128At(p, 0, 0); lo != -1 || hi != -1 {
+		t.Fatalf("MaxInt128At old: (%d, %d)", lo, hi)
+	}
+	if lo, hi := atomix.Relaxed.MinInt128At(p, -2, -1); lo != 0 || hi != 0 {
+		t.Fatalf("MinInt128At old: (%d, %d), want (0, 0) after signed Max", lo, hi)
+	}
+	if lo, hi := atomix.SeqCst.FetchAddInt128At(p, 3, 0); lo != -2 || hi != -1 {
+		t.Fatalf("FetchAddInt128At old: (%d, %d)", lo, hi)
+	}
+	if lo, hi := atomix.Acquire.LoadInt128At(p); lo != 1 || hi != 0 {
+		t.Fatalf("LoadInt128At: (%d, %d)", lo, hi)
+	}
+
+	// The raw slot and a typed Int128 placed on the same bytes agree.
+	buf := make([]byte, 64)
+	_, typed := atomix.PlaceAlignedInt128(buf, 0)
+	typed.Store(-5, -1)
+	if lo, hi := atomix.Acquire.LoadInt128At(unsafe.Pointer(typed)); lo != -5 || hi != -1 {
+		t.Fatalf("LoadInt128At on a placed Int128: (%d, %d)", lo, hi)
+	}
+}
+
+func TestMemoryOrderUint128AtMisaligned(t *testing.T) {
+	p := unsafe.Add(aligned16(make([]byte, 48)), 8)
+	for name, f := range map[string]func(){
+		"LoadUint128At":  func() { atomix.Acquire.LoadUint128At(p) },
+		"StoreInt128At":  func() { atomix.Release.StoreInt128At(p, 1, 1) },
+		"AddUint128At":   func() { atomix.AcqRel.AddUint128At(p, 1, 0) },
+		"UpdateInt128At": func() { atomix.AcqRel.UpdateInt128At(p, func(v atomix.I128) atomix.I128 { return v }) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s on a misaligned address did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -8,114 +8,389 @@ import "code.hybscloud.com/atomix/internal/arch"
 
 // LoadUint128 atomically loads *addr with the specified memory ordering.
 // Returns (lo, hi) where the full value is (hi << 64) | lo.
-// addr MUST be 16-byte aligned; use PlaceAlignedUint128 to ensure alignment.
 // Unknown orderings fallback to Acquire.
 //
 //go:nosplit
 func (o MemoryOrder) LoadUint128(addr *Uint128) (lo, hi uint64) {
-	if o == Relaxed {
-		return arch.LoadUint128Relaxed(&addr.v)
-	}
-	return arch.LoadUint128Acquire(&addr.v)
+	return o.load128(addr.v.slot())
 }
 
 // StoreUint128 atomically stores (lo, hi) to *addr with the specified memory ordering.
-// addr MUST be 16-byte aligned; use PlaceAlignedUint128 to ensure alignment.
 // Unknown orderings fallback to Release.
 //
 //go:nosplit
 func (o MemoryOrder) StoreUint128(addr *Uint128, lo, hi uint64) {
-	if o == Relaxed {
-		arch.StoreUint128Relaxed(&addr.v, lo, hi)
-		return
-	}
-	arch.StoreUint128Release(&addr.v, lo, hi)
+	o.store128(addr.v.slot(), lo, hi)
 }
 
 // SwapUint128 atomically stores (newLo, newHi) to *addr and returns the old value.
-// addr MUST be 16-byte aligned; use PlaceAlignedUint128 to ensure alignment.
 // Unknown orderings fallback to AcqRel.
 //
 //go:nosplit
 func (o MemoryOrder) SwapUint128(addr *Uint128, newLo, newHi uint64) (oldLo, oldHi uint64) {
+	return o.swap128(addr.v.slot(), newLo, newHi)
+}
+
+// CompareAndSwapUint128 atomically compares *addr with (oldLo, oldHi) and swaps if equal.
+// Returns true if the swap was performed.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapUint128(addr *Uint128, oldLo, oldHi, newLo, newHi uint64) (swapped bool) {
+	return o.cas128(addr.v.slot(), oldLo, oldHi, newLo, newHi)
+}
+
+// CompareExchangeUint128 atomically compares *addr with (oldLo, oldHi) and swaps if equal.
+// Returns the previous value (enables CAS loops without separate Load).
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeUint128(addr *Uint128, oldLo, oldHi, newLo, newHi uint64) (prevLo, prevHi uint64) {
+	return o.cax128(addr.v.slot(), oldLo, oldHi, newLo, newHi)
+}
+
+// AddUint128 atomically adds (deltaLo, deltaHi) to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddUint128(addr *Uint128, deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	_, new := o.add128(addr.v.slot(), U128{deltaLo, deltaHi}, false)
+	return new.Lo, new.Hi
+}
+
+// SubUint128 atomically subtracts (deltaLo, deltaHi) from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SubUint128(addr *Uint128, deltaLo, deltaHi uint64) (newLo, newHi uint64) {
+	_, new := o.add128(addr.v.slot(), U128{deltaLo, deltaHi}, true)
+	return new.Lo, new.Hi
+}
+
+// IncUint128 atomically adds 1 to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) IncUint128(addr *Uint128) (newLo, newHi uint64) {
+	return o.AddUint128(addr, 1, 0)
+}
+
+// DecUint128 atomically subtracts 1 from *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) DecUint128(addr *Uint128) (newLo, newHi uint64) {
+	return o.SubUint128(addr, 1, 0)
+}
+
+// FetchAddUint128 atomically adds (deltaLo, deltaHi) to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchAddUint128(addr *Uint128, deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	old, _ := o.add128(addr.v.slot(), U128{deltaLo, deltaHi}, false)
+	return old.Lo, old.Hi
+}
+
+// FetchSubUint128 atomically subtracts (deltaLo, deltaHi) from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchSubUint128(addr *Uint128, deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
+	old, _ := o.add128(addr.v.slot(), U128{deltaLo, deltaHi}, true)
+	return old.Lo, old.Hi
+}
+
+// FetchIncUint128 atomically adds 1 to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchIncUint128(addr *Uint128) (oldLo, oldHi uint64) {
+	return o.FetchAddUint128(addr, 1, 0)
+}
+
+// FetchDecUint128 atomically subtracts 1 from *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) FetchDecUint128(addr *Uint128) (oldLo, oldHi uint64) {
+	return o.FetchSubUint128(addr, 1, 0)
+}
+
+// AndUint128 atomically performs *addr &= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndUint128(addr *Uint128, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.and128(addr.v.slot(), lo, hi)
+}
+
+// AndNotUint128 atomically performs *addr &^= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndNotUint128(addr *Uint128, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.andNot128(addr.v.slot(), lo, hi)
+}
+
+// OrUint128 atomically performs *addr |= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrUint128(addr *Uint128, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.or128(addr.v.slot(), lo, hi)
+}
+
+// XorUint128 atomically performs *addr ^= (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorUint128(addr *Uint128, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.xor128(addr.v.slot(), lo, hi)
+}
+
+// MaxUint128 atomically stores the maximum of *addr and (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxUint128(addr *Uint128, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.max128(addr.v.slot(), lo, hi, false)
+}
+
+// MinUint128 atomically stores the minimum of *addr and (lo, hi) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinUint128(addr *Uint128, lo, hi uint64) (oldLo, oldHi uint64) {
+	return o.min128(addr.v.slot(), lo, hi, false)
+}
+
+// load128 dispatches a 128-bit load on raw 16-byte aligned storage.
+//
+//go:nosplit
+func (o MemoryOrder) load128(p *[16]byte) (lo, hi uint64) {
+	switch o {
+	case Relaxed:
+		return arch.LoadUint128Relaxed(p)
+	case SeqCst:
+		return arch.LoadUint128SeqCst(p)
+	default:
+		return arch.LoadUint128Acquire(p)
+	}
+}
+
+// store128 dispatches a 128-bit store on raw 16-byte aligned storage.
+//
+//go:nosplit
+func (o MemoryOrder) store128(p *[16]byte, lo, hi uint64) {
 	switch o {
 	case Relaxed:
-		return arch.SwapUint128Relaxed(&addr.v, newLo, newHi)
+		arch.StoreUint128Relaxed(p, lo, hi)
+	case SeqCst:
+		arch.StoreUint128SeqCst(p, lo, hi)
+	default:
+		arch.StoreUint128Release(p, lo, hi)
+	}
+}
+
+// swap128 dispatches a 128-bit swap on raw 16-byte aligned storage.
+//
+//go:nosplit
+func (o MemoryOrder) swap128(p *[16]byte, newLo, newHi uint64) (oldLo, oldHi uint64) {
+	switch o {
+	case Relaxed:
+		return arch.SwapUint128Relaxed(p, newLo, newHi)
 	case Acquire:
-		return arch.SwapUint128Acquire(&addr.v, newLo, newHi)
+		return arch.SwapUint128Acquire(p, newLo, newHi)
 	case Release:
-		return arch.SwapUint128Release(&addr.v, newLo, newHi)
+		return arch.SwapUint128Release(p, newLo, newHi)
 	default:
-		return arch.SwapUint128AcqRel(&addr.v, newLo, newHi)
+		return arch.SwapUint128AcqRel(p, newLo, newHi)
 	}
 }
 
-// CompareAndSwapUint128 atomically compares *addr with (oldLo, oldHi) and swaps if equal.
-// Returns true if the swap was performed.
-// addr MUST be 16-byte aligned; use PlaceAlignedUint128 to ensure alignment.
-// Unknown orderings fallback to AcqRel.
+// cas128 dispatches a 128-bit compare-and-swap on raw 16-byte aligned storage.
 //
 //go:nosplit
-func (o MemoryOrder) CompareAndSwapUint128(addr *Uint128, oldLo, oldHi, newLo, newHi uint64) (swapped bool) {
+func (o MemoryOrder) cas128(p *[16]byte, oldLo, oldHi, newLo, newHi uint64) bool {
 	switch o {
 	case Relaxed:
-		return arch.CasUint128Relaxed(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CasUint128Relaxed(p, oldLo, oldHi, newLo, newHi)
 	case Acquire:
-		return arch.CasUint128Acquire(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CasUint128Acquire(p, oldLo, oldHi, newLo, newHi)
 	case Release:
-		return arch.CasUint128Release(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CasUint128Release(p, oldLo, oldHi, newLo, newHi)
 	default:
-		return arch.CasUint128AcqRel(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CasUint128AcqRel(p, oldLo, oldHi, newLo, newHi)
 	}
 }
 
-// CompareExchangeUint128 atomically compares *addr with (oldLo, oldHi) and swaps if equal.
-// Returns the previous value (enables CAS loops without separate Load).
-// addr MUST be 16-byte aligned; use PlaceAlignedUint128 to ensure alignment.
-// Unknown orderings fallback to AcqRel.
+// cax128 dispatches a 128-bit compare-exchange on raw 16-byte aligned storage.
 //
 //go:nosplit
-func (o MemoryOrder) CompareExchangeUint128(addr *Uint128, oldLo, oldHi, newLo, newHi uint64) (prevLo, prevHi uint64) {
+func (o MemoryOrder) cax128(p *[16]byte, oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
 	switch o {
 	case Relaxed:
-		return arch.CaxUint128Relaxed(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CaxUint128Relaxed(p, oldLo, oldHi, newLo, newHi)
 	case Acquire:
-		return arch.CaxUint128Acquire(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CaxUint128Acquire(p, oldLo, oldHi, newLo, newHi)
 	case Release:
-		return arch.CaxUint128Release(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CaxUint128Release(p, oldLo, oldHi, newLo, newHi)
 	default:
-		return arch.CaxUint128AcqRel(&addr.v, oldLo, oldHi, newLo, newHi)
+		return arch.CaxUint128AcqRel(p, oldLo, oldHi, newLo, newHi)
 	}
 }
 
-// AddUint128 atomically adds (deltaLo, deltaHi) to *addr and returns the old value.
-// addr MUST be 16-byte aligned; use PlaceAlignedUint128 to ensure alignment.
-// Unknown orderings fallback to AcqRel.
+// and128 dispatches a 128-bit bitwise AND on raw 16-byte aligned storage.
+//
+//go:nosplit
+func (o MemoryOrder) and128(p *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	switch o {
+	case Relaxed:
+		return arch.AndUint128Relaxed(p, lo, hi)
+	case Acquire:
+		return arch.AndUint128Acquire(p, lo, hi)
+	case Release:
+		return arch.AndUint128Release(p, lo, hi)
+	default:
+		return arch.AndUint128AcqRel(p, lo, hi)
+	}
+}
+
+// andNot128 dispatches a 128-bit bitwise AND NOT on raw 16-byte aligned storage.
+//
+//go:nosplit
+func (o MemoryOrder) andNot128(p *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	switch o {
+	case Relaxed:
+		return arch.AndNotUint128Relaxed(p, lo, hi)
+	case Acquire:
+		return arch.AndNotUint128Acquire(p, lo, hi)
+	case Release:
+		return arch.AndNotUint128Release(p, lo, hi)
+	default:
+		return arch.AndNotUint128AcqRel(p, lo, hi)
+	}
+}
+
+// or128 dispatches a 128-bit bitwise OR on raw 16-byte aligned storage.
 //
 //go:nosplit
-func (o MemoryOrder) AddUint128(addr *Uint128, deltaLo, deltaHi uint64) (oldLo, oldHi uint64) {
-	if o == Relaxed {
-		for {
-			ulo, uhi := arch.LoadUint128Relaxed(&addr.v)
-			newLo := ulo + deltaLo
-			newHi := uhi + deltaHi
-			if newLo < ulo { // Carry
-				newHi++
-			}
-			if arch.CasUint128Relaxed(&addr.v, ulo, uhi, newLo, newHi) {
-				return ulo, uhi
-			}
+func (o MemoryOrder) or128(p *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	switch o {
+	case Relaxed:
+		return arch.OrUint128Relaxed(p, lo, hi)
+	case Acquire:
+		return arch.OrUint128Acquire(p, lo, hi)
+	case Release:
+		return arch.OrUint128Release(p, lo, hi)
+	default:
+		return arch.OrUint128AcqRel(p, lo, hi)
+	}
+}
+
+// add128 adds delta to *p, or subtracts it if sub is set, as a CAS loop on
+// raw 16-byte aligned storage and returns both the old and the new value.
+//
+//go:nosplit
+func (o MemoryOrder) add128(p *[16]byte, delta U128, sub bool) (old, new U128) {
+	for {
+		old.Lo, old.Hi = arch.LoadUint128Relaxed(p)
+		if sub {
+			new = old.Sub(delta)
+		} else {
+			new = old.Add(delta)
+		}
+		if o.cas128(p, old.Lo, old.Hi, new.Lo, new.Hi) {
+			return old, new
 		}
 	}
+}
+
+// xor128 runs a 128-bit bitwise XOR as a CAS loop on raw 16-byte aligned storage.
+//
+//go:nosplit
+func (o MemoryOrder) xor128(p *[16]byte, lo, hi uint64) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = arch.LoadUint128Relaxed(p)
+		if o.cas128(p, oldLo, oldHi, oldLo^lo, oldHi^hi) {
+			return
+		}
+	}
+}
+
+// max128 stores the maximum of *p and (lo, hi) as a CAS loop on raw 16-byte
+// aligned storage, comparing as two's complement if signed is set.
+//
+//go:nosplit
+func (o MemoryOrder) max128(p *[16]byte, lo, hi uint64, signed bool) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = o.load128(p)
+		if cmp128(oldLo, oldHi, lo, hi, signed) >= 0 || o.cas128(p, oldLo, oldHi, lo, hi) {
+			return
+		}
+	}
+}
+
+// min128 stores the minimum of *p and (lo, hi) as a CAS loop on raw 16-byte
+// aligned storage, comparing as two's complement if signed is set.
+//
+//go:nosplit
+func (o MemoryOrder) min128(p *[16]byte, lo, hi uint64, signed bool) (oldLo, oldHi uint64) {
+	for {
+		oldLo, oldHi = o.load128(p)
+		if cmp128(oldLo, oldHi, lo, hi, signed) <= 0 || o.cas128(p, oldLo, oldHi, lo, hi) {
+			return
+		}
+	}
+}
+
+// cmp128 compares two 128-bit values and returns -1, 0, or +1.
+func cmp128(xLo, xHi, yLo, yHi uint64, signed bool) int {
+	if signed {
+		return I128{xLo, int64(xHi)}.Cmp(I128{yLo, int64(yHi)})
+	}
+	return U128{xLo, xHi}.Cmp(U128{yLo, yHi})
+}
+
+// UpdateUint128 atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateUint128(addr *Uint128, f func(old U128) U128) (old, new U128) {
+	var b backoff
+	lo, hi := o.load128(addr.v.slot())
+	old = U128{lo, hi}
+	for {
+		new = f(old)
+		lo, hi = o.cax128(addr.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi)
+		prev := U128{lo, hi}
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateUint128 is like UpdateUint128, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateUint128(addr *Uint128, f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	var b backoff
+	lo, hi := o.load128(addr.v.slot())
+	old = U128{lo, hi}
 	for {
-		ulo, uhi := arch.LoadUint128Relaxed(&addr.v)
-		newLo := ulo + deltaLo
-		newHi := uhi + deltaHi
-		if newLo < ulo { // Carry
-			newHi++
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
 		}
-		if arch.CasUint128AcqRel(&addr.v, ulo, uhi, newLo, newHi) {
-			return ulo, uhi
+		lo, hi = o.cax128(addr.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi)
+		prev := U128{lo, hi}
+		if prev == old {
+			return old, new, true
 		}
+		old = prev
+		b.wait()
 	}
 }
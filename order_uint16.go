@@ -0,0 +1,200 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// LoadUint16 atomically loads *addr with the specified memory ordering.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadUint16(addr *uint16) uint16 {
+	switch o {
+	case Relaxed:
+		return arch.LoadUint16Relaxed(addr)
+	case SeqCst:
+		return arch.LoadUint16SeqCst(addr)
+	default:
+		return arch.LoadUint16Acquire(addr)
+	}
+}
+
+// StoreUint16 atomically stores val to *addr with the specified memory ordering.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreUint16(addr *uint16, val uint16) {
+	switch o {
+	case Relaxed:
+		arch.StoreUint16Relaxed(addr, val)
+	case SeqCst:
+		arch.StoreUint16SeqCst(addr, val)
+	default:
+		arch.StoreUint16Release(addr, val)
+	}
+}
+
+// SwapUint16 atomically stores new to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapUint16(addr *uint16, new uint16) (old uint16) {
+	switch o {
+	case Relaxed:
+		return arch.SwapUint16Relaxed(addr, new)
+	case Acquire:
+		return arch.SwapUint16Acquire(addr, new)
+	case Release:
+		return arch.SwapUint16Release(addr, new)
+	default:
+		return arch.SwapUint16AcqRel(addr, new)
+	}
+}
+
+// CompareAndSwapUint16 atomically compares *addr with old and swaps if equal.
+// Returns true if the swap was performed.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapUint16(addr *uint16, old, new uint16) (swapped bool) {
+	switch o {
+	case Relaxed:
+		return arch.CasUint16Relaxed(addr, old, new)
+	case Acquire:
+		return arch.CasUint16Acquire(addr, old, new)
+	case Release:
+		return arch.CasUint16Release(addr, old, new)
+	default:
+		return arch.CasUint16AcqRel(addr, old, new)
+	}
+}
+
+// CompareExchangeUint16 atomically compares *addr with old and swaps if equal.
+// Returns the previous value (enables CAS loops without separate Load).
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeUint16(addr *uint16, old, new uint16) (prev uint16) {
+	switch o {
+	case Relaxed:
+		return arch.CaxUint16Relaxed(addr, old, new)
+	case Acquire:
+		return arch.CaxUint16Acquire(addr, old, new)
+	case Release:
+		return arch.CaxUint16Release(addr, old, new)
+	default:
+		return arch.CaxUint16AcqRel(addr, old, new)
+	}
+}
+
+// AddUint16 atomically adds delta to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddUint16(addr *uint16, delta uint16) (new uint16) {
+	switch o {
+	case Relaxed:
+		return arch.AddUint16Relaxed(addr, delta)
+	case Acquire:
+		return arch.AddUint16Acquire(addr, delta)
+	case Release:
+		return arch.AddUint16Release(addr, delta)
+	default:
+		return arch.AddUint16AcqRel(addr, delta)
+	}
+}
+
+// AndUint16 atomically performs *addr &= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndUint16(addr *uint16, mask uint16) (old uint16) {
+	switch o {
+	case Relaxed:
+		return arch.AndUint16Relaxed(addr, mask)
+	case Acquire:
+		return arch.AndUint16Acquire(addr, mask)
+	case Release:
+		return arch.AndUint16Release(addr, mask)
+	default:
+		return arch.AndUint16AcqRel(addr, mask)
+	}
+}
+
+// OrUint16 atomically performs *addr |= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrUint16(addr *uint16, mask uint16) (old uint16) {
+	switch o {
+	case Relaxed:
+		return arch.OrUint16Relaxed(addr, mask)
+	case Acquire:
+		return arch.OrUint16Acquire(addr, mask)
+	case Release:
+		return arch.OrUint16Release(addr, mask)
+	default:
+		return arch.OrUint16AcqRel(addr, mask)
+	}
+}
+
+// XorUint16 atomically performs *addr ^= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorUint16(addr *uint16, mask uint16) (old uint16) {
+	switch o {
+	case Relaxed:
+		return arch.XorUint16Relaxed(addr, mask)
+	case Acquire:
+		return arch.XorUint16Acquire(addr, mask)
+	case Release:
+		return arch.XorUint16Release(addr, mask)
+	default:
+		return arch.XorUint16AcqRel(addr, mask)
+	}
+}
+
+// MaxUint16 atomically stores max(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxUint16(addr *uint16, val uint16) (old uint16) {
+	if o == Relaxed {
+		for {
+			old = arch.LoadUint16Relaxed(addr)
+			if old >= val || arch.CasUint16Relaxed(addr, old, val) {
+				return old
+			}
+		}
+	}
+	for {
+		old = arch.LoadUint16Relaxed(addr)
+		if old >= val || arch.CasUint16AcqRel(addr, old, val) {
+			return old
+		}
+	}
+}
+
+// MinUint16 atomically stores min(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinUint16(addr *uint16, val uint16) (old uint16) {
+	if o == Relaxed {
+		for {
+			old = arch.LoadUint16Relaxed(addr)
+			if old <= val || arch.CasUint16Relaxed(addr, old, val) {
+				return old
+			}
+		}
+	}
+	for {
+		old = arch.LoadUint16Relaxed(addr)
+		if old <= val || arch.CasUint16AcqRel(addr, old, val) {
+			return old
+		}
+	}
+}
@@ -11,10 +11,14 @@ import "code.hybscloud.com/atomix/internal/arch"
 //
 //go:nosplit
 func (o MemoryOrder) LoadUint32(addr *uint32) uint32 {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		return arch.LoadUint32Relaxed(addr)
+	case SeqCst:
+		return arch.LoadUint32SeqCst(addr)
+	default:
+		return arch.LoadUint32Acquire(addr)
 	}
-	return arch.LoadUint32Acquire(addr)
 }
 
 // StoreUint32 atomically stores val to *addr with the specified memory ordering.
@@ -22,11 +26,14 @@ func (o MemoryOrder) LoadUint32(addr *uint32) uint32 {
 //
 //go:nosplit
 func (o MemoryOrder) StoreUint32(addr *uint32, val uint32) {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		arch.StoreUint32Relaxed(addr, val)
-		return
+	case SeqCst:
+		arch.StoreUint32SeqCst(addr, val)
+	default:
+		arch.StoreUint32Release(addr, val)
 	}
-	arch.StoreUint32Release(addr, val)
 }
 
 // SwapUint32 atomically stores new to *addr and returns the old value.
@@ -191,3 +198,41 @@ func (o MemoryOrder) MinUint32(addr *uint32, val uint32) (old uint32) {
 		}
 	}
 }
+
+// UpdateUint32 atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateUint32(addr *uint32, f func(old uint32) uint32) (old, new uint32) {
+	var b backoff
+	old = o.LoadUint32(addr)
+	for {
+		new = f(old)
+		prev := o.CompareExchangeUint32(addr, old, new)
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateUint32 is like UpdateUint32, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateUint32(addr *uint32, f func(old uint32) (uint32, bool)) (old, new uint32, updated bool) {
+	var b backoff
+	old = o.LoadUint32(addr)
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := o.CompareExchangeUint32(addr, old, new)
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
@@ -11,10 +11,14 @@ import "code.hybscloud.com/atomix/internal/arch"
 //
 //go:nosplit
 func (o MemoryOrder) LoadUint64(addr *uint64) uint64 {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		return arch.LoadUint64Relaxed(addr)
+	case SeqCst:
+		return arch.LoadUint64SeqCst(addr)
+	default:
+		return arch.LoadUint64Acquire(addr)
 	}
-	return arch.LoadUint64Acquire(addr)
 }
 
 // StoreUint64 atomically stores val to *addr with the specified memory ordering.
@@ -22,11 +26,14 @@ func (o MemoryOrder) LoadUint64(addr *uint64) uint64 {
 //
 //go:nosplit
 func (o MemoryOrder) StoreUint64(addr *uint64, val uint64) {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		arch.StoreUint64Relaxed(addr, val)
-		return
+	case SeqCst:
+		arch.StoreUint64SeqCst(addr, val)
+	default:
+		arch.StoreUint64Release(addr, val)
 	}
-	arch.StoreUint64Release(addr, val)
 }
 
 // SwapUint64 atomically stores new to *addr and returns the old value.
@@ -191,3 +198,41 @@ func (o MemoryOrder) MinUint64(addr *uint64, val uint64) (old uint64) {
 		}
 	}
 }
+
+// UpdateUint64 atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateUint64(addr *uint64, f func(old uint64) uint64) (old, new uint64) {
+	var b backoff
+	old = o.LoadUint64(addr)
+	for {
+		new = f(old)
+		prev := o.CompareExchangeUint64(addr, old, new)
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateUint64 is like UpdateUint64, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateUint64(addr *uint64, f func(old uint64) (uint64, bool)) (old, new uint64, updated bool) {
+	var b backoff
+	old = o.LoadUint64(addr)
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := o.CompareExchangeUint64(addr, old, new)
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
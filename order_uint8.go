@@ -0,0 +1,200 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// LoadUint8 atomically loads *addr with the specified memory ordering.
+// Unknown orderings fallback to Acquire.
+//
+//go:nosplit
+func (o MemoryOrder) LoadUint8(addr *uint8) uint8 {
+	switch o {
+	case Relaxed:
+		return arch.LoadUint8Relaxed(addr)
+	case SeqCst:
+		return arch.LoadUint8SeqCst(addr)
+	default:
+		return arch.LoadUint8Acquire(addr)
+	}
+}
+
+// StoreUint8 atomically stores val to *addr with the specified memory ordering.
+// Unknown orderings fallback to Release.
+//
+//go:nosplit
+func (o MemoryOrder) StoreUint8(addr *uint8, val uint8) {
+	switch o {
+	case Relaxed:
+		arch.StoreUint8Relaxed(addr, val)
+	case SeqCst:
+		arch.StoreUint8SeqCst(addr, val)
+	default:
+		arch.StoreUint8Release(addr, val)
+	}
+}
+
+// SwapUint8 atomically stores new to *addr and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) SwapUint8(addr *uint8, new uint8) (old uint8) {
+	switch o {
+	case Relaxed:
+		return arch.SwapUint8Relaxed(addr, new)
+	case Acquire:
+		return arch.SwapUint8Acquire(addr, new)
+	case Release:
+		return arch.SwapUint8Release(addr, new)
+	default:
+		return arch.SwapUint8AcqRel(addr, new)
+	}
+}
+
+// CompareAndSwapUint8 atomically compares *addr with old and swaps if equal.
+// Returns true if the swap was performed.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareAndSwapUint8(addr *uint8, old, new uint8) (swapped bool) {
+	switch o {
+	case Relaxed:
+		return arch.CasUint8Relaxed(addr, old, new)
+	case Acquire:
+		return arch.CasUint8Acquire(addr, old, new)
+	case Release:
+		return arch.CasUint8Release(addr, old, new)
+	default:
+		return arch.CasUint8AcqRel(addr, old, new)
+	}
+}
+
+// CompareExchangeUint8 atomically compares *addr with old and swaps if equal.
+// Returns the previous value (enables CAS loops without separate Load).
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) CompareExchangeUint8(addr *uint8, old, new uint8) (prev uint8) {
+	switch o {
+	case Relaxed:
+		return arch.CaxUint8Relaxed(addr, old, new)
+	case Acquire:
+		return arch.CaxUint8Acquire(addr, old, new)
+	case Release:
+		return arch.CaxUint8Release(addr, old, new)
+	default:
+		return arch.CaxUint8AcqRel(addr, old, new)
+	}
+}
+
+// AddUint8 atomically adds delta to *addr and returns the new value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AddUint8(addr *uint8, delta uint8) (new uint8) {
+	switch o {
+	case Relaxed:
+		return arch.AddUint8Relaxed(addr, delta)
+	case Acquire:
+		return arch.AddUint8Acquire(addr, delta)
+	case Release:
+		return arch.AddUint8Release(addr, delta)
+	default:
+		return arch.AddUint8AcqRel(addr, delta)
+	}
+}
+
+// AndUint8 atomically performs *addr &= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) AndUint8(addr *uint8, mask uint8) (old uint8) {
+	switch o {
+	case Relaxed:
+		return arch.AndUint8Relaxed(addr, mask)
+	case Acquire:
+		return arch.AndUint8Acquire(addr, mask)
+	case Release:
+		return arch.AndUint8Release(addr, mask)
+	default:
+		return arch.AndUint8AcqRel(addr, mask)
+	}
+}
+
+// OrUint8 atomically performs *addr |= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) OrUint8(addr *uint8, mask uint8) (old uint8) {
+	switch o {
+	case Relaxed:
+		return arch.OrUint8Relaxed(addr, mask)
+	case Acquire:
+		return arch.OrUint8Acquire(addr, mask)
+	case Release:
+		return arch.OrUint8Release(addr, mask)
+	default:
+		return arch.OrUint8AcqRel(addr, mask)
+	}
+}
+
+// XorUint8 atomically performs *addr ^= mask and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) XorUint8(addr *uint8, mask uint8) (old uint8) {
+	switch o {
+	case Relaxed:
+		return arch.XorUint8Relaxed(addr, mask)
+	case Acquire:
+		return arch.XorUint8Acquire(addr, mask)
+	case Release:
+		return arch.XorUint8Release(addr, mask)
+	default:
+		return arch.XorUint8AcqRel(addr, mask)
+	}
+}
+
+// MaxUint8 atomically stores max(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MaxUint8(addr *uint8, val uint8) (old uint8) {
+	if o == Relaxed {
+		for {
+			old = arch.LoadUint8Relaxed(addr)
+			if old >= val || arch.CasUint8Relaxed(addr, old, val) {
+				return old
+			}
+		}
+	}
+	for {
+		old = arch.LoadUint8Relaxed(addr)
+		if old >= val || arch.CasUint8AcqRel(addr, old, val) {
+			return old
+		}
+	}
+}
+
+// MinUint8 atomically stores min(*addr, val) and returns the old value.
+// Unknown orderings fallback to AcqRel.
+//
+//go:nosplit
+func (o MemoryOrder) MinUint8(addr *uint8, val uint8) (old uint8) {
+	if o == Relaxed {
+		for {
+			old = arch.LoadUint8Relaxed(addr)
+			if old <= val || arch.CasUint8Relaxed(addr, old, val) {
+				return old
+			}
+		}
+	}
+	for {
+		old = arch.LoadUint8Relaxed(addr)
+		if old <= val || arch.CasUint8AcqRel(addr, old, val) {
+			return old
+		}
+	}
+}
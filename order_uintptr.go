@@ -11,10 +11,14 @@ import "code.hybscloud.com/atomix/internal/arch"
 //
 //go:nosplit
 func (o MemoryOrder) LoadUintptr(addr *uintptr) uintptr {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		return arch.LoadUintptrRelaxed(addr)
+	case SeqCst:
+		return arch.LoadUintptrSeqCst(addr)
+	default:
+		return arch.LoadUintptrAcquire(addr)
 	}
-	return arch.LoadUintptrAcquire(addr)
 }
 
 // StoreUintptr atomically stores val to *addr with the specified memory ordering.
@@ -22,11 +26,14 @@ func (o MemoryOrder) LoadUintptr(addr *uintptr) uintptr {
 //
 //go:nosplit
 func (o MemoryOrder) StoreUintptr(addr *uintptr, val uintptr) {
-	if o == Relaxed {
+	switch o {
+	case Relaxed:
 		arch.StoreUintptrRelaxed(addr, val)
-		return
+	case SeqCst:
+		arch.StoreUintptrSeqCst(addr, val)
+	default:
+		arch.StoreUintptrRelease(addr, val)
 	}
-	arch.StoreUintptrRelease(addr, val)
 }
 
 // SwapUintptr atomically stores new to *addr and returns the old value.
@@ -191,3 +198,41 @@ func (o MemoryOrder) MinUintptr(addr *uintptr, val uintptr) (old uintptr) {
 		}
 	}
 }
+
+// UpdateUintptr atomically replaces *addr with f(*addr) and returns the old and
+// new values. f may run more than once under contention and must be free of
+// side effects; retries use CompareExchange and back off.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) UpdateUintptr(addr *uintptr, f func(old uintptr) uintptr) (old, new uintptr) {
+	var b backoff
+	old = o.LoadUintptr(addr)
+	for {
+		new = f(old)
+		prev := o.CompareExchangeUintptr(addr, old, new)
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// TryUpdateUintptr is like UpdateUintptr, but f may decline by returning false, in
+// which case *addr is left unchanged and (old, old, false) is returned.
+// Unknown orderings fallback to AcqRel.
+func (o MemoryOrder) TryUpdateUintptr(addr *uintptr, f func(old uintptr) (uintptr, bool)) (old, new uintptr, updated bool) {
+	var b backoff
+	old = o.LoadUintptr(addr)
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := o.CompareExchangeUintptr(addr, old, new)
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
@@ -156,3 +156,90 @@ func (a *Pointer[T]) CompareExchangeRelease(old, new *T) *T {
 func (a *Pointer[T]) CompareExchangeAcqRel(old, new *T) *T {
 	return (*T)(arch.CaxPointerAcqRel(&a.v, unsafe.Pointer(old), unsafe.Pointer(new)))
 }
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Pointer[T]) Update(f func(old *T) *T) (old, new *T) {
+	return updatePointer(AcqRel, &a.v, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Pointer[T]) UpdateRelaxed(f func(old *T) *T) (old, new *T) {
+	return updatePointer(Relaxed, &a.v, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Pointer[T]) UpdateAcquire(f func(old *T) *T) (old, new *T) {
+	return updatePointer(Acquire, &a.v, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Pointer[T]) UpdateRelease(f func(old *T) *T) (old, new *T) {
+	return updatePointer(Release, &a.v, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Pointer[T]) UpdateAcqRel(f func(old *T) *T) (old, new *T) {
+	return updatePointer(AcqRel, &a.v, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Pointer[T]) TryUpdate(f func(old *T) (*T, bool)) (old, new *T, updated bool) {
+	return tryUpdatePointer(AcqRel, &a.v, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Pointer[T]) TryUpdateRelaxed(f func(old *T) (*T, bool)) (old, new *T, updated bool) {
+	return tryUpdatePointer(Relaxed, &a.v, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Pointer[T]) TryUpdateAcquire(f func(old *T) (*T, bool)) (old, new *T, updated bool) {
+	return tryUpdatePointer(Acquire, &a.v, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Pointer[T]) TryUpdateRelease(f func(old *T) (*T, bool)) (old, new *T, updated bool) {
+	return tryUpdatePointer(Release, &a.v, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Pointer[T]) TryUpdateAcqRel(f func(old *T) (*T, bool)) (old, new *T, updated bool) {
+	return tryUpdatePointer(AcqRel, &a.v, f)
+}
+
+// updatePointer runs UpdatePointer on behalf of Pointer[T] without wrapping f.
+func updatePointer[T any](o MemoryOrder, addr *unsafe.Pointer, f func(old *T) *T) (old, new *T) {
+	var b backoff
+	old = (*T)(o.LoadPointer(addr))
+	for {
+		new = f(old)
+		prev := (*T)(o.CompareExchangePointer(addr, unsafe.Pointer(old), unsafe.Pointer(new)))
+		if prev == old {
+			return old, new
+		}
+		old = prev
+		b.wait()
+	}
+}
+
+// tryUpdatePointer runs TryUpdatePointer on behalf of Pointer[T] without wrapping f.
+func tryUpdatePointer[T any](o MemoryOrder, addr *unsafe.Pointer, f func(old *T) (*T, bool)) (old, new *T, updated bool) {
+	var b backoff
+	old = (*T)(o.LoadPointer(addr))
+	for {
+		new, ok := f(old)
+		if !ok {
+			return old, old, false
+		}
+		prev := (*T)(o.CompareExchangePointer(addr, unsafe.Pointer(old), unsafe.Pointer(new)))
+		if prev == old {
+			return old, new, true
+		}
+		old = prev
+		b.wait()
+	}
+}
@@ -0,0 +1,147 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build race
+
+package atomix_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+const raceEnabled = true
+
+// =============================================================================
+// Race Detector Tests
+// =============================================================================
+
+// Each test publishes a plain field through an atomix release and reads it
+// after the matching acquire. The race detector must see the edge; before
+// the race backend, these reported false data races.
+
+// publish runs write and then release in one goroutine, and acquire until
+// it reports true and then read in another.
+func publish(t *testing.T, write, release func(), acquire func() bool, read func()) {
+	t.Helper()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for !acquire() {
+			runtime.Gosched()
+		}
+		read()
+	}()
+	write()
+	release()
+	wg.Wait()
+}
+
+func TestRacePublishTypes(t *testing.T) {
+	var data int
+
+	var i64 atomix.Int64
+	publish(t, func() { data = 1 }, func() { i64.StoreRelease(1) },
+		func() bool { return i64.LoadAcquire() == 1 }, func() { _ = data })
+
+	var flag atomix.Bool
+	publish(t, func() { data = 2 }, func() { flag.StoreRelease(true) },
+		func() bool { return flag.LoadAcquire() }, func() { _ = data })
+
+	var u8 atomix.Uint8
+	publish(t, func() { data = 3 }, func() { u8.AddRelease(1) },
+		func() bool { return u8.LoadAcquire() == 1 }, func() { _ = data })
+
+	type msg struct{ v int }
+	var p atomix.Pointer[msg]
+	m := new(msg)
+	publish(t, func() { m.v = 4 }, func() { p.StoreRelease(m) },
+		func() bool { return p.LoadAcquire() != nil }, func() { _ = p.LoadAcquire().v })
+
+	var u128 atomix.Uint128
+	publish(t, func() { data = 5 }, func() { u128.StoreRelease(1, 1) },
+		func() bool { lo, _ := u128.LoadAcquire(); return lo == 1 }, func() { _ = data })
+
+	var raw uint32
+	publish(t, func() { data = 6 }, func() { atomix.Release.StoreUint32(&raw, 1) },
+		func() bool { return atomix.Acquire.LoadUint32(&raw) == 1 }, func() { _ = data })
+
+	var x, y atomix.KCASUint64
+	publish(t, func() { data = 7 }, func() { atomix.KCAS(x.Entry(0, 1), y.Entry(0, 1)) },
+		func() bool { return y.Load() == 1 }, func() { _ = data })
+
+	// The Relaxed variants of Value still publish the box they store.
+	v := atomix.NewValue(atomix.TypeAny)
+	publish(t, func() { m.v = 8 }, func() { v.StoreRelaxed(m) },
+		func() bool { return v.LoadRelaxed() != nil }, func() { _ = v.LoadRelaxed().(*msg).v })
+	w := atomix.NewValue(atomix.TypeAny)
+	publish(t, func() { data = 9 }, func() { w.CompareAndSwapRelaxed(nil, &data) },
+		func() bool { return w.LoadRelaxed() != nil }, func() { _ = *w.LoadRelaxed().(*int) })
+}
+
+// 8-bit and 16-bit atomics next to plain fields: the atomic accesses only its
+// own bytes, so a plain write to a neighbour is not a race.
+func TestRaceSubwordNeighbour(t *testing.T) {
+	const iters = 200
+	var s struct {
+		a atomix.Uint8
+		b uint8
+		c atomix.Uint16
+		d uint16
+	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := range iters {
+			s.a.Store(uint8(i))
+			s.a.Add(1)
+			s.a.CompareAndSwap(uint8(i+1), 0)
+			s.c.StoreRelease(uint16(i))
+			s.c.Or(1)
+			_ = s.c.LoadAcquire()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := range iters {
+			s.b = uint8(i)
+			s.d = uint16(i)
+		}
+	}()
+	wg.Wait()
+	if s.b != iters-1 || s.d != iters-1 {
+		t.Fatalf("b=%d d=%d, want %d", s.b, s.d, iters-1)
+	}
+}
+
+// A spin lock built on CompareAndSwapAcquire and StoreRelease protects a
+// plain counter.
+func TestRaceSpinLock(t *testing.T) {
+	const goroutines, iters = 4, 200
+	var lock atomix.Int32
+	var count int
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iters {
+				for !lock.CompareAndSwapAcquire(0, 1) {
+					runtime.Gosched()
+				}
+				count++
+				lock.StoreRelease(0)
+			}
+		}()
+	}
+	wg.Wait()
+	if count != goroutines*iters {
+		t.Fatalf("count = %d, want %d", count, goroutines*iters)
+	}
+}
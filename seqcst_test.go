@@ -0,0 +1,128 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// SeqCst Tests
+// =============================================================================
+
+func TestSeqCstLoadStore(t *testing.T) {
+	var i8 atomix.Int8
+	i8.StoreSeqCst(-3)
+	var u16 atomix.Uint16
+	u16.StoreSeqCst(0xbeef)
+	var i64 atomix.Int64
+	i64.StoreSeqCst(-1 << 40)
+	var b atomix.Bool
+	b.StoreSeqCst(true)
+	var f atomix.Float64
+	f.StoreSeqCst(2.5)
+	var p atomix.Pointer[int]
+	x := 7
+	p.StoreSeqCst(&x)
+	_, u128 := atomix.PlaceAlignedUint128(make([]byte, 64), 0)
+	u128.StoreSeqCst(1, 2)
+	if i8.LoadSeqCst() != -3 || u16.LoadSeqCst() != 0xbeef || i64.LoadSeqCst() != -1<<40 ||
+		!b.LoadSeqCst() || f.LoadSeqCst() != 2.5 || p.LoadSeqCst() != &x {
+		t.Fatal("LoadSeqCst should return the value stored by StoreSeqCst")
+	}
+	if lo, hi := u128.LoadSeqCst(); lo != 1 || hi != 2 {
+		t.Fatalf("Uint128 LoadSeqCst: got (%d, %d)", lo, hi)
+	}
+	if i64.AddSeqCst(1) != -1<<40+1 || i64.CompareExchangeSeqCst(0, 1) != -1<<40+1 {
+		t.Fatal("Int64 SeqCst read-modify-writes")
+	}
+
+	var raw uint32
+	atomix.SeqCst.StoreUint32(&raw, 9)
+	if atomix.SeqCst.LoadUint32(&raw) != 9 || atomix.SeqCst.AddUint32(&raw, 1) != 10 {
+		t.Fatal("MemoryOrder SeqCst on uint32")
+	}
+	var rp unsafe.Pointer
+	atomix.SeqCst.StorePointer(&rp, unsafe.Pointer(&x))
+	if atomix.SeqCst.LoadPointer(&rp) != unsafe.Pointer(&x) {
+		t.Fatal("MemoryOrder SeqCst on pointer")
+	}
+}
+
+// litmusSB runs the store-buffering litmus test
+//
+//	T0: x = 1; r0 = y        T1: y = 1; r1 = x
+//
+// iters times and returns how often both loads saw 0. Under sequential
+// consistency that outcome is forbidden. The main goroutine runs T0 and a
+// helper runs T1; both spin on an epoch so each round starts together.
+func litmusSB(iters int, thread func(mine, theirs *atomix.Uint32) uint32) (forbidden int) {
+	var x, y, epoch, done atomix.Uint32
+	var r1 atomix.Uint32
+	go func() {
+		for i := uint32(1); i <= uint32(iters); i++ {
+			for epoch.LoadAcquire() != i {
+			}
+			r1.StoreRelaxed(thread(&y, &x))
+			done.StoreRelease(i)
+		}
+	}()
+	for i := uint32(1); i <= uint32(iters); i++ {
+		x.StoreRelaxed(0)
+		y.StoreRelaxed(0)
+		epoch.StoreRelease(i)
+		r0 := thread(&x, &y)
+		for done.LoadAcquire() != i {
+		}
+		if r0 == 0 && r1.LoadRelaxed() == 0 {
+			forbidden++
+		}
+	}
+	return forbidden
+}
+
+func TestSeqCstStoreBuffering(t *testing.T) {
+	if runtime.GOMAXPROCS(0) < 2 || runtime.NumCPU() < 2 {
+		t.Skip("needs two CPUs running in parallel")
+	}
+	iters := 200000
+	if testing.Short() {
+		iters = 20000
+	}
+	tests := []struct {
+		name   string
+		thread func(mine, theirs *atomix.Uint32) uint32
+	}{
+		{"StoreSeqCst/LoadSeqCst", func(mine, theirs *atomix.Uint32) uint32 {
+			mine.StoreSeqCst(1)
+			return theirs.LoadSeqCst()
+		}},
+		{"SwapSeqCst/LoadSeqCst", func(mine, theirs *atomix.Uint32) uint32 {
+			mine.SwapSeqCst(1)
+			return theirs.LoadSeqCst()
+		}},
+		{"BarrierSeqCst", func(mine, theirs *atomix.Uint32) uint32 {
+			mine.StoreRelaxed(1)
+			atomix.BarrierSeqCst()
+			return theirs.LoadRelaxed()
+		}},
+		{"BarrierStoreLoad", func(mine, theirs *atomix.Uint32) uint32 {
+			mine.StoreRelaxed(1)
+			atomix.BarrierStoreLoad()
+			return theirs.LoadRelaxed()
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := litmusSB(iters, tt.thread); n != 0 {
+				t.Fatalf("store-buffering outcome r0 == r1 == 0 observed %d/%d times", n, iters)
+			}
+		})
+	}
+}
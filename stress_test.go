@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"sync"
 	"testing"
+	"unsafe"
 
 	"code.hybscloud.com/atomix"
 )
@@ -228,6 +229,95 @@ func TestStressSwapContention(t *testing.T) {
 	t.Logf("swap contention: %d goroutines × %d swaps (PASS)", numGoroutines, iterations)
 }
 
+// TestStressUint128TornReads keeps the halves of 128-bit values in a fixed
+// relation (hi == ^lo) and has writers replace them with Store, Swap, CAS
+// and Update while readers check every value they see. A backend that
+// writes the halves separately lets a reader pair one half of one write
+// with the other half of another.
+func TestStressUint128TornReads(t *testing.T) {
+	writers, readers, iterations := runtime.GOMAXPROCS(0)+1, runtime.GOMAXPROCS(0)+1, 20000
+	if testing.Short() {
+		iterations = 2000
+	}
+	t.Logf("Uint128IsLockFree=%v Uint128Variant=%s", atomix.Uint128IsLockFree(), atomix.Uint128Variant())
+
+	var u atomix.Uint128
+	var i atomix.Int128
+	raw := make([]byte, 32)
+	slot := unsafe.Pointer(&raw[-uintptr(unsafe.Pointer(&raw[0]))&15])
+	u.Store(0, ^uint64(0))
+	i.Store(0, -1)
+	atomix.Release.StoreUint128At(slot, 0, ^uint64(0))
+
+	check := func(what string, lo, hi uint64) bool {
+		if hi != ^lo {
+			t.Errorf("%s: torn value lo=%#x hi=%#x", what, lo, hi)
+			return false
+		}
+		return true
+	}
+
+	var done atomix.Bool
+	var rg sync.WaitGroup
+	for range readers {
+		rg.Add(1)
+		go func() {
+			defer rg.Done()
+			for !done.Load() {
+				lo, hi := u.Load()
+				ilo, ihi := i.LoadAcquire()
+				slo, shi := atomix.Acquire.LoadUint128At(slot)
+				qlo, qhi := u.LoadSeqCst()
+				if !check("Uint128.Load", lo, hi) || !check("Int128.LoadAcquire", uint64(ilo), uint64(ihi)) ||
+					!check("LoadUint128At", slo, shi) || !check("Uint128.LoadSeqCst", qlo, qhi) {
+					return
+				}
+			}
+		}()
+	}
+
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range iterations {
+				v := uint64(w)<<32 | uint64(n)
+				switch n % 4 {
+				case 0:
+					u.StoreRelease(v, ^v)
+					i.Store(int64(v), int64(^v))
+					atomix.Relaxed.StoreUint128At(slot, v, ^v)
+				case 1:
+					lo, hi := u.Swap(v, ^v)
+					slo, shi := atomix.AcqRel.SwapUint128At(slot, v, ^v)
+					ilo, ihi := i.SwapAcquire(int64(v), int64(^v))
+					if !check("Uint128.Swap", lo, hi) || !check("SwapUint128At", slo, shi) ||
+						!check("Int128.SwapAcquire", uint64(ilo), uint64(ihi)) {
+						return
+					}
+				case 2:
+					lo, hi := u.Load()
+					lo, hi = u.CompareExchange(lo, hi, v, ^v)
+					slo, shi := atomix.Relaxed.LoadUint128At(slot)
+					slo, shi = atomix.Release.CompareExchangeUint128At(slot, slo, shi, v, ^v)
+					if !check("Uint128.CompareExchange", lo, hi) || !check("CompareExchangeUint128At", slo, shi) {
+						return
+					}
+				case 3:
+					u.Update(func(old atomix.U128) atomix.U128 {
+						check("Uint128.Update", old.Lo, old.Hi)
+						return atomix.U128{Lo: v, Hi: ^v}
+					})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	done.Store(true)
+	rg.Wait()
+}
+
 // BenchmarkStressUint64Parallel measures throughput under parallel stress
 func BenchmarkStressUint64Parallel(b *testing.B) {
 	var a atomix.Uint64
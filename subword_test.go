@@ -0,0 +1,271 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// 8-bit and 16-bit Tests
+// =============================================================================
+
+func TestUint8Methods(t *testing.T) {
+	var a atomix.Uint8
+	a.Store(10)
+	if got := a.LoadAcquire(); got != 10 {
+		t.Fatalf("LoadAcquire: got %d, want 10", got)
+	}
+	if got := a.Add(250); got != 4 {
+		t.Fatalf("Add wraparound: got %d, want 4", got)
+	}
+	if got := a.SubRelaxed(5); got != 255 {
+		t.Fatalf("SubRelaxed wraparound: got %d, want 255", got)
+	}
+	if old := a.AndAcquire(0x0F); old != 255 {
+		t.Fatalf("AndAcquire: got %d, want 255", old)
+	}
+	if old := a.OrRelease(0x80); old != 0x0F {
+		t.Fatalf("OrRelease: got %#x, want 0x0F", old)
+	}
+	if old := a.XorAcqRel(0xFF); old != 0x8F {
+		t.Fatalf("XorAcqRel: got %#x, want 0x8F", old)
+	}
+	if old := a.SwapRelease(1); old != 0x70 {
+		t.Fatalf("SwapRelease: got %#x, want 0x70", old)
+	}
+	if !a.CompareAndSwap(1, 2) || a.CompareAndSwapAcquire(1, 3) {
+		t.Fatal("CompareAndSwap: unexpected result")
+	}
+	if prev := a.CompareExchangeRelease(2, 200); prev != 2 {
+		t.Fatalf("CompareExchangeRelease: got %d, want 2", prev)
+	}
+	if old := a.Max(100); old != 200 {
+		t.Fatalf("Max: got %d, want 200", old)
+	}
+	if old := a.MinRelaxed(100); old != 200 {
+		t.Fatalf("MinRelaxed: got %d, want 200", old)
+	}
+	if got := a.Load(); got != 100 {
+		t.Fatalf("Load: got %d, want 100", got)
+	}
+}
+
+func TestInt8Signed(t *testing.T) {
+	var a atomix.Int8
+	a.StoreRelease(-100)
+	if got := a.Sub(30); got != 126 {
+		t.Fatalf("Sub overflow: got %d, want 126", got)
+	}
+	if old := a.MinRelaxed(-1); old != 126 {
+		t.Fatalf("MinRelaxed: got %d, want 126", old)
+	}
+	if old := a.Max(-128); old != -1 {
+		t.Fatalf("Max: got %d, want -1", old)
+	}
+	if prev := a.CompareExchangeAcqRel(-1, -128); prev != -1 {
+		t.Fatalf("CompareExchangeAcqRel: got %d, want -1", prev)
+	}
+	if got := a.AddAcquire(-1); got != 127 {
+		t.Fatalf("AddAcquire underflow: got %d, want 127", got)
+	}
+}
+
+func TestInt16AndUint16Methods(t *testing.T) {
+	var s atomix.Int16
+	s.Store(-1000)
+	if old := s.Max(2000); old != -1000 {
+		t.Fatalf("Int16.Max: got %d, want -1000", old)
+	}
+	if got := s.AddRelease(31000); got != -32536 {
+		t.Fatalf("Int16.AddRelease overflow: got %d, want -32536", got)
+	}
+	if old := s.Min(-32768); old != -32536 {
+		t.Fatalf("Int16.Min: got %d, want -32536", old)
+	}
+
+	var u atomix.Uint16
+	if old := u.OrRelaxed(0x8001); old != 0 {
+		t.Fatalf("Uint16.OrRelaxed: got %#x, want 0", old)
+	}
+	if !u.CompareAndSwapRelease(0x8001, 0xFFFF) {
+		t.Fatal("Uint16.CompareAndSwapRelease should succeed")
+	}
+	if got := u.AddRelaxed(1); got != 0 {
+		t.Fatalf("Uint16.AddRelaxed wraparound: got %#x, want 0", got)
+	}
+	if old := u.SwapAcquire(0x1234); old != 0 {
+		t.Fatalf("Uint16.SwapAcquire: got %#x, want 0", old)
+	}
+	if got := u.LoadRelaxed(); got != 0x1234 {
+		t.Fatalf("Uint16.LoadRelaxed: got %#x, want 0x1234", got)
+	}
+}
+
+func TestMemoryOrderSubword(t *testing.T) {
+	// An io_uring-style header: a flags byte next to other fields.
+	var hdr struct {
+		opcode uint8
+		flags  uint8
+		prio   int16
+		fd     int32
+	}
+	hdr.opcode = 7
+	hdr.fd = -1
+
+	for _, o := range []atomix.MemoryOrder{atomix.Relaxed, atomix.Acquire, atomix.Release, atomix.AcqRel} {
+		o.StoreUint8(&hdr.flags, 0)
+		if old := o.OrUint8(&hdr.flags, 1<<2); old != 0 {
+			t.Fatalf("%v OrUint8: got %#x, want 0", o, old)
+		}
+		if !o.CompareAndSwapUint8(&hdr.flags, 1<<2, 1<<3) {
+			t.Fatalf("%v CompareAndSwapUint8 should succeed", o)
+		}
+		if prev := o.CompareExchangeUint8(&hdr.flags, 0, 1); prev != 1<<3 {
+			t.Fatalf("%v CompareExchangeUint8: got %#x, want %#x", o, prev, 1<<3)
+		}
+		if old := o.XorUint8(&hdr.flags, 0xFF); old != 1<<3 {
+			t.Fatalf("%v XorUint8: got %#x", o, old)
+		}
+		if old := o.AndUint8(&hdr.flags, 0x0F); old != 0xF7 {
+			t.Fatalf("%v AndUint8: got %#x, want 0xF7", o, old)
+		}
+		if got := o.AddUint8(&hdr.flags, 1); got != 0x08 {
+			t.Fatalf("%v AddUint8: got %#x, want 0x08", o, got)
+		}
+		if old := o.MaxUint8(&hdr.flags, 0x10); old != 0x08 {
+			t.Fatalf("%v MaxUint8: got %#x, want 0x08", o, old)
+		}
+		if old := o.MinUint8(&hdr.flags, 0x01); old != 0x10 {
+			t.Fatalf("%v MinUint8: got %#x, want 0x10", o, old)
+		}
+		if old := o.SwapUint8(&hdr.flags, 0); old != 0x01 {
+			t.Fatalf("%v SwapUint8: got %#x, want 0x01", o, old)
+		}
+
+		o.StoreInt16(&hdr.prio, -5)
+		if old := o.MinInt16(&hdr.prio, -7); old != -5 {
+			t.Fatalf("%v MinInt16: got %d, want -5", o, old)
+		}
+		if got := o.AddInt16(&hdr.prio, 10); got != 3 {
+			t.Fatalf("%v AddInt16: got %d, want 3", o, got)
+		}
+		if old := o.MaxInt16(&hdr.prio, 4); old != 3 {
+			t.Fatalf("%v MaxInt16: got %d, want 3", o, old)
+		}
+		if prev := o.CompareExchangeInt16(&hdr.prio, 4, -4); prev != 4 {
+			t.Fatalf("%v CompareExchangeInt16: got %d, want 4", o, prev)
+		}
+		if got := o.LoadInt16(&hdr.prio); got != -4 {
+			t.Fatalf("%v LoadInt16: got %d, want -4", o, got)
+		}
+
+		if hdr.opcode != 7 || hdr.fd != -1 {
+			t.Fatalf("%v neighbours clobbered: opcode=%d fd=%d", o, hdr.opcode, hdr.fd)
+		}
+	}
+
+	var i8 int8
+	atomix.AcqRel.StoreInt8(&i8, -3)
+	if old := atomix.Relaxed.SwapInt8(&i8, 5); old != -3 {
+		t.Fatalf("SwapInt8: got %d, want -3", old)
+	}
+	if !atomix.Release.CompareAndSwapInt8(&i8, 5, -128) {
+		t.Fatal("CompareAndSwapInt8 should succeed")
+	}
+	if old := atomix.Acquire.MaxInt8(&i8, -1); old != -128 {
+		t.Fatalf("MaxInt8: got %d, want -128", old)
+	}
+	if got := atomix.Acquire.LoadInt8(&i8); got != -1 {
+		t.Fatalf("LoadInt8: got %d, want -1", got)
+	}
+
+	var u16 uint16
+	atomix.Release.StoreUint16(&u16, 0xFF00)
+	if old := atomix.AcqRel.XorUint16(&u16, 0xFFFF); old != 0xFF00 {
+		t.Fatalf("XorUint16: got %#x, want 0xFF00", old)
+	}
+	if got := atomix.Relaxed.LoadUint16(&u16); got != 0x00FF {
+		t.Fatalf("LoadUint16: got %#x, want 0x00FF", got)
+	}
+}
+
+func TestSubwordConcurrentNeighbours(t *testing.T) {
+	const iterations = 5000
+	var bytes [8]atomix.Uint8
+	var halves [4]atomix.Int16
+	var wg sync.WaitGroup
+	for i := range bytes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				bytes[i].Add(1)
+				halves[i/2].AddRelaxed(1)
+			}
+		}()
+	}
+	wg.Wait()
+	for i := range bytes {
+		if got := bytes[i].Load(); got != uint8(iterations%256) {
+			t.Fatalf("byte %d: got %d, want %d", i, got, iterations%256)
+		}
+	}
+	for i := range halves {
+		if got := halves[i].Load(); got != 2*iterations {
+			t.Fatalf("half %d: got %d, want %d", i, got, 2*iterations)
+		}
+	}
+}
+
+func TestPlaceSubword(t *testing.T) {
+	buf := make([]byte, 256)
+	if !atomix.CanPlaceAligned2(buf, 1) || atomix.CanPlaceAligned2(buf, 255) {
+		t.Fatal("CanPlaceAligned2: unexpected result")
+	}
+
+	n, u8 := atomix.PlaceAlignedUint8(buf, 3)
+	if n != 1 || uintptr(unsafe.Pointer(u8)) != uintptr(unsafe.Pointer(&buf[3])) {
+		t.Fatalf("PlaceAlignedUint8: n=%d", n)
+	}
+	_, u16 := atomix.PlaceAlignedUint16(buf, 5)
+	if uintptr(unsafe.Pointer(u16))&1 != 0 {
+		t.Fatal("PlaceAlignedUint16: misaligned")
+	}
+	_, i16p := atomix.PlaceCacheAlignedInt16(buf, 1)
+	if uintptr(unsafe.Pointer(i16p))%atomix.CacheLineSize != 0 {
+		t.Fatal("PlaceCacheAlignedInt16: misaligned")
+	}
+
+	alloc := atomix.NewAllocator(buf)
+	a := alloc.Int8()
+	b := alloc.Uint16()
+	c := alloc.Int16()
+	d := alloc.Uint8()
+	e := alloc.CacheAlignedUint8()
+	f := alloc.CacheAlignedInt8()
+	g := alloc.CacheAlignedUint16()
+	a.Store(-1)
+	b.Store(0xBEEF)
+	c.Store(-2)
+	d.Store(3)
+	e.Store(4)
+	f.Store(-5)
+	g.Store(6)
+	if a.Load() != -1 || b.Load() != 0xBEEF || c.Load() != -2 || d.Load() != 3 ||
+		e.Load() != 4 || f.Load() != -5 || g.Load() != 6 {
+		t.Fatal("allocated sub-word values clobbered each other")
+	}
+	if uintptr(unsafe.Pointer(b))&1 != 0 || uintptr(unsafe.Pointer(c))&1 != 0 {
+		t.Fatal("Allocator: 16-bit values misaligned")
+	}
+	if unsafe.Sizeof(atomix.Uint16Padded{}) != atomix.CacheLineSize {
+		t.Fatalf("Uint16Padded size: got %d", unsafe.Sizeof(atomix.Uint16Padded{}))
+	}
+}
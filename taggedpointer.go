@@ -0,0 +1,159 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"sync/atomic"
+	"unsafe"
+
+	"code.hybscloud.com/atomix/internal/arch"
+)
+
+// Load atomically loads and returns the pointer and tag with relaxed ordering.
+//
+//go:nosplit
+func (a *TaggedPointer[T]) Load() (p *T, tag uint64) {
+	return a.load(Relaxed)
+}
+
+// LoadRelaxed atomically loads and returns the pointer and tag with relaxed ordering.
+//
+//go:nosplit
+func (a *TaggedPointer[T]) LoadRelaxed() (p *T, tag uint64) {
+	return a.load(Relaxed)
+}
+
+// LoadAcquire atomically loads and returns the pointer and tag with acquire ordering.
+//
+//go:nosplit
+func (a *TaggedPointer[T]) LoadAcquire() (p *T, tag uint64) {
+	return a.load(Acquire)
+}
+
+// CompareAndSwap atomically replaces (old, oldTag) with (new, oldTag+1)
+// with acquire-release ordering. Returns true if the swap was performed.
+func (a *TaggedPointer[T]) CompareAndSwap(old *T, oldTag uint64, new *T) bool {
+	p, tag := a.cax(AcqRel, old, oldTag, new)
+	return p == old && tag == oldTag
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+func (a *TaggedPointer[T]) CompareAndSwapRelaxed(old *T, oldTag uint64, new *T) bool {
+	p, tag := a.cax(Relaxed, old, oldTag, new)
+	return p == old && tag == oldTag
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+func (a *TaggedPointer[T]) CompareAndSwapAcquire(old *T, oldTag uint64, new *T) bool {
+	p, tag := a.cax(Acquire, old, oldTag, new)
+	return p == old && tag == oldTag
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+func (a *TaggedPointer[T]) CompareAndSwapRelease(old *T, oldTag uint64, new *T) bool {
+	p, tag := a.cax(Release, old, oldTag, new)
+	return p == old && tag == oldTag
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+func (a *TaggedPointer[T]) CompareAndSwapAcqRel(old *T, oldTag uint64, new *T) bool {
+	p, tag := a.cax(AcqRel, old, oldTag, new)
+	return p == old && tag == oldTag
+}
+
+// CompareExchange atomically replaces (old, oldTag) with (new, oldTag+1)
+// and returns the previous pointer and tag. Uses acquire-release ordering.
+// The swap was performed if and only if the result equals (old, oldTag).
+func (a *TaggedPointer[T]) CompareExchange(old *T, oldTag uint64, new *T) (prev *T, prevTag uint64) {
+	return a.cax(AcqRel, old, oldTag, new)
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+func (a *TaggedPointer[T]) CompareExchangeRelaxed(old *T, oldTag uint64, new *T) (prev *T, prevTag uint64) {
+	return a.cax(Relaxed, old, oldTag, new)
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+func (a *TaggedPointer[T]) CompareExchangeAcquire(old *T, oldTag uint64, new *T) (prev *T, prevTag uint64) {
+	return a.cax(Acquire, old, oldTag, new)
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+func (a *TaggedPointer[T]) CompareExchangeRelease(old *T, oldTag uint64, new *T) (prev *T, prevTag uint64) {
+	return a.cax(Release, old, oldTag, new)
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+func (a *TaggedPointer[T]) CompareExchangeAcqRel(old *T, oldTag uint64, new *T) (prev *T, prevTag uint64) {
+	return a.cax(AcqRel, old, oldTag, new)
+}
+
+// IsLockFree reports whether operations on a use the native 128-bit CAS
+// rather than the lock table. The answer is the same for every instance.
+//
+//go:nosplit
+func (a *TaggedPointer[T]) IsLockFree() bool {
+	return arch.LockFree128
+}
+
+// slot returns the 16-byte aligned slot of a, and the pointer word in it:
+// the low word when a itself is aligned, the high word otherwise.
+//
+//go:nosplit
+func (a *TaggedPointer[T]) slot() (s *[16]byte, ptr *unsafe.Pointer, high bool) {
+	if uintptr(unsafe.Pointer(a))&15 == 0 {
+		return (*[16]byte)(unsafe.Pointer(a)), &a.p0, false
+	}
+	return (*[16]byte)(unsafe.Pointer(&a.tag)), &a.p1, true
+}
+
+// load must stay nosplit: between the 128-bit load and the conversion
+// back to *T the pointer is held only as an integer.
+//
+//go:nosplit
+func (a *TaggedPointer[T]) load(o MemoryOrder) (p *T, tag uint64) {
+	if !arch.LockFree128 {
+		mu := lockFor(unsafe.Pointer(a))
+		mu.Lock()
+		p, tag = (*T)(a.p0), a.tag
+		mu.Unlock()
+		return p, tag
+	}
+	s, _, high := a.slot()
+	lo, hi := o.load128(s)
+	if high {
+		lo, hi = hi, lo
+	}
+	return *(**T)(unsafe.Pointer(&lo)), hi
+}
+
+// cax must stay nosplit for the same reason as load. The write barrier
+// runs first, while old and new are still typed pointers.
+//
+//go:nosplit
+func (a *TaggedPointer[T]) cax(o MemoryOrder, old *T, oldTag uint64, new *T) (prev *T, prevTag uint64) {
+	if !arch.LockFree128 {
+		mu := lockFor(unsafe.Pointer(a))
+		mu.Lock()
+		prev, prevTag = (*T)(a.p0), a.tag
+		if prev == old && prevTag == oldTag {
+			a.p0, a.tag = unsafe.Pointer(new), oldTag+1
+		}
+		mu.Unlock()
+		return prev, prevTag
+	}
+	s, ptr, high := a.slot()
+	atomic.CompareAndSwapPointer(ptr, unsafe.Pointer(new), unsafe.Pointer(new))
+	oldLo, oldHi := uint64(uintptr(unsafe.Pointer(old))), oldTag
+	newLo, newHi := uint64(uintptr(unsafe.Pointer(new))), oldTag+1
+	if high {
+		oldLo, oldHi, newLo, newHi = oldHi, oldLo, newHi, newLo
+	}
+	lo, hi := o.cax128(s, oldLo, oldHi, newLo, newHi)
+	if high {
+		lo, hi = hi, lo
+	}
+	return *(**T)(unsafe.Pointer(&lo)), hi
+}
@@ -0,0 +1,229 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// TaggedPointer Tests
+// =============================================================================
+
+type stackNode struct {
+	next  *stackNode
+	value int
+}
+
+func TestTaggedPointerBasic(t *testing.T) {
+	a := new(atomix.TaggedPointer[int])
+	if p, tag := a.Load(); p != nil || tag != 0 {
+		t.Fatalf("zero value: got (%v, %d), want (nil, 0)", p, tag)
+	}
+	if !a.IsLockFree() && runtime.GOARCH == "amd64" && !raceEnabled {
+		t.Fatal("heap-allocated TaggedPointer should be lock-free on amd64")
+	}
+
+	x, y := 1, 2
+	if !a.CompareAndSwap(nil, 0, &x) {
+		t.Fatal("CompareAndSwap should succeed")
+	}
+	if p, tag := a.LoadAcquire(); p != &x || tag != 1 {
+		t.Fatalf("LoadAcquire: got (%p, %d), want (%p, 1)", p, tag, &x)
+	}
+	if a.CompareAndSwapRelaxed(&x, 0, &y) {
+		t.Fatal("CompareAndSwapRelaxed should fail on stale tag")
+	}
+	if a.CompareAndSwapAcquire(&y, 1, &y) {
+		t.Fatal("CompareAndSwapAcquire should fail on wrong pointer")
+	}
+	if !a.CompareAndSwapRelease(&x, 1, &y) {
+		t.Fatal("CompareAndSwapRelease should succeed")
+	}
+	if !a.CompareAndSwapAcqRel(&y, 2, &x) {
+		t.Fatal("CompareAndSwapAcqRel should succeed")
+	}
+	if p, tag := a.LoadRelaxed(); p != &x || tag != 3 {
+		t.Fatalf("LoadRelaxed: got (%p, %d), want (%p, 3)", p, tag, &x)
+	}
+}
+
+func TestTaggedPointerCompareExchange(t *testing.T) {
+	a := new(atomix.TaggedPointer[int])
+	x, y := 1, 2
+	cases := []struct {
+		name string
+		cx   func(old *int, oldTag uint64, new *int) (*int, uint64)
+	}{
+		{"CompareExchange", a.CompareExchange},
+		{"CompareExchangeRelaxed", a.CompareExchangeRelaxed},
+		{"CompareExchangeAcquire", a.CompareExchangeAcquire},
+		{"CompareExchangeRelease", a.CompareExchangeRelease},
+		{"CompareExchangeAcqRel", a.CompareExchangeAcqRel},
+	}
+	cur, curTag := (*int)(nil), uint64(0)
+	for _, c := range cases {
+		next := &x
+		if cur == &x {
+			next = &y
+		}
+		if p, tag := c.cx(next, curTag, next); p != cur || tag != curTag {
+			t.Fatalf("%s failure: got (%p, %d), want (%p, %d)", c.name, p, tag, cur, curTag)
+		}
+		if p, tag := c.cx(cur, curTag, next); p != cur || tag != curTag {
+			t.Fatalf("%s: got (%p, %d), want (%p, %d)", c.name, p, tag, cur, curTag)
+		}
+		cur, curTag = next, curTag+1
+	}
+	if p, tag := a.Load(); p != cur || tag != curTag {
+		t.Fatalf("Load: got (%p, %d), want (%p, %d)", p, tag, cur, curTag)
+	}
+}
+
+func TestTaggedPointerABA(t *testing.T) {
+	var top atomix.TaggedPointer[stackNode]
+	a := &stackNode{value: 1}
+	b := &stackNode{value: 2, next: a}
+	top.CompareAndSwap(nil, 0, b)
+
+	// A slow popper reads (b, tag) and b.next == a.
+	old, oldTag := top.Load()
+	next := old.next
+
+	// Meanwhile b and a are popped and b is pushed back: the pointer
+	// matches again but the tag has moved on.
+	top.CompareAndSwap(b, 1, a)
+	top.CompareAndSwap(a, 2, nil)
+	b.next = nil
+	top.CompareAndSwap(nil, 3, b)
+
+	if top.CompareAndSwap(old, oldTag, next) {
+		t.Fatal("CompareAndSwap must detect ABA through the tag")
+	}
+	if p, tag := top.Load(); p != b || tag != 4 {
+		t.Fatalf("Load: got (%p, %d), want (%p, 4)", p, tag, b)
+	}
+}
+
+func TestTaggedPointerUnaligned(t *testing.T) {
+	// a sits 8 bytes into each element, off the 16-byte grid.
+	type embedded struct {
+		pad uint64
+		a   atomix.TaggedPointer[int]
+	}
+	s := make([]embedded, 4)
+	vals := make([]int, len(s))
+	for i := range s {
+		if s[i].a.IsLockFree() != atomix.Uint128IsLockFree() {
+			t.Fatalf("element %d: IsLockFree = %v, Uint128IsLockFree = %v",
+				i, s[i].a.IsLockFree(), atomix.Uint128IsLockFree())
+		}
+		s[i].pad = ^uint64(0)
+		if !s[i].a.CompareAndSwap(nil, 0, &vals[i]) {
+			t.Fatalf("element %d: CompareAndSwap should succeed", i)
+		}
+	}
+	for i := range s {
+		if p, tag := s[i].a.Load(); p != &vals[i] || tag != 1 {
+			t.Fatalf("element %d: Load got (%p, %d), want (%p, 1)", i, p, tag, &vals[i])
+		}
+		if p, tag := s[i].a.CompareExchange(&vals[i], 1, nil); p != &vals[i] || tag != 1 {
+			t.Fatalf("element %d: CompareExchange got (%p, %d)", i, p, tag)
+		}
+		if p, tag := s[i].a.LoadAcquire(); p != nil || tag != 2 {
+			t.Fatalf("element %d: LoadAcquire got (%p, %d), want (nil, 2)", i, p, tag)
+		}
+		if s[i].pad != ^uint64(0) {
+			t.Fatalf("element %d: neighbouring field overwritten: %#x", i, s[i].pad)
+		}
+	}
+}
+
+func TestTaggedPointerTreiberStackGC(t *testing.T) {
+	t.Run("aligned", func(t *testing.T) {
+		testTreiberStackGC(t, new(atomix.TaggedPointer[stackNode]))
+	})
+	t.Run("offset8", func(t *testing.T) {
+		s := new(struct {
+			_   uint64
+			top atomix.TaggedPointer[stackNode]
+		})
+		testTreiberStackGC(t, &s.top)
+	})
+}
+
+func testTreiberStackGC(t *testing.T, top *atomix.TaggedPointer[stackNode]) {
+	const goroutines, iterations = 4, 5000
+
+	push := func(n *stackNode) {
+		for {
+			old, tag := top.LoadAcquire()
+			n.next = old
+			if top.CompareAndSwapRelease(old, tag, n) {
+				return
+			}
+		}
+	}
+	pop := func() *stackNode {
+		for {
+			old, tag := top.LoadAcquire()
+			if old == nil {
+				return nil
+			}
+			if top.CompareAndSwapAcqRel(old, tag, old.next) {
+				return old
+			}
+		}
+	}
+
+	stop := make(chan struct{})
+	gcDone := make(chan struct{})
+	go func() {
+		defer close(gcDone)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				runtime.GC()
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				push(&stackNode{value: g*iterations + i})
+				if i%2 == 1 {
+					if n := pop(); n == nil {
+						t.Error("pop from non-empty stack returned nil")
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(stop)
+	<-gcDone
+
+	seen := make(map[int]bool)
+	for n := pop(); n != nil; n = pop() {
+		if seen[n.value] {
+			t.Fatalf("value %d popped twice", n.value)
+		}
+		seen[n.value] = true
+	}
+	if want := goroutines * iterations / 2; len(seen) != want {
+		t.Fatalf("remaining nodes: got %d, want %d", len(seen), want)
+	}
+}
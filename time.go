@@ -0,0 +1,245 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "time"
+
+// Load atomically loads and returns the value with relaxed ordering.
+func (a *Time) Load() time.Time {
+	return bitsTime(a.v.LoadRelaxed())
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+func (a *Time) LoadRelaxed() time.Time {
+	return bitsTime(a.v.LoadRelaxed())
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+func (a *Time) LoadAcquire() time.Time {
+	return bitsTime(a.v.LoadAcquire())
+}
+
+// Store atomically stores val with relaxed ordering.
+func (a *Time) Store(val time.Time) {
+	a.v.StoreRelaxed(timeBits(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+func (a *Time) StoreRelaxed(val time.Time) {
+	a.v.StoreRelaxed(timeBits(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+func (a *Time) StoreRelease(val time.Time) {
+	a.v.StoreRelease(timeBits(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+func (a *Time) Swap(new time.Time) time.Time {
+	return bitsTime(a.v.SwapAcqRel(timeBits(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+func (a *Time) SwapRelaxed(new time.Time) time.Time {
+	return bitsTime(a.v.SwapRelaxed(timeBits(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+func (a *Time) SwapAcquire(new time.Time) time.Time {
+	return bitsTime(a.v.SwapAcquire(timeBits(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+func (a *Time) SwapRelease(new time.Time) time.Time {
+	return bitsTime(a.v.SwapRelease(timeBits(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+func (a *Time) SwapAcqRel(new time.Time) time.Time {
+	return bitsTime(a.v.SwapAcqRel(timeBits(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+func (a *Time) CompareAndSwap(old, new time.Time) bool {
+	return a.v.CompareAndSwapAcqRel(timeBits(old), timeBits(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+func (a *Time) CompareAndSwapRelaxed(old, new time.Time) bool {
+	return a.v.CompareAndSwapRelaxed(timeBits(old), timeBits(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+func (a *Time) CompareAndSwapAcquire(old, new time.Time) bool {
+	return a.v.CompareAndSwapAcquire(timeBits(old), timeBits(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+func (a *Time) CompareAndSwapRelease(old, new time.Time) bool {
+	return a.v.CompareAndSwapRelease(timeBits(old), timeBits(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+func (a *Time) CompareAndSwapAcqRel(old, new time.Time) bool {
+	return a.v.CompareAndSwapAcqRel(timeBits(old), timeBits(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+func (a *Time) CompareExchange(old, new time.Time) time.Time {
+	return bitsTime(a.v.CompareExchangeAcqRel(timeBits(old), timeBits(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+func (a *Time) CompareExchangeRelaxed(old, new time.Time) time.Time {
+	return bitsTime(a.v.CompareExchangeRelaxed(timeBits(old), timeBits(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+func (a *Time) CompareExchangeAcquire(old, new time.Time) time.Time {
+	return bitsTime(a.v.CompareExchangeAcquire(timeBits(old), timeBits(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+func (a *Time) CompareExchangeRelease(old, new time.Time) time.Time {
+	return bitsTime(a.v.CompareExchangeRelease(timeBits(old), timeBits(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+func (a *Time) CompareExchangeAcqRel(old, new time.Time) time.Time {
+	return bitsTime(a.v.CompareExchangeAcqRel(timeBits(old), timeBits(new)))
+}
+
+// Max atomically stores the maximum (later) of current and val, returning the old value.
+// Uses acquire-release ordering.
+func (a *Time) Max(val time.Time) time.Time {
+	return bitsTime(a.v.MaxAcqRel(timeBits(val)))
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+func (a *Time) MaxRelaxed(val time.Time) time.Time {
+	return bitsTime(a.v.MaxRelaxed(timeBits(val)))
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+func (a *Time) MaxAcquire(val time.Time) time.Time {
+	return bitsTime(a.v.MaxAcquire(timeBits(val)))
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+func (a *Time) MaxRelease(val time.Time) time.Time {
+	return bitsTime(a.v.MaxRelease(timeBits(val)))
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+func (a *Time) MaxAcqRel(val time.Time) time.Time {
+	return bitsTime(a.v.MaxAcqRel(timeBits(val)))
+}
+
+// Min atomically stores the minimum (earlier) of current and val, returning the old value.
+// An unset Time is replaced by any val, and a zero val never replaces a set one.
+// Uses acquire-release ordering.
+func (a *Time) Min(val time.Time) time.Time {
+	return bitsTime(a.min(AcqRel, timeBits(val)))
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+func (a *Time) MinRelaxed(val time.Time) time.Time {
+	return bitsTime(a.min(Relaxed, timeBits(val)))
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+func (a *Time) MinAcquire(val time.Time) time.Time {
+	return bitsTime(a.min(Acquire, timeBits(val)))
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+func (a *Time) MinRelease(val time.Time) time.Time {
+	return bitsTime(a.min(Release, timeBits(val)))
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+func (a *Time) MinAcqRel(val time.Time) time.Time {
+	return bitsTime(a.min(AcqRel, timeBits(val)))
+}
+
+// Before reports whether the stored time is before u.
+// An unset Time is before any set time. The load uses relaxed ordering.
+func (a *Time) Before(u time.Time) bool {
+	return a.v.LoadRelaxed() < timeBits(u)
+}
+
+// After reports whether the stored time is after u.
+// The load uses relaxed ordering.
+func (a *Time) After(u time.Time) bool {
+	return a.v.LoadRelaxed() > timeBits(u)
+}
+
+// StoreIfAfter atomically stores val if it is after the stored time and
+// reports whether it did. Uses acquire-release ordering.
+// This is the usual way to advance a last-seen timestamp; the first
+// non-zero val always applies.
+func (a *Time) StoreIfAfter(val time.Time) bool {
+	b := timeBits(val)
+	return a.v.MaxAcqRel(b) < b
+}
+
+// StoreIfAfterRelaxed is StoreIfAfter with relaxed ordering.
+func (a *Time) StoreIfAfterRelaxed(val time.Time) bool {
+	b := timeBits(val)
+	return a.v.MaxRelaxed(b) < b
+}
+
+// StoreIfBefore atomically stores val if it is before the stored time, or
+// if no time is stored, and reports whether it did. A zero val never
+// applies. Uses acquire-release ordering.
+// This is the usual way to pull in a deadline.
+func (a *Time) StoreIfBefore(val time.Time) bool {
+	b := timeBits(val)
+	prev := a.min(AcqRel, b)
+	return b != 0 && (prev == 0 || b < prev)
+}
+
+// StoreIfBeforeRelaxed is StoreIfBefore with relaxed ordering.
+func (a *Time) StoreIfBeforeRelaxed(val time.Time) bool {
+	b := timeBits(val)
+	prev := a.min(Relaxed, b)
+	return b != 0 && (prev == 0 || b < prev)
+}
+
+// min stores b if it is set and earlier than the stored time or nothing is
+// stored, and returns the previous bits. Unlike MinUint64 it treats 0,
+// the unset Time, as later than every time.
+func (a *Time) min(o MemoryOrder, b uint64) uint64 {
+	cur := o.LoadUint64(&a.v.v)
+	for b != 0 && (cur == 0 || b < cur) {
+		prev := o.CompareExchangeUint64(&a.v.v, cur, b)
+		if prev == cur {
+			break
+		}
+		cur = prev
+	}
+	return cur
+}
+
+// timeBits encodes t as its Unix nanoseconds with the sign bit flipped, so
+// unsigned order is time order and the zero time.Time, encoded as 0, comes
+// before every other time. math.MinInt64 nanoseconds, in 1677, is outside
+// the supported range and is the only time that also encodes as 0.
+func timeBits(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano()) ^ 1<<63
+}
+
+// bitsTime decodes the result of timeBits, mapping 0 to the zero time.Time.
+func bitsTime(b uint64) time.Time {
+	if b == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(b^1<<63))
+}
@@ -0,0 +1,242 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Duration Tests
+// =============================================================================
+
+func TestDuration(t *testing.T) {
+	var d atomix.Duration
+	if got := d.Load(); got != 0 {
+		t.Fatalf("zero value: got %v, want 0", got)
+	}
+	d.Store(time.Second)
+	if got := d.Add(500 * time.Millisecond); got != 1500*time.Millisecond {
+		t.Fatalf("Add: got %v, want 1.5s", got)
+	}
+	if got := d.SubRelease(time.Second); got != 500*time.Millisecond {
+		t.Fatalf("SubRelease: got %v, want 500ms", got)
+	}
+	if old := d.Max(time.Minute); old != 500*time.Millisecond {
+		t.Fatalf("Max: got %v, want 500ms", old)
+	}
+	if old := d.MinAcquire(time.Second); old != time.Minute {
+		t.Fatalf("MinAcquire: got %v, want 1m", old)
+	}
+	if !d.CompareAndSwap(time.Second, 2*time.Second) {
+		t.Fatal("CompareAndSwap should succeed")
+	}
+	if prev := d.CompareExchangeRelaxed(time.Second, 0); prev != 2*time.Second {
+		t.Fatalf("CompareExchangeRelaxed: got %v, want 2s", prev)
+	}
+	if old := d.SwapAcqRel(-time.Hour); old != 2*time.Second {
+		t.Fatalf("SwapAcqRel: got %v, want 2s", old)
+	}
+	if got := d.LoadAcquire(); got != -time.Hour {
+		t.Fatalf("LoadAcquire: got %v, want -1h", got)
+	}
+}
+
+func TestDurationConcurrentAdd(t *testing.T) {
+	const goroutines, iterations = 8, 1000
+	var total atomix.Duration
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				total.AddRelaxed(time.Microsecond)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := total.Load(); got != goroutines*iterations*time.Microsecond {
+		t.Fatalf("total: got %v, want %v", got, goroutines*iterations*time.Microsecond)
+	}
+}
+
+// =============================================================================
+// Time Tests
+// =============================================================================
+
+func TestTimeZeroValue(t *testing.T) {
+	var ts atomix.Time
+	if got := ts.Load(); !got.IsZero() {
+		t.Fatalf("zero value: got %v, want zero time", got)
+	}
+	ts.Store(time.Unix(100, 5))
+	ts.StoreRelease(time.Time{})
+	if got := ts.LoadAcquire(); !got.IsZero() {
+		t.Fatalf("Store(zero): got %v, want zero time", got)
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	var ts atomix.Time
+	now := time.Now()
+	ts.Store(now)
+	got := ts.Load()
+	if !got.Equal(now) {
+		t.Fatalf("Load: got %v, want %v", got, now)
+	}
+	if got.UnixNano() != now.UnixNano() {
+		t.Fatalf("UnixNano: got %d, want %d", got.UnixNano(), now.UnixNano())
+	}
+
+	// The location is not stored; the instant is.
+	utc := now.In(time.UTC)
+	if !ts.CompareAndSwap(utc, now.Add(time.Second)) {
+		t.Fatal("CompareAndSwap should match the same instant in another location")
+	}
+	if old := ts.SwapRelaxed(now); !old.Equal(now.Add(time.Second)) {
+		t.Fatalf("SwapRelaxed: got %v", old)
+	}
+	if prev := ts.CompareExchangeAcquire(now.Add(time.Hour), now); !prev.Equal(now) {
+		t.Fatalf("CompareExchangeAcquire failure: got %v, want %v", prev, now)
+	}
+}
+
+func TestTimeOrdering(t *testing.T) {
+	base := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+	var ts atomix.Time
+	ts.Store(base)
+
+	if !ts.Before(base.Add(time.Nanosecond)) || ts.Before(base) {
+		t.Fatal("Before: unexpected result")
+	}
+	if !ts.After(base.Add(-time.Nanosecond)) || ts.After(base) {
+		t.Fatal("After: unexpected result")
+	}
+
+	if ts.StoreIfAfter(base.Add(-time.Second)) {
+		t.Fatal("StoreIfAfter should not store an earlier time")
+	}
+	if ts.StoreIfAfterRelaxed(base) {
+		t.Fatal("StoreIfAfterRelaxed should not store an equal time")
+	}
+	if !ts.StoreIfAfter(base.Add(time.Second)) {
+		t.Fatal("StoreIfAfter should store a later time")
+	}
+	if !ts.StoreIfBefore(base) || ts.StoreIfBeforeRelaxed(base.Add(time.Minute)) {
+		t.Fatal("StoreIfBefore: unexpected result")
+	}
+	if got := ts.Load(); !got.Equal(base) {
+		t.Fatalf("Load: got %v, want %v", got, base)
+	}
+
+	if old := ts.MaxRelease(base.Add(time.Hour)); !old.Equal(base) {
+		t.Fatalf("MaxRelease: got %v, want %v", old, base)
+	}
+	if old := ts.Min(base.Add(-time.Hour)); !old.Equal(base.Add(time.Hour)) {
+		t.Fatalf("Min: got %v", old)
+	}
+	if got := ts.LoadRelaxed(); !got.Equal(base.Add(-time.Hour)) {
+		t.Fatalf("LoadRelaxed: got %v", got)
+	}
+}
+
+func TestTimeUnset(t *testing.T) {
+	deadline := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var ts atomix.Time
+	if !ts.StoreIfBefore(deadline) {
+		t.Fatal("StoreIfBefore should set the first deadline on a zero Time")
+	}
+	if ts.StoreIfBeforeRelaxed(time.Time{}) || !ts.Load().Equal(deadline) {
+		t.Fatal("StoreIfBefore(zero) should not clear a deadline")
+	}
+	if !ts.StoreIfBefore(deadline.Add(-time.Second)) || ts.StoreIfBefore(deadline) {
+		t.Fatal("StoreIfBefore: unexpected result after the first deadline")
+	}
+
+	var lo atomix.Time
+	if old := lo.MinAcquire(deadline); !old.IsZero() || !lo.Load().Equal(deadline) {
+		t.Fatalf("Min on a zero Time: old %v, now %v", old, lo.Load())
+	}
+	if old := lo.Min(time.Time{}); !old.Equal(deadline) || !lo.Load().Equal(deadline) {
+		t.Fatalf("Min(zero): old %v, now %v", old, lo.Load())
+	}
+
+	// The epoch and earlier times are ordinary values, distinct from unset.
+	epoch, before := time.Unix(0, 0), time.Unix(-86400, 0)
+	var hi atomix.Time
+	if !hi.StoreIfAfter(before) || !hi.Load().Equal(before) {
+		t.Fatalf("StoreIfAfter on a zero Time: got %v", hi.Load())
+	}
+	if old := hi.Max(epoch); !old.Equal(before) {
+		t.Fatalf("Max: old %v, want %v", old, before)
+	}
+	if got := hi.Load(); got.IsZero() || !got.Equal(epoch) {
+		t.Fatalf("epoch loaded as %v", got)
+	}
+	if !hi.Before(epoch.Add(1)) || !hi.After(before) {
+		t.Fatal("Before/After around the epoch: unexpected result")
+	}
+	if !lo.After(epoch) || new(atomix.Time).After(before) {
+		t.Fatal("After: an unset Time should order before every set time")
+	}
+}
+
+func TestTimeLastSeenConcurrent(t *testing.T) {
+	const goroutines, iterations = 8, 1000
+	base := time.Unix(1_700_000_000, 0)
+	var lastSeen atomix.Time
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range iterations {
+				lastSeen.StoreIfAfter(base.Add(time.Duration(g*iterations+i) * time.Millisecond))
+			}
+		}()
+	}
+	wg.Wait()
+	want := base.Add(time.Duration(goroutines*iterations-1) * time.Millisecond)
+	if got := lastSeen.Load(); !got.Equal(want) {
+		t.Fatalf("lastSeen: got %v, want %v", got, want)
+	}
+}
+
+func TestTimePlacement(t *testing.T) {
+	buf := make([]byte, 512)
+	alloc := atomix.NewAllocator(buf)
+	alloc.Skip(3)
+	d := alloc.Duration()
+	ts := alloc.Time()
+	dp := alloc.CacheAlignedDuration()
+	tp := alloc.CacheAlignedTime()
+	for _, p := range []unsafe.Pointer{unsafe.Pointer(d), unsafe.Pointer(ts)} {
+		if uintptr(p)&7 != 0 {
+			t.Fatal("Allocator: Duration/Time misaligned")
+		}
+	}
+	for _, p := range []unsafe.Pointer{unsafe.Pointer(dp), unsafe.Pointer(tp)} {
+		if uintptr(p)%atomix.CacheLineSize != 0 {
+			t.Fatal("Allocator: padded Duration/Time misaligned")
+		}
+	}
+	d.Store(time.Second)
+	dp.Store(time.Minute)
+	now := time.Now()
+	ts.Store(now)
+	tp.Store(now)
+	if d.Load() != time.Second || dp.Load() != time.Minute || !ts.Load().Equal(now) || !tp.Load().Equal(now) {
+		t.Fatal("placed values clobbered")
+	}
+	if unsafe.Sizeof(atomix.TimePadded{}) != atomix.CacheLineSize {
+		t.Fatalf("TimePadded size: got %d", unsafe.Sizeof(atomix.TimePadded{}))
+	}
+}
@@ -0,0 +1,160 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Named-Type Integer Tests
+// =============================================================================
+
+type connState uint32
+
+const (
+	stateIdle connState = iota
+	stateActive
+	stateClosed
+)
+
+type epoch int64
+
+type slotIndex uintptr
+
+func TestUint32OfNamedType(t *testing.T) {
+	var s atomix.Uint32Of[connState]
+	if got := s.Load(); got != stateIdle {
+		t.Fatalf("zero value: got %v, want %v", got, stateIdle)
+	}
+	if !s.CompareAndSwap(stateIdle, stateActive) {
+		t.Fatal("CompareAndSwap should succeed")
+	}
+	if s.CompareAndSwapAcquire(stateIdle, stateClosed) {
+		t.Fatal("CompareAndSwapAcquire should fail on mismatch")
+	}
+	if prev := s.CompareExchangeRelease(stateActive, stateClosed); prev != stateActive {
+		t.Fatalf("CompareExchangeRelease: got %v, want %v", prev, stateActive)
+	}
+	if old := s.SwapAcqRel(stateIdle); old != stateClosed {
+		t.Fatalf("SwapAcqRel: got %v, want %v", old, stateClosed)
+	}
+	if old := s.Or(1 << 4); old != stateIdle {
+		t.Fatalf("Or: got %v, want %v", old, stateIdle)
+	}
+	if old := s.AndRelaxed(^connState(1 << 4)); old != 1<<4 {
+		t.Fatalf("AndRelaxed: got %v, want %v", old, connState(1<<4))
+	}
+	if old := s.XorRelease(3); old != 0 {
+		t.Fatalf("XorRelease: got %v, want 0", old)
+	}
+	if got := s.Sub(4); got != ^connState(0) {
+		t.Fatalf("Sub wraparound: got %v, want %v", got, ^connState(0))
+	}
+	s.StoreRelease(stateActive)
+	if old := s.MaxAcquire(stateClosed); old != stateActive {
+		t.Fatalf("MaxAcquire: got %v, want %v", old, stateActive)
+	}
+	if old := s.MinRelease(stateIdle); old != stateClosed {
+		t.Fatalf("MinRelease: got %v, want %v", old, stateClosed)
+	}
+	if got := s.LoadAcquire(); got != stateIdle {
+		t.Fatalf("LoadAcquire: got %v, want %v", got, stateIdle)
+	}
+}
+
+func TestInt64OfSigned(t *testing.T) {
+	var e atomix.Int64Of[epoch]
+	e.Store(-5)
+	if old := e.Max(-10); old != -5 {
+		t.Fatalf("Max: got %d, want -5", old)
+	}
+	if old := e.MaxRelaxed(3); old != -5 {
+		t.Fatalf("MaxRelaxed: got %d, want -5", old)
+	}
+	if old := e.MinAcqRel(-7); old != 3 {
+		t.Fatalf("MinAcqRel: got %d, want 3", old)
+	}
+	if got := e.AddRelease(10); got != 3 {
+		t.Fatalf("AddRelease: got %d, want 3", got)
+	}
+	if got := e.SubAcquire(5); got != -2 {
+		t.Fatalf("SubAcquire: got %d, want -2", got)
+	}
+	if old := e.SwapRelaxed(epoch(1) << 40); old != -2 {
+		t.Fatalf("SwapRelaxed: got %d, want -2", old)
+	}
+	if got := e.LoadRelaxed(); got != epoch(1)<<40 {
+		t.Fatalf("LoadRelaxed: got %d", got)
+	}
+}
+
+func TestInt32OfAndUint64Of(t *testing.T) {
+	type level int32
+	var l atomix.Int32Of[level]
+	if got := l.AddRelaxed(-3); got != -3 {
+		t.Fatalf("Int32Of.AddRelaxed: got %d, want -3", got)
+	}
+	if old := l.Min(-10); old != -3 {
+		t.Fatalf("Int32Of.Min: got %d, want -3", old)
+	}
+	if !l.CompareAndSwapRelaxed(-10, 0) {
+		t.Fatal("Int32Of.CompareAndSwapRelaxed should succeed")
+	}
+
+	type seq uint64
+	var s atomix.Uint64Of[seq]
+	if got := s.AddAcqRel(1 << 40); got != 1<<40 {
+		t.Fatalf("Uint64Of.AddAcqRel: got %d", got)
+	}
+	if old := s.MinRelaxed(1); old != 1<<40 {
+		t.Fatalf("Uint64Of.MinRelaxed: got %d", old)
+	}
+	if prev := s.CompareExchangeAcqRel(1, 2); prev != 1 {
+		t.Fatalf("Uint64Of.CompareExchangeAcqRel: got %d, want 1", prev)
+	}
+}
+
+func TestPlaceAlignedOf(t *testing.T) {
+	buf := make([]byte, 64)
+	off := 1
+	n, s := atomix.PlaceAlignedUint32Of[connState](buf, off)
+	off += n
+	s.Store(stateClosed)
+	n, e := atomix.PlaceAlignedInt64Of[epoch](buf, off)
+	off += n
+	e.Store(-1)
+	n, i := atomix.PlaceAlignedUintptrOf[slotIndex](buf, off)
+	off += n
+	i.Store(42)
+	_, l := atomix.PlaceAlignedInt32Of[int32](buf, off)
+	l.Store(-9)
+
+	if s.Load() != stateClosed || e.Load() != -1 || i.Load() != 42 || l.Load() != -9 {
+		t.Fatalf("placed values: got (%v, %d, %d, %d)", s.Load(), e.Load(), i.Load(), l.Load())
+	}
+}
+
+func TestUintptrOfConcurrent(t *testing.T) {
+	const goroutines, iterations = 8, 1000
+	var idx atomix.UintptrOf[slotIndex]
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				idx.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := idx.Load(); got != goroutines*iterations {
+		t.Fatalf("final: got %d, want %d", got, goroutines*iterations)
+	}
+}
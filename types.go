@@ -15,6 +15,58 @@ type Bool struct {
 	v uint32
 }
 
+// Int8 represents an atomic 8-bit signed integer.
+//
+// The zero value is 0. Int8 is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Operations use native byte instructions on amd64 and arm64. Elsewhere they
+// run a CAS loop on the aligned 32-bit word containing the value, so that
+// whole word must be addressable memory; neighbouring bytes are preserved.
+type Int8 struct {
+	_ noCopy
+	v uint8
+}
+
+// Uint8 represents an atomic 8-bit unsigned integer.
+//
+// The zero value is 0. Uint8 is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Operations use native byte instructions on amd64 and arm64. Elsewhere they
+// run a CAS loop on the aligned 32-bit word containing the value, so that
+// whole word must be addressable memory; neighbouring bytes are preserved.
+type Uint8 struct {
+	_ noCopy
+	v uint8
+}
+
+// Int16 represents an atomic 16-bit signed integer.
+//
+// The zero value is 0. Int16 is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Operations use native halfword instructions on amd64 and arm64. Elsewhere they
+// run a CAS loop on the aligned 32-bit word containing the value, so that
+// whole word must be addressable memory; neighbouring bytes are preserved.
+type Int16 struct {
+	_ noCopy
+	v uint16
+}
+
+// Uint16 represents an atomic 16-bit unsigned integer.
+//
+// The zero value is 0. Uint16 is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Operations use native halfword instructions on amd64 and arm64. Elsewhere they
+// run a CAS loop on the aligned 32-bit word containing the value, so that
+// whole word must be addressable memory; neighbouring bytes are preserved.
+type Uint16 struct {
+	_ noCopy
+	v uint16
+}
+
 // Int32 represents an atomic 32-bit signed integer.
 //
 // The zero value is 0. Int32 is safe for concurrent use.
@@ -51,6 +103,121 @@ type Uint64 struct {
 	v uint64
 }
 
+// Int32Of represents an atomic 32-bit signed integer of a named type T,
+// such as an enum or epoch declared as `type State int32`.
+//
+// Int32Of has the layout and method set of [Int32], but takes and returns
+// T directly. The zero value is 0. Int32Of is safe for concurrent use.
+// Must not be copied after first use.
+type Int32Of[T ~int32] struct {
+	_ noCopy
+	v int32
+}
+
+// Uint32Of represents an atomic 32-bit unsigned integer of a named type T,
+// such as an enum or epoch declared as `type State uint32`.
+//
+// Uint32Of has the layout and method set of [Uint32], but takes and returns
+// T directly. The zero value is 0. Uint32Of is safe for concurrent use.
+// Must not be copied after first use.
+type Uint32Of[T ~uint32] struct {
+	_ noCopy
+	v uint32
+}
+
+// Int64Of represents an atomic 64-bit signed integer of a named type T,
+// such as an enum or epoch declared as `type State int64`.
+//
+// Int64Of has the layout and method set of [Int64], but takes and returns
+// T directly. The zero value is 0. Int64Of is safe for concurrent use.
+// Must not be copied after first use.
+type Int64Of[T ~int64] struct {
+	_ noCopy
+	v int64
+}
+
+// Uint64Of represents an atomic 64-bit unsigned integer of a named type T,
+// such as an enum or epoch declared as `type State uint64`.
+//
+// Uint64Of has the layout and method set of [Uint64], but takes and returns
+// T directly. The zero value is 0. Uint64Of is safe for concurrent use.
+// Must not be copied after first use.
+type Uint64Of[T ~uint64] struct {
+	_ noCopy
+	v uint64
+}
+
+// UintptrOf represents an atomic pointer-sized unsigned integer of a named type T,
+// such as an enum or epoch declared as `type State uintptr`.
+//
+// UintptrOf has the layout and method set of [Uintptr], but takes and returns
+// T directly. The zero value is 0. UintptrOf is safe for concurrent use.
+// Must not be copied after first use.
+type UintptrOf[T ~uintptr] struct {
+	_ noCopy
+	v uintptr
+}
+
+// Float32 represents an atomic 32-bit floating-point number.
+//
+// The zero value is +0. Float32 is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Values are stored as their IEEE 754 bit patterns. CompareAndSwap and
+// CompareExchange compare bitwise: a NaN matches only the identical NaN
+// payload, and +0 and -0 are distinct. Max and Min treat -0 as smaller than
+// +0; a NaN operand (stored or supplied) wins and is stored unchanged.
+type Float32 struct {
+	_ noCopy
+	v uint32
+}
+
+// Float64 represents an atomic 64-bit floating-point number.
+//
+// The zero value is +0. Float64 is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Values are stored as their IEEE 754 bit patterns. CompareAndSwap and
+// CompareExchange compare bitwise: a NaN matches only the identical NaN
+// payload, and +0 and -0 are distinct. Max and Min treat -0 as smaller than
+// +0; a NaN operand (stored or supplied) wins and is stored unchanged.
+type Float64 struct {
+	_ noCopy
+	v uint64
+}
+
+// Duration represents an atomic time.Duration.
+//
+// The zero value is 0. Duration is safe for concurrent use.
+// Must not be copied after first use.
+type Duration struct {
+	v Int64
+}
+
+// Time represents an atomic wall-clock time.
+//
+// The zero value is the zero time.Time. Time is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Only the instant is kept: the location and any monotonic clock reading
+// are dropped, and loaded values are in the local time zone. Times must lie
+// within the range of [time.Time.UnixNano] (years 1678 to 2262).
+//
+// The time is stored in one Uint64 word as its Unix nanoseconds with the
+// sign bit flipped, uint64(t.UnixNano()) ^ 1<<63, and the zero time.Time as
+// 0. Unsigned order of the word is time order, so comparisons, Max, and Min
+// work on the word directly, and the all-zero Time is the zero time.Time
+// rather than the Unix epoch, as a plain Int64 of Unix nanoseconds would be.
+//
+// The zero time.Time means unset and is kept apart from every other time,
+// the Unix epoch and earlier times included. Like time.Time it orders
+// before every set time, so Max and StoreIfAfter replace it; Min and
+// StoreIfBefore instead treat it as later than every time, so the first
+// deadline pulled in on a zero Time applies.
+type Time struct {
+	v Uint64 // uint64(UnixNano) ^ 1<<63; 0 is the zero time.Time
+}
+
 // Uintptr represents an atomic pointer-sized unsigned integer.
 //
 // The zero value is 0. Uintptr is safe for concurrent use.
@@ -69,16 +236,150 @@ type Pointer[T any] struct {
 	v unsafe.Pointer
 }
 
+// KCASUint64 represents an atomic 64-bit unsigned integer that a [KCAS] can
+// update together with other words.
+//
+// The zero value is 0. KCASUint64 is safe for concurrent use.
+// Must not be copied after first use.
+//
+// The word points at an immutable node holding the value or describing a
+// KCAS in flight, so any uint64 is a valid value, and Load, Store and
+// CompareAndSwap account for a KCAS in flight. Store and CompareAndSwap
+// allocate the new node; use [Uint64] for words outside any KCAS.
+//
+// KCAS runs on this dedicated type rather than on [Uint64] words. Resolving
+// descriptors in a Uint64 would make every operation on every Uint64 check
+// for them, raw [MemoryOrder] accesses through a *uint64 included, and the
+// marker would take values away from the word. A separate type keeps Uint64
+// single-instruction and lets a KCAS word hold any uint64. To take part in
+// KCAS, declare the word as KCASUint64 in place of Uint64.
+type KCASUint64 struct {
+	_ noCopy
+	p unsafe.Pointer // *kcasNode
+}
+
+// KCASPointer represents an atomic pointer to a value of type T that a
+// [KCAS] can update together with other words.
+//
+// The zero value is nil. KCASPointer is safe for concurrent use.
+// Must not be copied after first use.
+//
+// Like [KCASUint64], the word points at an immutable node, so any pointer,
+// including one with the low bit set, is a valid value. Use [Pointer] for
+// words outside any KCAS; KCAS does not run on Pointer words for the same
+// reasons it does not run on Uint64 words.
+type KCASPointer[T any] struct {
+	_ noCopy
+	p unsafe.Pointer // *kcasNode
+}
+
+// TaggedPointer represents an atomic pointer to a value of type T paired
+// with a 64-bit tag that every successful CompareAndSwap increments, so a
+// pointer that is freed and reused between a Load and a CompareAndSwap is
+// still detected (ABA).
+//
+// The zero value is (nil, 0). TaggedPointer is safe for concurrent use.
+// Must not be copied after first use.
+//
+// The pointer and tag are updated together with one 128-bit CAS, which
+// requires 16-byte alignment. TaggedPointer is self-aligning: the tag sits
+// between two pointer words, and the aligned 16 bytes are either the first
+// pointer word and the tag or the tag and the second pointer word, so any
+// declared instance, struct field or slice element uses the native CAS where
+// the platform has one. [TaggedPointer.IsLockFree] reports whether it does.
+//
+// GC safety: both pointer words are typed pointer fields, so the garbage
+// collector always scans the one in use (the other stays nil), and a 128-bit
+// CAS updates it in a single access, so the collector never observes a torn
+// value. Because the CAS bypasses the compiler's write barrier, each
+// CompareAndSwap first runs the runtime barrier through
+// [sync/atomic.CompareAndSwapPointer] with the new pointer as both operands:
+// it shades the current and the new pointer and leaves the word unchanged.
+// Results are converted back to *T inside nosplit functions, which cannot be
+// preempted for a stack scan while the pointer is held only as an integer.
+type TaggedPointer[T any] struct {
+	_   noCopy
+	_   [0]uint64
+	p0  unsafe.Pointer // pointer word when a is 16-byte aligned
+	_   [8 - unsafe.Sizeof(uintptr(0))]byte
+	tag uint64
+	p1  unsafe.Pointer // pointer word when a is 8 bytes off
+}
+
+// Atomic represents an atomic value of an arbitrary type T.
+//
+// The zero value holds the zero value of T. Atomic is safe for concurrent
+// use. Must not be copied after first use.
+//
+// When T is 4, 8, or 16 bytes and contains no pointers, operations use the
+// native 32/64/128-bit instructions. Otherwise they serialize through a
+// striped lock table; [Atomic.IsLockFree] reports which path T uses.
+//
+// Atomic is self-aligning: 8 spare bytes after the value let a native T sit
+// at a naturally aligned address wherever the Atomic is declared, so a
+// 16-byte T embedded at an offset of 8 is still lock-free.
+//
+// Values are compared bitwise, so floating-point fields follow the [Float64]
+// rules. Padding bytes in T are cleared on every store and ignored by
+// comparisons.
+type Atomic[T any] struct {
+	_ noCopy
+	_ [0]uint64
+	v T
+	_ [8]byte
+}
+
+// Value represents an atomic interface value with explicit memory ordering,
+// like [sync/atomic.Value] without the implied sequential consistency.
+//
+// The zero value holds nil and uses [TypePanic]. Value is safe for
+// concurrent use. Must not be copied after first use.
+//
+// Each stored value is boxed and published as a single pointer, so a load
+// observes either the old or the new interface value, never a mix of the
+// two. A load reads the box through that pointer, so stores publish with at
+// least release ordering and loads, swaps and compare-and-swaps read with at
+// least acquire ordering. The method suffix can only strengthen that: the
+// Relaxed variants order like the Acquire and Release ones, and every swap
+// and compare-and-swap is AcqRel.
+//
+// Under [TypePanic] and [TypeError] every stored value must be non-nil
+// and of the same concrete type as the first; [TypeAny] accepts any value,
+// including nil.
+type Value struct {
+	_      noCopy
+	p      unsafe.Pointer // *valueBox
+	policy TypePolicy
+}
+
+// wide128 is storage for a 128-bit value. It is large enough that a 16-byte
+// aligned slot fits inside wherever it sits, given the 8-byte alignment of
+// uint64 (4 bytes on 32-bit platforms).
+type wide128 struct {
+	_ [0]uint64
+	b [32 - unsafe.Alignof(uint64(0))]byte
+}
+
+// slot returns the 16-byte aligned slot inside w.
+//
+//go:nosplit
+func (w *wide128) slot() *[16]byte {
+	p := unsafe.Pointer(&w.b)
+	return (*[16]byte)(unsafe.Add(p, -uintptr(p)&15))
+}
+
 // Int128 represents an atomic 128-bit signed integer.
 //
 // The zero value is 0. Int128 is safe for concurrent use.
 // Must not be copied after first use.
 //
-// Int128 requires 16-byte alignment. Use [PlaceAlignedInt128] to ensure
-// proper alignment when embedding in byte slices or shared memory.
+// Int128 is self-aligning: the value lives in a 16-byte aligned slot inside
+// over-allocated storage, so any declared instance, struct field or slice
+// element is safe for 128-bit instructions. [PlaceAlignedInt128] places one
+// whose slot is its first 16 bytes, for layouts shared outside Go.
 type Int128 struct {
 	_ noCopy
-	v [16]byte
+	v wide128
 }
 
 // Uint128 represents an atomic 128-bit unsigned integer.
@@ -86,9 +387,11 @@ type Int128 struct {
 // The zero value is 0. Uint128 is safe for concurrent use.
 // Must not be copied after first use.
 //
-// Uint128 requires 16-byte alignment. Use [PlaceAlignedUint128] to ensure
-// proper alignment when embedding in byte slices or shared memory.
+// Uint128 is self-aligning: the value lives in a 16-byte aligned slot inside
+// over-allocated storage, so any declared instance, struct field or slice
+// element is safe for 128-bit instructions. [PlaceAlignedUint128] places one
+// whose slot is its first 16 bytes, for layouts shared outside Go.
 type Uint128 struct {
 	_ noCopy
-	v [16]byte
+	v wide128
 }
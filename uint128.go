@@ -6,46 +6,65 @@ package atomix
 
 import "code.hybscloud.com/atomix/internal/arch"
 
+// Uint128IsLockFree reports whether 128-bit operations on [Uint128],
+// [Int128] and the 128-bit At functions use a native 16-byte atomic
+// instruction. Where they do not (riscv64, loong64, other architectures,
+// and race builds), every 128-bit operation, loads included, takes a mutex
+// chosen by address from a lock table: still atomic, but blocking, and only
+// among operations of this process.
+func Uint128IsLockFree() bool {
+	return arch.LockFree128
+}
+
+// Uint128Variant names the implementation of 128-bit operations in this
+// process, for diagnostics: "CMPXCHG16B" on amd64, "CASP" or "LDXP/STXP"
+// on arm64, where it is chosen at startup from the CPU features unless a
+// build tag fixes it, and "lock table" elsewhere. The answer never changes
+// while the process runs.
+func Uint128Variant() string {
+	return arch.Variant128()
+}
+
 // Load atomically loads and returns the value with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) Load() (lo, hi uint64) {
-	return arch.LoadUint128Relaxed(&a.v)
+	return arch.LoadUint128Relaxed(a.v.slot())
 }
 
 // LoadRelaxed atomically loads and returns the value with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) LoadRelaxed() (lo, hi uint64) {
-	return arch.LoadUint128Relaxed(&a.v)
+	return arch.LoadUint128Relaxed(a.v.slot())
 }
 
 // LoadAcquire atomically loads and returns the value with acquire ordering.
 //
 //go:nosplit
 func (a *Uint128) LoadAcquire() (lo, hi uint64) {
-	return arch.LoadUint128Acquire(&a.v)
+	return arch.LoadUint128Acquire(a.v.slot())
 }
 
 // Store atomically stores val with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) Store(lo, hi uint64) {
-	arch.StoreUint128Relaxed(&a.v, lo, hi)
+	arch.StoreUint128Relaxed(a.v.slot(), lo, hi)
 }
 
 // StoreRelaxed atomically stores val with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) StoreRelaxed(lo, hi uint64) {
-	arch.StoreUint128Relaxed(&a.v, lo, hi)
+	arch.StoreUint128Relaxed(a.v.slot(), lo, hi)
 }
 
 // StoreRelease atomically stores val with release ordering.
 //
 //go:nosplit
 func (a *Uint128) StoreRelease(lo, hi uint64) {
-	arch.StoreUint128Release(&a.v, lo, hi)
+	arch.StoreUint128Release(a.v.slot(), lo, hi)
 }
 
 // Swap atomically stores new value and returns the old value.
@@ -53,35 +72,35 @@ func (a *Uint128) StoreRelease(lo, hi uint64) {
 //
 //go:nosplit
 func (a *Uint128) Swap(newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return arch.SwapUint128AcqRel(&a.v, newLo, newHi)
+	return arch.SwapUint128AcqRel(a.v.slot(), newLo, newHi)
 }
 
 // SwapRelaxed atomically stores new value and returns the old value with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) SwapRelaxed(newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return arch.SwapUint128Relaxed(&a.v, newLo, newHi)
+	return arch.SwapUint128Relaxed(a.v.slot(), newLo, newHi)
 }
 
 // SwapAcquire atomically stores new value and returns the old value with acquire ordering.
 //
 //go:nosplit
 func (a *Uint128) SwapAcquire(newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return arch.SwapUint128Acquire(&a.v, newLo, newHi)
+	return arch.SwapUint128Acquire(a.v.slot(), newLo, newHi)
 }
 
 // SwapRelease atomically stores new value and returns the old value with release ordering.
 //
 //go:nosplit
 func (a *Uint128) SwapRelease(newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return arch.SwapUint128Release(&a.v, newLo, newHi)
+	return arch.SwapUint128Release(a.v.slot(), newLo, newHi)
 }
 
 // SwapAcqRel atomically stores new value and returns the old value with acquire-release ordering.
 //
 //go:nosplit
 func (a *Uint128) SwapAcqRel(newLo, newHi uint64) (oldLo, oldHi uint64) {
-	return arch.SwapUint128AcqRel(&a.v, newLo, newHi)
+	return arch.SwapUint128AcqRel(a.v.slot(), newLo, newHi)
 }
 
 // CompareAndSwap atomically compares and swaps with acquire-release ordering.
@@ -89,35 +108,35 @@ func (a *Uint128) SwapAcqRel(newLo, newHi uint64) (oldLo, oldHi uint64) {
 //
 //go:nosplit
 func (a *Uint128) CompareAndSwap(oldLo, oldHi, newLo, newHi uint64) bool {
-	return arch.CasUint128AcqRel(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareAndSwapRelaxed(oldLo, oldHi, newLo, newHi uint64) bool {
-	return arch.CasUint128Relaxed(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CasUint128Relaxed(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareAndSwapAcquire(oldLo, oldHi, newLo, newHi uint64) bool {
-	return arch.CasUint128Acquire(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CasUint128Acquire(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareAndSwapRelease atomically compares and swaps with release ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareAndSwapRelease(oldLo, oldHi, newLo, newHi uint64) bool {
-	return arch.CasUint128Release(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CasUint128Release(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareAndSwapAcqRel(oldLo, oldHi, newLo, newHi uint64) bool {
-	return arch.CasUint128AcqRel(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareExchange atomically compares and swaps, returning the old value.
@@ -125,35 +144,35 @@ func (a *Uint128) CompareAndSwapAcqRel(oldLo, oldHi, newLo, newHi uint64) bool {
 //
 //go:nosplit
 func (a *Uint128) CompareExchange(oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return arch.CaxUint128AcqRel(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CaxUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareExchangeRelaxed(oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return arch.CaxUint128Relaxed(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CaxUint128Relaxed(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareExchangeAcquire atomically compares and swaps with acquire ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareExchangeAcquire(oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return arch.CaxUint128Acquire(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CaxUint128Acquire(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareExchangeRelease atomically compares and swaps with release ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareExchangeRelease(oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return arch.CaxUint128Release(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CaxUint128Release(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
 //
 //go:nosplit
 func (a *Uint128) CompareExchangeAcqRel(oldLo, oldHi, newLo, newHi uint64) (lo, hi uint64) {
-	return arch.CaxUint128AcqRel(&a.v, oldLo, oldHi, newLo, newHi)
+	return arch.CaxUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi)
 }
 
 // Add atomically adds (deltaLo, deltaHi) and returns the new value.
@@ -162,13 +181,13 @@ func (a *Uint128) CompareExchangeAcqRel(oldLo, oldHi, newLo, newHi uint64) (lo,
 //go:nosplit
 func (a *Uint128) Add(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
 	for {
-		oldLo, oldHi := arch.LoadUint128Relaxed(&a.v)
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo = oldLo + deltaLo
 		newHi = oldHi + deltaHi
 		if newLo < oldLo { // Carry
 			newHi++
 		}
-		if arch.CasUint128AcqRel(&a.v, oldLo, oldHi, newLo, newHi) {
+		if arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi) {
 			return newLo, newHi
 		}
 	}
@@ -179,13 +198,13 @@ func (a *Uint128) Add(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
 //go:nosplit
 func (a *Uint128) AddRelaxed(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
 	for {
-		oldLo, oldHi := arch.LoadUint128Relaxed(&a.v)
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo = oldLo + deltaLo
 		newHi = oldHi + deltaHi
 		if newLo < oldLo {
 			newHi++
 		}
-		if arch.CasUint128Relaxed(&a.v, oldLo, oldHi, newLo, newHi) {
+		if arch.CasUint128Relaxed(a.v.slot(), oldLo, oldHi, newLo, newHi) {
 			return newLo, newHi
 		}
 	}
@@ -197,13 +216,13 @@ func (a *Uint128) AddRelaxed(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
 //go:nosplit
 func (a *Uint128) Sub(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
 	for {
-		oldLo, oldHi := arch.LoadUint128Relaxed(&a.v)
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo = oldLo - deltaLo
 		newHi = oldHi - deltaHi
 		if newLo > oldLo { // Borrow
 			newHi--
 		}
-		if arch.CasUint128AcqRel(&a.v, oldLo, oldHi, newLo, newHi) {
+		if arch.CasUint128AcqRel(a.v.slot(), oldLo, oldHi, newLo, newHi) {
 			return newLo, newHi
 		}
 	}
@@ -214,13 +233,13 @@ func (a *Uint128) Sub(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
 //go:nosplit
 func (a *Uint128) SubRelaxed(deltaLo, deltaHi uint64) (newLo, newHi uint64) {
 	for {
-		oldLo, oldHi := arch.LoadUint128Relaxed(&a.v)
+		oldLo, oldHi := arch.LoadUint128Relaxed(a.v.slot())
 		newLo = oldLo - deltaLo
 		newHi = oldHi - deltaHi
 		if newLo > oldLo {
 			newHi--
 		}
-		if arch.CasUint128Relaxed(&a.v, oldLo, oldHi, newLo, newHi) {
+		if arch.CasUint128Relaxed(a.v.slot(), oldLo, oldHi, newLo, newHi) {
 			return newLo, newHi
 		}
 	}
@@ -256,12 +275,225 @@ func (a *Uint128) DecRelaxed() (newLo, newHi uint64) {
 	return a.SubRelaxed(1, 0)
 }
 
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) And(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.and128(a.v.slot(), lo, hi)
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) AndRelaxed(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Relaxed.and128(a.v.slot(), lo, hi)
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) AndAcquire(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Acquire.and128(a.v.slot(), lo, hi)
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Uint128) AndRelease(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Release.and128(a.v.slot(), lo, hi)
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) AndAcqRel(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.and128(a.v.slot(), lo, hi)
+}
+
+// AndNot atomically performs bitwise AND NOT (clears the bits set in (lo, hi))
+// and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) AndNot(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.andNot128(a.v.slot(), lo, hi)
+}
+
+// AndNotRelaxed atomically performs bitwise AND NOT with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) AndNotRelaxed(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Relaxed.andNot128(a.v.slot(), lo, hi)
+}
+
+// AndNotAcquire atomically performs bitwise AND NOT with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) AndNotAcquire(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Acquire.andNot128(a.v.slot(), lo, hi)
+}
+
+// AndNotRelease atomically performs bitwise AND NOT with release ordering.
+//
+//go:nosplit
+func (a *Uint128) AndNotRelease(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Release.andNot128(a.v.slot(), lo, hi)
+}
+
+// AndNotAcqRel atomically performs bitwise AND NOT with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) AndNotAcqRel(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.andNot128(a.v.slot(), lo, hi)
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) Or(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.or128(a.v.slot(), lo, hi)
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) OrRelaxed(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Relaxed.or128(a.v.slot(), lo, hi)
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) OrAcquire(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Acquire.or128(a.v.slot(), lo, hi)
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Uint128) OrRelease(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Release.or128(a.v.slot(), lo, hi)
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) OrAcqRel(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.or128(a.v.slot(), lo, hi)
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) Xor(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.xor128(a.v.slot(), lo, hi)
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) XorRelaxed(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Relaxed.xor128(a.v.slot(), lo, hi)
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) XorAcquire(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Acquire.xor128(a.v.slot(), lo, hi)
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Uint128) XorRelease(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Release.xor128(a.v.slot(), lo, hi)
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) XorAcqRel(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.xor128(a.v.slot(), lo, hi)
+}
+
+// Max atomically stores the maximum of current and (lo, hi), returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) Max(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.max128(a.v.slot(), lo, hi, false)
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) MaxRelaxed(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Relaxed.max128(a.v.slot(), lo, hi, false)
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) MaxAcquire(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Acquire.max128(a.v.slot(), lo, hi, false)
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uint128) MaxRelease(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Release.max128(a.v.slot(), lo, hi, false)
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) MaxAcqRel(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.max128(a.v.slot(), lo, hi, false)
+}
+
+// Min atomically stores the minimum of current and (lo, hi), returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) Min(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.min128(a.v.slot(), lo, hi, false)
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) MinRelaxed(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Relaxed.min128(a.v.slot(), lo, hi, false)
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) MinAcquire(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Acquire.min128(a.v.slot(), lo, hi, false)
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uint128) MinRelease(lo, hi uint64) (oldLo, oldHi uint64) {
+	return Release.min128(a.v.slot(), lo, hi, false)
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) MinAcqRel(lo, hi uint64) (oldLo, oldHi uint64) {
+	return AcqRel.min128(a.v.slot(), lo, hi, false)
+}
+
 // Equal atomically loads and compares for equality.
 // Uses acquire ordering.
 //
 //go:nosplit
 func (a *Uint128) Equal(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Acquire(&a.v)
+	alo, ahi := arch.LoadUint128Acquire(a.v.slot())
 	return alo == lo && ahi == hi
 }
 
@@ -269,7 +501,7 @@ func (a *Uint128) Equal(lo, hi uint64) bool {
 //
 //go:nosplit
 func (a *Uint128) EqualRelaxed(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Relaxed(&a.v)
+	alo, ahi := arch.LoadUint128Relaxed(a.v.slot())
 	return alo == lo && ahi == hi
 }
 
@@ -278,16 +510,16 @@ func (a *Uint128) EqualRelaxed(lo, hi uint64) bool {
 //
 //go:nosplit
 func (a *Uint128) Less(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Acquire(&a.v)
-	return ahi < hi || (ahi == hi && alo < lo)
+	alo, ahi := arch.LoadUint128Acquire(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) < 0
 }
 
 // LessRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) LessRelaxed(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Relaxed(&a.v)
-	return ahi < hi || (ahi == hi && alo < lo)
+	alo, ahi := arch.LoadUint128Relaxed(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) < 0
 }
 
 // LessOrEqual atomically loads and returns true if value <= (lo, hi).
@@ -295,16 +527,16 @@ func (a *Uint128) LessRelaxed(lo, hi uint64) bool {
 //
 //go:nosplit
 func (a *Uint128) LessOrEqual(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Acquire(&a.v)
-	return ahi < hi || (ahi == hi && alo <= lo)
+	alo, ahi := arch.LoadUint128Acquire(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) <= 0
 }
 
 // LessOrEqualRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) LessOrEqualRelaxed(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Relaxed(&a.v)
-	return ahi < hi || (ahi == hi && alo <= lo)
+	alo, ahi := arch.LoadUint128Relaxed(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) <= 0
 }
 
 // Greater atomically loads and returns true if value > (lo, hi).
@@ -312,16 +544,16 @@ func (a *Uint128) LessOrEqualRelaxed(lo, hi uint64) bool {
 //
 //go:nosplit
 func (a *Uint128) Greater(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Acquire(&a.v)
-	return ahi > hi || (ahi == hi && alo > lo)
+	alo, ahi := arch.LoadUint128Acquire(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) > 0
 }
 
 // GreaterRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) GreaterRelaxed(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Relaxed(&a.v)
-	return ahi > hi || (ahi == hi && alo > lo)
+	alo, ahi := arch.LoadUint128Relaxed(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) > 0
 }
 
 // GreaterOrEqual atomically loads and returns true if value >= (lo, hi).
@@ -329,14 +561,157 @@ func (a *Uint128) GreaterRelaxed(lo, hi uint64) bool {
 //
 //go:nosplit
 func (a *Uint128) GreaterOrEqual(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Acquire(&a.v)
-	return ahi > hi || (ahi == hi && alo >= lo)
+	alo, ahi := arch.LoadUint128Acquire(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) >= 0
 }
 
 // GreaterOrEqualRelaxed atomically loads and compares with relaxed ordering.
 //
 //go:nosplit
 func (a *Uint128) GreaterOrEqualRelaxed(lo, hi uint64) bool {
-	alo, ahi := arch.LoadUint128Relaxed(&a.v)
-	return ahi > hi || (ahi == hi && alo >= lo)
+	alo, ahi := arch.LoadUint128Relaxed(a.v.slot())
+	return U128{alo, ahi}.Cmp(U128{lo, hi}) >= 0
+}
+
+// LoadValue atomically loads and returns the value as a U128 with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) LoadValue() U128 {
+	lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+	return U128{lo, hi}
+}
+
+// LoadValueRelaxed atomically loads and returns the value as a U128 with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) LoadValueRelaxed() U128 {
+	lo, hi := arch.LoadUint128Relaxed(a.v.slot())
+	return U128{lo, hi}
+}
+
+// LoadValueAcquire atomically loads and returns the value as a U128 with acquire ordering.
+//
+//go:nosplit
+func (a *Uint128) LoadValueAcquire() U128 {
+	lo, hi := arch.LoadUint128Acquire(a.v.slot())
+	return U128{lo, hi}
+}
+
+// StoreValue atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) StoreValue(val U128) {
+	arch.StoreUint128Relaxed(a.v.slot(), val.Lo, val.Hi)
+}
+
+// StoreValueRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint128) StoreValueRelaxed(val U128) {
+	arch.StoreUint128Relaxed(a.v.slot(), val.Lo, val.Hi)
+}
+
+// StoreValueRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Uint128) StoreValueRelease(val U128) {
+	arch.StoreUint128Release(a.v.slot(), val.Lo, val.Hi)
+}
+
+// SwapValue atomically stores new and returns the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) SwapValue(new U128) U128 {
+	lo, hi := arch.SwapUint128AcqRel(a.v.slot(), new.Lo, new.Hi)
+	return U128{lo, hi}
+}
+
+// CompareAndSwapValue atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Uint128) CompareAndSwapValue(old, new U128) bool {
+	return arch.CasUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi)
+}
+
+// CompareExchangeValue atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) CompareExchangeValue(old, new U128) U128 {
+	lo, hi := arch.CaxUint128AcqRel(a.v.slot(), old.Lo, old.Hi, new.Lo, new.Hi)
+	return U128{lo, hi}
+}
+
+// AddValue atomically adds delta and returns the new value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) AddValue(delta U128) U128 {
+	lo, hi := a.Add(delta.Lo, delta.Hi)
+	return U128{lo, hi}
+}
+
+// SubValue atomically subtracts delta and returns the new value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint128) SubValue(delta U128) U128 {
+	lo, hi := a.Sub(delta.Lo, delta.Hi)
+	return U128{lo, hi}
+}
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Uint128) Update(f func(old U128) U128) (old, new U128) {
+	return AcqRel.UpdateUint128(a, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Uint128) UpdateRelaxed(f func(old U128) U128) (old, new U128) {
+	return Relaxed.UpdateUint128(a, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Uint128) UpdateAcquire(f func(old U128) U128) (old, new U128) {
+	return Acquire.UpdateUint128(a, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Uint128) UpdateRelease(f func(old U128) U128) (old, new U128) {
+	return Release.UpdateUint128(a, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Uint128) UpdateAcqRel(f func(old U128) U128) (old, new U128) {
+	return AcqRel.UpdateUint128(a, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Uint128) TryUpdate(f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	return AcqRel.TryUpdateUint128(a, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Uint128) TryUpdateRelaxed(f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	return Relaxed.TryUpdateUint128(a, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Uint128) TryUpdateAcquire(f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	return Acquire.TryUpdateUint128(a, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Uint128) TryUpdateRelease(f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	return Release.TryUpdateUint128(a, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Uint128) TryUpdateAcqRel(f func(old U128) (U128, bool)) (old, new U128, updated bool) {
+	return AcqRel.TryUpdateUint128(a, f)
 }
@@ -0,0 +1,393 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) Load() uint16 {
+	return arch.LoadUint16Relaxed(&a.v)
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) LoadRelaxed() uint16 {
+	return arch.LoadUint16Relaxed(&a.v)
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) LoadAcquire() uint16 {
+	return arch.LoadUint16Acquire(&a.v)
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) Store(val uint16) {
+	arch.StoreUint16Relaxed(&a.v, val)
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) StoreRelaxed(val uint16) {
+	arch.StoreUint16Relaxed(&a.v, val)
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Uint16) StoreRelease(val uint16) {
+	arch.StoreUint16Release(&a.v, val)
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Swap(new uint16) uint16 {
+	return arch.SwapUint16AcqRel(&a.v, new)
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) SwapRelaxed(new uint16) uint16 {
+	return arch.SwapUint16Relaxed(&a.v, new)
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) SwapAcquire(new uint16) uint16 {
+	return arch.SwapUint16Acquire(&a.v, new)
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) SwapRelease(new uint16) uint16 {
+	return arch.SwapUint16Release(&a.v, new)
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) SwapAcqRel(new uint16) uint16 {
+	return arch.SwapUint16AcqRel(&a.v, new)
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Uint16) CompareAndSwap(old, new uint16) bool {
+	return arch.CasUint16AcqRel(&a.v, old, new)
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareAndSwapRelaxed(old, new uint16) bool {
+	return arch.CasUint16Relaxed(&a.v, old, new)
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareAndSwapAcquire(old, new uint16) bool {
+	return arch.CasUint16Acquire(&a.v, old, new)
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareAndSwapRelease(old, new uint16) bool {
+	return arch.CasUint16Release(&a.v, old, new)
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareAndSwapAcqRel(old, new uint16) bool {
+	return arch.CasUint16AcqRel(&a.v, old, new)
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareExchange(old, new uint16) uint16 {
+	return arch.CaxUint16AcqRel(&a.v, old, new)
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareExchangeRelaxed(old, new uint16) uint16 {
+	return arch.CaxUint16Relaxed(&a.v, old, new)
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareExchangeAcquire(old, new uint16) uint16 {
+	return arch.CaxUint16Acquire(&a.v, old, new)
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareExchangeRelease(old, new uint16) uint16 {
+	return arch.CaxUint16Release(&a.v, old, new)
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) CompareExchangeAcqRel(old, new uint16) uint16 {
+	return arch.CaxUint16AcqRel(&a.v, old, new)
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Add(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, delta)
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) AddRelaxed(delta uint16) uint16 {
+	return arch.AddUint16Relaxed(&a.v, delta)
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) AddAcquire(delta uint16) uint16 {
+	return arch.AddUint16Acquire(&a.v, delta)
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) AddRelease(delta uint16) uint16 {
+	return arch.AddUint16Release(&a.v, delta)
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) AddAcqRel(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, delta)
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Sub(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, -delta)
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) SubRelaxed(delta uint16) uint16 {
+	return arch.AddUint16Relaxed(&a.v, -delta)
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) SubAcquire(delta uint16) uint16 {
+	return arch.AddUint16Acquire(&a.v, -delta)
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint16) SubRelease(delta uint16) uint16 {
+	return arch.AddUint16Release(&a.v, -delta)
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) SubAcqRel(delta uint16) uint16 {
+	return arch.AddUint16AcqRel(&a.v, -delta)
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) And(mask uint16) uint16 {
+	return arch.AndUint16AcqRel(&a.v, mask)
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) AndRelaxed(mask uint16) uint16 {
+	return arch.AndUint16Relaxed(&a.v, mask)
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) AndAcquire(mask uint16) uint16 {
+	return arch.AndUint16Acquire(&a.v, mask)
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Uint16) AndRelease(mask uint16) uint16 {
+	return arch.AndUint16Release(&a.v, mask)
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) AndAcqRel(mask uint16) uint16 {
+	return arch.AndUint16AcqRel(&a.v, mask)
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Or(mask uint16) uint16 {
+	return arch.OrUint16AcqRel(&a.v, mask)
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) OrRelaxed(mask uint16) uint16 {
+	return arch.OrUint16Relaxed(&a.v, mask)
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) OrAcquire(mask uint16) uint16 {
+	return arch.OrUint16Acquire(&a.v, mask)
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Uint16) OrRelease(mask uint16) uint16 {
+	return arch.OrUint16Release(&a.v, mask)
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) OrAcqRel(mask uint16) uint16 {
+	return arch.OrUint16AcqRel(&a.v, mask)
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Xor(mask uint16) uint16 {
+	return arch.XorUint16AcqRel(&a.v, mask)
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) XorRelaxed(mask uint16) uint16 {
+	return arch.XorUint16Relaxed(&a.v, mask)
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint16) XorAcquire(mask uint16) uint16 {
+	return arch.XorUint16Acquire(&a.v, mask)
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Uint16) XorRelease(mask uint16) uint16 {
+	return arch.XorUint16Release(&a.v, mask)
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) XorAcqRel(mask uint16) uint16 {
+	return arch.XorUint16AcqRel(&a.v, mask)
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Max(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) MaxRelaxed(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint16Relaxed(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint16) Min(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint16) MinRelaxed(val uint16) uint16 {
+	for {
+		old := arch.LoadUint16Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint16Relaxed(&a.v, old, val) {
+			return old
+		}
+	}
+}
@@ -391,3 +391,57 @@ func (a *Uint32) MinRelaxed(val uint32) uint32 {
 		}
 	}
 }
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Uint32) Update(f func(old uint32) uint32) (old, new uint32) {
+	return AcqRel.UpdateUint32(&a.v, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Uint32) UpdateRelaxed(f func(old uint32) uint32) (old, new uint32) {
+	return Relaxed.UpdateUint32(&a.v, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Uint32) UpdateAcquire(f func(old uint32) uint32) (old, new uint32) {
+	return Acquire.UpdateUint32(&a.v, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Uint32) UpdateRelease(f func(old uint32) uint32) (old, new uint32) {
+	return Release.UpdateUint32(&a.v, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Uint32) UpdateAcqRel(f func(old uint32) uint32) (old, new uint32) {
+	return AcqRel.UpdateUint32(&a.v, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Uint32) TryUpdate(f func(old uint32) (uint32, bool)) (old, new uint32, updated bool) {
+	return AcqRel.TryUpdateUint32(&a.v, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Uint32) TryUpdateRelaxed(f func(old uint32) (uint32, bool)) (old, new uint32, updated bool) {
+	return Relaxed.TryUpdateUint32(&a.v, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Uint32) TryUpdateAcquire(f func(old uint32) (uint32, bool)) (old, new uint32, updated bool) {
+	return Acquire.TryUpdateUint32(&a.v, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Uint32) TryUpdateRelease(f func(old uint32) (uint32, bool)) (old, new uint32, updated bool) {
+	return Release.TryUpdateUint32(&a.v, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Uint32) TryUpdateAcqRel(f func(old uint32) (uint32, bool)) (old, new uint32, updated bool) {
+	return AcqRel.TryUpdateUint32(&a.v, f)
+}
@@ -0,0 +1,403 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Load() T {
+	return T(arch.LoadUint32Relaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) LoadRelaxed() T {
+	return T(arch.LoadUint32Relaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) LoadAcquire() T {
+	return T(arch.LoadUint32Acquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Store(val T) {
+	arch.StoreUint32Relaxed(&a.v, uint32(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) StoreRelaxed(val T) {
+	arch.StoreUint32Relaxed(&a.v, uint32(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) StoreRelease(val T) {
+	arch.StoreUint32Release(&a.v, uint32(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Swap(new T) T {
+	return T(arch.SwapUint32AcqRel(&a.v, uint32(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SwapRelaxed(new T) T {
+	return T(arch.SwapUint32Relaxed(&a.v, uint32(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SwapAcquire(new T) T {
+	return T(arch.SwapUint32Acquire(&a.v, uint32(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SwapRelease(new T) T {
+	return T(arch.SwapUint32Release(&a.v, uint32(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SwapAcqRel(new T) T {
+	return T(arch.SwapUint32AcqRel(&a.v, uint32(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareAndSwap(old, new T) bool {
+	return arch.CasUint32AcqRel(&a.v, uint32(old), uint32(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareAndSwapRelaxed(old, new T) bool {
+	return arch.CasUint32Relaxed(&a.v, uint32(old), uint32(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareAndSwapAcquire(old, new T) bool {
+	return arch.CasUint32Acquire(&a.v, uint32(old), uint32(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareAndSwapRelease(old, new T) bool {
+	return arch.CasUint32Release(&a.v, uint32(old), uint32(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareAndSwapAcqRel(old, new T) bool {
+	return arch.CasUint32AcqRel(&a.v, uint32(old), uint32(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareExchange(old, new T) T {
+	return T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareExchangeRelaxed(old, new T) T {
+	return T(arch.CaxUint32Relaxed(&a.v, uint32(old), uint32(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareExchangeAcquire(old, new T) T {
+	return T(arch.CaxUint32Acquire(&a.v, uint32(old), uint32(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareExchangeRelease(old, new T) T {
+	return T(arch.CaxUint32Release(&a.v, uint32(old), uint32(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) CompareExchangeAcqRel(old, new T) T {
+	return T(arch.CaxUint32AcqRel(&a.v, uint32(old), uint32(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Add(delta T) T {
+	return T(arch.AddUint32AcqRel(&a.v, uint32(delta)))
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddRelaxed(delta T) T {
+	return T(arch.AddUint32Relaxed(&a.v, uint32(delta)))
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddAcquire(delta T) T {
+	return T(arch.AddUint32Acquire(&a.v, uint32(delta)))
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddRelease(delta T) T {
+	return T(arch.AddUint32Release(&a.v, uint32(delta)))
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AddAcqRel(delta T) T {
+	return T(arch.AddUint32AcqRel(&a.v, uint32(delta)))
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Sub(delta T) T {
+	return T(arch.AddUint32AcqRel(&a.v, -uint32(delta)))
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubRelaxed(delta T) T {
+	return T(arch.AddUint32Relaxed(&a.v, -uint32(delta)))
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubAcquire(delta T) T {
+	return T(arch.AddUint32Acquire(&a.v, -uint32(delta)))
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubRelease(delta T) T {
+	return T(arch.AddUint32Release(&a.v, -uint32(delta)))
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) SubAcqRel(delta T) T {
+	return T(arch.AddUint32AcqRel(&a.v, -uint32(delta)))
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) And(mask T) T {
+	return T(arch.AndUint32AcqRel(&a.v, uint32(mask)))
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AndRelaxed(mask T) T {
+	return T(arch.AndUint32Relaxed(&a.v, uint32(mask)))
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AndAcquire(mask T) T {
+	return T(arch.AndUint32Acquire(&a.v, uint32(mask)))
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AndRelease(mask T) T {
+	return T(arch.AndUint32Release(&a.v, uint32(mask)))
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) AndAcqRel(mask T) T {
+	return T(arch.AndUint32AcqRel(&a.v, uint32(mask)))
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Or(mask T) T {
+	return T(arch.OrUint32AcqRel(&a.v, uint32(mask)))
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) OrRelaxed(mask T) T {
+	return T(arch.OrUint32Relaxed(&a.v, uint32(mask)))
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) OrAcquire(mask T) T {
+	return T(arch.OrUint32Acquire(&a.v, uint32(mask)))
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) OrRelease(mask T) T {
+	return T(arch.OrUint32Release(&a.v, uint32(mask)))
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) OrAcqRel(mask T) T {
+	return T(arch.OrUint32AcqRel(&a.v, uint32(mask)))
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Xor(mask T) T {
+	return T(arch.XorUint32AcqRel(&a.v, uint32(mask)))
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) XorRelaxed(mask T) T {
+	return T(arch.XorUint32Relaxed(&a.v, uint32(mask)))
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) XorAcquire(mask T) T {
+	return T(arch.XorUint32Acquire(&a.v, uint32(mask)))
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) XorRelease(mask T) T {
+	return T(arch.XorUint32Release(&a.v, uint32(mask)))
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) XorAcqRel(mask T) T {
+	return T(arch.XorUint32AcqRel(&a.v, uint32(mask)))
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Max(val T) T {
+	return T(AcqRel.MaxUint32(&a.v, uint32(val)))
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MaxRelaxed(val T) T {
+	return T(Relaxed.MaxUint32(&a.v, uint32(val)))
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MaxAcquire(val T) T {
+	return T(Acquire.MaxUint32(&a.v, uint32(val)))
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MaxRelease(val T) T {
+	return T(Release.MaxUint32(&a.v, uint32(val)))
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MaxAcqRel(val T) T {
+	return T(AcqRel.MaxUint32(&a.v, uint32(val)))
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) Min(val T) T {
+	return T(AcqRel.MinUint32(&a.v, uint32(val)))
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MinRelaxed(val T) T {
+	return T(Relaxed.MinUint32(&a.v, uint32(val)))
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MinAcquire(val T) T {
+	return T(Acquire.MinUint32(&a.v, uint32(val)))
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MinRelease(val T) T {
+	return T(Release.MinUint32(&a.v, uint32(val)))
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint32Of[T]) MinAcqRel(val T) T {
+	return T(AcqRel.MinUint32(&a.v, uint32(val)))
+}
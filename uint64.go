@@ -391,3 +391,57 @@ func (a *Uint64) MinRelaxed(val uint64) uint64 {
 		}
 	}
 }
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Uint64) Update(f func(old uint64) uint64) (old, new uint64) {
+	return AcqRel.UpdateUint64(&a.v, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Uint64) UpdateRelaxed(f func(old uint64) uint64) (old, new uint64) {
+	return Relaxed.UpdateUint64(&a.v, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Uint64) UpdateAcquire(f func(old uint64) uint64) (old, new uint64) {
+	return Acquire.UpdateUint64(&a.v, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Uint64) UpdateRelease(f func(old uint64) uint64) (old, new uint64) {
+	return Release.UpdateUint64(&a.v, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Uint64) UpdateAcqRel(f func(old uint64) uint64) (old, new uint64) {
+	return AcqRel.UpdateUint64(&a.v, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Uint64) TryUpdate(f func(old uint64) (uint64, bool)) (old, new uint64, updated bool) {
+	return AcqRel.TryUpdateUint64(&a.v, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Uint64) TryUpdateRelaxed(f func(old uint64) (uint64, bool)) (old, new uint64, updated bool) {
+	return Relaxed.TryUpdateUint64(&a.v, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Uint64) TryUpdateAcquire(f func(old uint64) (uint64, bool)) (old, new uint64, updated bool) {
+	return Acquire.TryUpdateUint64(&a.v, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Uint64) TryUpdateRelease(f func(old uint64) (uint64, bool)) (old, new uint64, updated bool) {
+	return Release.TryUpdateUint64(&a.v, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Uint64) TryUpdateAcqRel(f func(old uint64) (uint64, bool)) (old, new uint64, updated bool) {
+	return AcqRel.TryUpdateUint64(&a.v, f)
+}
@@ -0,0 +1,403 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Load() T {
+	return T(arch.LoadUint64Relaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) LoadRelaxed() T {
+	return T(arch.LoadUint64Relaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) LoadAcquire() T {
+	return T(arch.LoadUint64Acquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Store(val T) {
+	arch.StoreUint64Relaxed(&a.v, uint64(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) StoreRelaxed(val T) {
+	arch.StoreUint64Relaxed(&a.v, uint64(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) StoreRelease(val T) {
+	arch.StoreUint64Release(&a.v, uint64(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Swap(new T) T {
+	return T(arch.SwapUint64AcqRel(&a.v, uint64(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SwapRelaxed(new T) T {
+	return T(arch.SwapUint64Relaxed(&a.v, uint64(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SwapAcquire(new T) T {
+	return T(arch.SwapUint64Acquire(&a.v, uint64(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SwapRelease(new T) T {
+	return T(arch.SwapUint64Release(&a.v, uint64(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SwapAcqRel(new T) T {
+	return T(arch.SwapUint64AcqRel(&a.v, uint64(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareAndSwap(old, new T) bool {
+	return arch.CasUint64AcqRel(&a.v, uint64(old), uint64(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareAndSwapRelaxed(old, new T) bool {
+	return arch.CasUint64Relaxed(&a.v, uint64(old), uint64(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareAndSwapAcquire(old, new T) bool {
+	return arch.CasUint64Acquire(&a.v, uint64(old), uint64(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareAndSwapRelease(old, new T) bool {
+	return arch.CasUint64Release(&a.v, uint64(old), uint64(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareAndSwapAcqRel(old, new T) bool {
+	return arch.CasUint64AcqRel(&a.v, uint64(old), uint64(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareExchange(old, new T) T {
+	return T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareExchangeRelaxed(old, new T) T {
+	return T(arch.CaxUint64Relaxed(&a.v, uint64(old), uint64(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareExchangeAcquire(old, new T) T {
+	return T(arch.CaxUint64Acquire(&a.v, uint64(old), uint64(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareExchangeRelease(old, new T) T {
+	return T(arch.CaxUint64Release(&a.v, uint64(old), uint64(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) CompareExchangeAcqRel(old, new T) T {
+	return T(arch.CaxUint64AcqRel(&a.v, uint64(old), uint64(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Add(delta T) T {
+	return T(arch.AddUint64AcqRel(&a.v, uint64(delta)))
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddRelaxed(delta T) T {
+	return T(arch.AddUint64Relaxed(&a.v, uint64(delta)))
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddAcquire(delta T) T {
+	return T(arch.AddUint64Acquire(&a.v, uint64(delta)))
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddRelease(delta T) T {
+	return T(arch.AddUint64Release(&a.v, uint64(delta)))
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AddAcqRel(delta T) T {
+	return T(arch.AddUint64AcqRel(&a.v, uint64(delta)))
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Sub(delta T) T {
+	return T(arch.AddUint64AcqRel(&a.v, -uint64(delta)))
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubRelaxed(delta T) T {
+	return T(arch.AddUint64Relaxed(&a.v, -uint64(delta)))
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubAcquire(delta T) T {
+	return T(arch.AddUint64Acquire(&a.v, -uint64(delta)))
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubRelease(delta T) T {
+	return T(arch.AddUint64Release(&a.v, -uint64(delta)))
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) SubAcqRel(delta T) T {
+	return T(arch.AddUint64AcqRel(&a.v, -uint64(delta)))
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) And(mask T) T {
+	return T(arch.AndUint64AcqRel(&a.v, uint64(mask)))
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AndRelaxed(mask T) T {
+	return T(arch.AndUint64Relaxed(&a.v, uint64(mask)))
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AndAcquire(mask T) T {
+	return T(arch.AndUint64Acquire(&a.v, uint64(mask)))
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AndRelease(mask T) T {
+	return T(arch.AndUint64Release(&a.v, uint64(mask)))
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) AndAcqRel(mask T) T {
+	return T(arch.AndUint64AcqRel(&a.v, uint64(mask)))
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Or(mask T) T {
+	return T(arch.OrUint64AcqRel(&a.v, uint64(mask)))
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) OrRelaxed(mask T) T {
+	return T(arch.OrUint64Relaxed(&a.v, uint64(mask)))
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) OrAcquire(mask T) T {
+	return T(arch.OrUint64Acquire(&a.v, uint64(mask)))
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) OrRelease(mask T) T {
+	return T(arch.OrUint64Release(&a.v, uint64(mask)))
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) OrAcqRel(mask T) T {
+	return T(arch.OrUint64AcqRel(&a.v, uint64(mask)))
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Xor(mask T) T {
+	return T(arch.XorUint64AcqRel(&a.v, uint64(mask)))
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) XorRelaxed(mask T) T {
+	return T(arch.XorUint64Relaxed(&a.v, uint64(mask)))
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) XorAcquire(mask T) T {
+	return T(arch.XorUint64Acquire(&a.v, uint64(mask)))
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) XorRelease(mask T) T {
+	return T(arch.XorUint64Release(&a.v, uint64(mask)))
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) XorAcqRel(mask T) T {
+	return T(arch.XorUint64AcqRel(&a.v, uint64(mask)))
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Max(val T) T {
+	return T(AcqRel.MaxUint64(&a.v, uint64(val)))
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MaxRelaxed(val T) T {
+	return T(Relaxed.MaxUint64(&a.v, uint64(val)))
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MaxAcquire(val T) T {
+	return T(Acquire.MaxUint64(&a.v, uint64(val)))
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MaxRelease(val T) T {
+	return T(Release.MaxUint64(&a.v, uint64(val)))
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MaxAcqRel(val T) T {
+	return T(AcqRel.MaxUint64(&a.v, uint64(val)))
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) Min(val T) T {
+	return T(AcqRel.MinUint64(&a.v, uint64(val)))
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MinRelaxed(val T) T {
+	return T(Relaxed.MinUint64(&a.v, uint64(val)))
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MinAcquire(val T) T {
+	return T(Acquire.MinUint64(&a.v, uint64(val)))
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MinRelease(val T) T {
+	return T(Release.MinUint64(&a.v, uint64(val)))
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint64Of[T]) MinAcqRel(val T) T {
+	return T(AcqRel.MinUint64(&a.v, uint64(val)))
+}
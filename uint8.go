@@ -0,0 +1,393 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) Load() uint8 {
+	return arch.LoadUint8Relaxed(&a.v)
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) LoadRelaxed() uint8 {
+	return arch.LoadUint8Relaxed(&a.v)
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) LoadAcquire() uint8 {
+	return arch.LoadUint8Acquire(&a.v)
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) Store(val uint8) {
+	arch.StoreUint8Relaxed(&a.v, val)
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) StoreRelaxed(val uint8) {
+	arch.StoreUint8Relaxed(&a.v, val)
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *Uint8) StoreRelease(val uint8) {
+	arch.StoreUint8Release(&a.v, val)
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Swap(new uint8) uint8 {
+	return arch.SwapUint8AcqRel(&a.v, new)
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) SwapRelaxed(new uint8) uint8 {
+	return arch.SwapUint8Relaxed(&a.v, new)
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) SwapAcquire(new uint8) uint8 {
+	return arch.SwapUint8Acquire(&a.v, new)
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) SwapRelease(new uint8) uint8 {
+	return arch.SwapUint8Release(&a.v, new)
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) SwapAcqRel(new uint8) uint8 {
+	return arch.SwapUint8AcqRel(&a.v, new)
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *Uint8) CompareAndSwap(old, new uint8) bool {
+	return arch.CasUint8AcqRel(&a.v, old, new)
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareAndSwapRelaxed(old, new uint8) bool {
+	return arch.CasUint8Relaxed(&a.v, old, new)
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareAndSwapAcquire(old, new uint8) bool {
+	return arch.CasUint8Acquire(&a.v, old, new)
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareAndSwapRelease(old, new uint8) bool {
+	return arch.CasUint8Release(&a.v, old, new)
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareAndSwapAcqRel(old, new uint8) bool {
+	return arch.CasUint8AcqRel(&a.v, old, new)
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareExchange(old, new uint8) uint8 {
+	return arch.CaxUint8AcqRel(&a.v, old, new)
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareExchangeRelaxed(old, new uint8) uint8 {
+	return arch.CaxUint8Relaxed(&a.v, old, new)
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareExchangeAcquire(old, new uint8) uint8 {
+	return arch.CaxUint8Acquire(&a.v, old, new)
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareExchangeRelease(old, new uint8) uint8 {
+	return arch.CaxUint8Release(&a.v, old, new)
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) CompareExchangeAcqRel(old, new uint8) uint8 {
+	return arch.CaxUint8AcqRel(&a.v, old, new)
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Add(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, delta)
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) AddRelaxed(delta uint8) uint8 {
+	return arch.AddUint8Relaxed(&a.v, delta)
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) AddAcquire(delta uint8) uint8 {
+	return arch.AddUint8Acquire(&a.v, delta)
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) AddRelease(delta uint8) uint8 {
+	return arch.AddUint8Release(&a.v, delta)
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) AddAcqRel(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, delta)
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Sub(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, -delta)
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) SubRelaxed(delta uint8) uint8 {
+	return arch.AddUint8Relaxed(&a.v, -delta)
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) SubAcquire(delta uint8) uint8 {
+	return arch.AddUint8Acquire(&a.v, -delta)
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *Uint8) SubRelease(delta uint8) uint8 {
+	return arch.AddUint8Release(&a.v, -delta)
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) SubAcqRel(delta uint8) uint8 {
+	return arch.AddUint8AcqRel(&a.v, -delta)
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) And(mask uint8) uint8 {
+	return arch.AndUint8AcqRel(&a.v, mask)
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) AndRelaxed(mask uint8) uint8 {
+	return arch.AndUint8Relaxed(&a.v, mask)
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) AndAcquire(mask uint8) uint8 {
+	return arch.AndUint8Acquire(&a.v, mask)
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *Uint8) AndRelease(mask uint8) uint8 {
+	return arch.AndUint8Release(&a.v, mask)
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) AndAcqRel(mask uint8) uint8 {
+	return arch.AndUint8AcqRel(&a.v, mask)
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Or(mask uint8) uint8 {
+	return arch.OrUint8AcqRel(&a.v, mask)
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) OrRelaxed(mask uint8) uint8 {
+	return arch.OrUint8Relaxed(&a.v, mask)
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) OrAcquire(mask uint8) uint8 {
+	return arch.OrUint8Acquire(&a.v, mask)
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *Uint8) OrRelease(mask uint8) uint8 {
+	return arch.OrUint8Release(&a.v, mask)
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) OrAcqRel(mask uint8) uint8 {
+	return arch.OrUint8AcqRel(&a.v, mask)
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Xor(mask uint8) uint8 {
+	return arch.XorUint8AcqRel(&a.v, mask)
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) XorRelaxed(mask uint8) uint8 {
+	return arch.XorUint8Relaxed(&a.v, mask)
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *Uint8) XorAcquire(mask uint8) uint8 {
+	return arch.XorUint8Acquire(&a.v, mask)
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *Uint8) XorRelease(mask uint8) uint8 {
+	return arch.XorUint8Release(&a.v, mask)
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) XorAcqRel(mask uint8) uint8 {
+	return arch.XorUint8AcqRel(&a.v, mask)
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Max(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) MaxRelaxed(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Relaxed(&a.v)
+		if old >= val {
+			return old
+		}
+		if arch.CasUint8Relaxed(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *Uint8) Min(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8AcqRel(&a.v, old, val) {
+			return old
+		}
+	}
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *Uint8) MinRelaxed(val uint8) uint8 {
+	for {
+		old := arch.LoadUint8Relaxed(&a.v)
+		if old <= val {
+			return old
+		}
+		if arch.CasUint8Relaxed(&a.v, old, val) {
+			return old
+		}
+	}
+}
@@ -377,3 +377,57 @@ func (a *Uintptr) MinRelaxed(val uintptr) uintptr {
 		}
 	}
 }
+
+// Update atomically replaces the value with f(old) and returns the old and new
+// values, retrying with backoff under contention. f may run more than once and
+// must be free of side effects. Uses acquire-release ordering.
+func (a *Uintptr) Update(f func(old uintptr) uintptr) (old, new uintptr) {
+	return AcqRel.UpdateUintptr(&a.v, f)
+}
+
+// UpdateRelaxed atomically replaces the value with f(old) with relaxed ordering.
+func (a *Uintptr) UpdateRelaxed(f func(old uintptr) uintptr) (old, new uintptr) {
+	return Relaxed.UpdateUintptr(&a.v, f)
+}
+
+// UpdateAcquire atomically replaces the value with f(old) with acquire ordering.
+func (a *Uintptr) UpdateAcquire(f func(old uintptr) uintptr) (old, new uintptr) {
+	return Acquire.UpdateUintptr(&a.v, f)
+}
+
+// UpdateRelease atomically replaces the value with f(old) with release ordering.
+func (a *Uintptr) UpdateRelease(f func(old uintptr) uintptr) (old, new uintptr) {
+	return Release.UpdateUintptr(&a.v, f)
+}
+
+// UpdateAcqRel atomically replaces the value with f(old) with acquire-release ordering.
+func (a *Uintptr) UpdateAcqRel(f func(old uintptr) uintptr) (old, new uintptr) {
+	return AcqRel.UpdateUintptr(&a.v, f)
+}
+
+// TryUpdate is like Update, but f may decline by returning false, in which
+// case the value is left unchanged and (old, old, false) is returned.
+// Uses acquire-release ordering.
+func (a *Uintptr) TryUpdate(f func(old uintptr) (uintptr, bool)) (old, new uintptr, updated bool) {
+	return AcqRel.TryUpdateUintptr(&a.v, f)
+}
+
+// TryUpdateRelaxed is like TryUpdate with relaxed ordering.
+func (a *Uintptr) TryUpdateRelaxed(f func(old uintptr) (uintptr, bool)) (old, new uintptr, updated bool) {
+	return Relaxed.TryUpdateUintptr(&a.v, f)
+}
+
+// TryUpdateAcquire is like TryUpdate with acquire ordering.
+func (a *Uintptr) TryUpdateAcquire(f func(old uintptr) (uintptr, bool)) (old, new uintptr, updated bool) {
+	return Acquire.TryUpdateUintptr(&a.v, f)
+}
+
+// TryUpdateRelease is like TryUpdate with release ordering.
+func (a *Uintptr) TryUpdateRelease(f func(old uintptr) (uintptr, bool)) (old, new uintptr, updated bool) {
+	return Release.TryUpdateUintptr(&a.v, f)
+}
+
+// TryUpdateAcqRel is like TryUpdate with acquire-release ordering.
+func (a *Uintptr) TryUpdateAcqRel(f func(old uintptr) (uintptr, bool)) (old, new uintptr, updated bool) {
+	return AcqRel.TryUpdateUintptr(&a.v, f)
+}
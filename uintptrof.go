@@ -0,0 +1,403 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import "code.hybscloud.com/atomix/internal/arch"
+
+// Load atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Load() T {
+	return T(arch.LoadUintptrRelaxed(&a.v))
+}
+
+// LoadRelaxed atomically loads and returns the value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) LoadRelaxed() T {
+	return T(arch.LoadUintptrRelaxed(&a.v))
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) LoadAcquire() T {
+	return T(arch.LoadUintptrAcquire(&a.v))
+}
+
+// Store atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Store(val T) {
+	arch.StoreUintptrRelaxed(&a.v, uintptr(val))
+}
+
+// StoreRelaxed atomically stores val with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) StoreRelaxed(val T) {
+	arch.StoreUintptrRelaxed(&a.v, uintptr(val))
+}
+
+// StoreRelease atomically stores val with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) StoreRelease(val T) {
+	arch.StoreUintptrRelease(&a.v, uintptr(val))
+}
+
+// Swap atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Swap(new T) T {
+	return T(arch.SwapUintptrAcqRel(&a.v, uintptr(new)))
+}
+
+// SwapRelaxed atomically swaps the value and returns the old value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SwapRelaxed(new T) T {
+	return T(arch.SwapUintptrRelaxed(&a.v, uintptr(new)))
+}
+
+// SwapAcquire atomically swaps the value and returns the old value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SwapAcquire(new T) T {
+	return T(arch.SwapUintptrAcquire(&a.v, uintptr(new)))
+}
+
+// SwapRelease atomically swaps the value and returns the old value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SwapRelease(new T) T {
+	return T(arch.SwapUintptrRelease(&a.v, uintptr(new)))
+}
+
+// SwapAcqRel atomically swaps the value and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SwapAcqRel(new T) T {
+	return T(arch.SwapUintptrAcqRel(&a.v, uintptr(new)))
+}
+
+// CompareAndSwap atomically compares and swaps with acquire-release ordering.
+// Returns true if the swap was performed.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareAndSwap(old, new T) bool {
+	return arch.CasUintptrAcqRel(&a.v, uintptr(old), uintptr(new))
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareAndSwapRelaxed(old, new T) bool {
+	return arch.CasUintptrRelaxed(&a.v, uintptr(old), uintptr(new))
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareAndSwapAcquire(old, new T) bool {
+	return arch.CasUintptrAcquire(&a.v, uintptr(old), uintptr(new))
+}
+
+// CompareAndSwapRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareAndSwapRelease(old, new T) bool {
+	return arch.CasUintptrRelease(&a.v, uintptr(old), uintptr(new))
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareAndSwapAcqRel(old, new T) bool {
+	return arch.CasUintptrAcqRel(&a.v, uintptr(old), uintptr(new))
+}
+
+// CompareExchange atomically compares and swaps, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareExchange(old, new T) T {
+	return T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+}
+
+// CompareExchangeRelaxed atomically compares and swaps with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareExchangeRelaxed(old, new T) T {
+	return T(arch.CaxUintptrRelaxed(&a.v, uintptr(old), uintptr(new)))
+}
+
+// CompareExchangeAcquire atomically compares and swaps with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareExchangeAcquire(old, new T) T {
+	return T(arch.CaxUintptrAcquire(&a.v, uintptr(old), uintptr(new)))
+}
+
+// CompareExchangeRelease atomically compares and swaps with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareExchangeRelease(old, new T) T {
+	return T(arch.CaxUintptrRelease(&a.v, uintptr(old), uintptr(new)))
+}
+
+// CompareExchangeAcqRel atomically compares and swaps with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) CompareExchangeAcqRel(old, new T) T {
+	return T(arch.CaxUintptrAcqRel(&a.v, uintptr(old), uintptr(new)))
+}
+
+// Add atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Add(delta T) T {
+	return T(arch.AddUintptrAcqRel(&a.v, uintptr(delta)))
+}
+
+// AddRelaxed atomically adds delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddRelaxed(delta T) T {
+	return T(arch.AddUintptrRelaxed(&a.v, uintptr(delta)))
+}
+
+// AddAcquire atomically adds delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddAcquire(delta T) T {
+	return T(arch.AddUintptrAcquire(&a.v, uintptr(delta)))
+}
+
+// AddRelease atomically adds delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddRelease(delta T) T {
+	return T(arch.AddUintptrRelease(&a.v, uintptr(delta)))
+}
+
+// AddAcqRel atomically adds delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AddAcqRel(delta T) T {
+	return T(arch.AddUintptrAcqRel(&a.v, uintptr(delta)))
+}
+
+// Sub atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Sub(delta T) T {
+	return T(arch.AddUintptrAcqRel(&a.v, -uintptr(delta)))
+}
+
+// SubRelaxed atomically subtracts delta and returns the new value with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubRelaxed(delta T) T {
+	return T(arch.AddUintptrRelaxed(&a.v, -uintptr(delta)))
+}
+
+// SubAcquire atomically subtracts delta and returns the new value with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubAcquire(delta T) T {
+	return T(arch.AddUintptrAcquire(&a.v, -uintptr(delta)))
+}
+
+// SubRelease atomically subtracts delta and returns the new value with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubRelease(delta T) T {
+	return T(arch.AddUintptrRelease(&a.v, -uintptr(delta)))
+}
+
+// SubAcqRel atomically subtracts delta and returns the new value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) SubAcqRel(delta T) T {
+	return T(arch.AddUintptrAcqRel(&a.v, -uintptr(delta)))
+}
+
+// And atomically performs bitwise AND and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) And(mask T) T {
+	return T(arch.AndUintptrAcqRel(&a.v, uintptr(mask)))
+}
+
+// AndRelaxed atomically performs bitwise AND with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AndRelaxed(mask T) T {
+	return T(arch.AndUintptrRelaxed(&a.v, uintptr(mask)))
+}
+
+// AndAcquire atomically performs bitwise AND with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AndAcquire(mask T) T {
+	return T(arch.AndUintptrAcquire(&a.v, uintptr(mask)))
+}
+
+// AndRelease atomically performs bitwise AND with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AndRelease(mask T) T {
+	return T(arch.AndUintptrRelease(&a.v, uintptr(mask)))
+}
+
+// AndAcqRel atomically performs bitwise AND with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) AndAcqRel(mask T) T {
+	return T(arch.AndUintptrAcqRel(&a.v, uintptr(mask)))
+}
+
+// Or atomically performs bitwise OR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Or(mask T) T {
+	return T(arch.OrUintptrAcqRel(&a.v, uintptr(mask)))
+}
+
+// OrRelaxed atomically performs bitwise OR with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) OrRelaxed(mask T) T {
+	return T(arch.OrUintptrRelaxed(&a.v, uintptr(mask)))
+}
+
+// OrAcquire atomically performs bitwise OR with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) OrAcquire(mask T) T {
+	return T(arch.OrUintptrAcquire(&a.v, uintptr(mask)))
+}
+
+// OrRelease atomically performs bitwise OR with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) OrRelease(mask T) T {
+	return T(arch.OrUintptrRelease(&a.v, uintptr(mask)))
+}
+
+// OrAcqRel atomically performs bitwise OR with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) OrAcqRel(mask T) T {
+	return T(arch.OrUintptrAcqRel(&a.v, uintptr(mask)))
+}
+
+// Xor atomically performs bitwise XOR and returns the old value with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Xor(mask T) T {
+	return T(arch.XorUintptrAcqRel(&a.v, uintptr(mask)))
+}
+
+// XorRelaxed atomically performs bitwise XOR with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) XorRelaxed(mask T) T {
+	return T(arch.XorUintptrRelaxed(&a.v, uintptr(mask)))
+}
+
+// XorAcquire atomically performs bitwise XOR with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) XorAcquire(mask T) T {
+	return T(arch.XorUintptrAcquire(&a.v, uintptr(mask)))
+}
+
+// XorRelease atomically performs bitwise XOR with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) XorRelease(mask T) T {
+	return T(arch.XorUintptrRelease(&a.v, uintptr(mask)))
+}
+
+// XorAcqRel atomically performs bitwise XOR with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) XorAcqRel(mask T) T {
+	return T(arch.XorUintptrAcqRel(&a.v, uintptr(mask)))
+}
+
+// Max atomically stores the maximum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Max(val T) T {
+	return T(AcqRel.MaxUintptr(&a.v, uintptr(val)))
+}
+
+// MaxRelaxed atomically stores the maximum with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MaxRelaxed(val T) T {
+	return T(Relaxed.MaxUintptr(&a.v, uintptr(val)))
+}
+
+// MaxAcquire atomically stores the maximum with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MaxAcquire(val T) T {
+	return T(Acquire.MaxUintptr(&a.v, uintptr(val)))
+}
+
+// MaxRelease atomically stores the maximum with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MaxRelease(val T) T {
+	return T(Release.MaxUintptr(&a.v, uintptr(val)))
+}
+
+// MaxAcqRel atomically stores the maximum with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MaxAcqRel(val T) T {
+	return T(AcqRel.MaxUintptr(&a.v, uintptr(val)))
+}
+
+// Min atomically stores the minimum of current and val, returning the old value.
+// Uses acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) Min(val T) T {
+	return T(AcqRel.MinUintptr(&a.v, uintptr(val)))
+}
+
+// MinRelaxed atomically stores the minimum with relaxed ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MinRelaxed(val T) T {
+	return T(Relaxed.MinUintptr(&a.v, uintptr(val)))
+}
+
+// MinAcquire atomically stores the minimum with acquire ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MinAcquire(val T) T {
+	return T(Acquire.MinUintptr(&a.v, uintptr(val)))
+}
+
+// MinRelease atomically stores the minimum with release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MinRelease(val T) T {
+	return T(Release.MinUintptr(&a.v, uintptr(val)))
+}
+
+// MinAcqRel atomically stores the minimum with acquire-release ordering.
+//
+//go:nosplit
+func (a *UintptrOf[T]) MinAcqRel(val T) T {
+	return T(AcqRel.MinUintptr(&a.v, uintptr(val)))
+}
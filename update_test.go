@@ -0,0 +1,217 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Update / TryUpdate Tests
+// =============================================================================
+
+func TestUpdateInt64AllOrderings(t *testing.T) {
+	var a atomix.Int64
+	updates := []func(func(int64) int64) (int64, int64){
+		a.Update, a.UpdateRelaxed, a.UpdateAcquire, a.UpdateRelease, a.UpdateAcqRel, a.UpdateSeqCst,
+	}
+	for i, update := range updates {
+		a.Store(10)
+		old, new := update(func(v int64) int64 { return v*3 + 1 })
+		if old != 10 || new != 31 || a.Load() != 31 {
+			t.Fatalf("Update[%d]: got (%d, %d), stored %d", i, old, new, a.Load())
+		}
+	}
+	tries := []func(func(int64) (int64, bool)) (int64, int64, bool){
+		a.TryUpdate, a.TryUpdateRelaxed, a.TryUpdateAcquire, a.TryUpdateRelease, a.TryUpdateAcqRel,
+		a.TryUpdateSeqCst,
+	}
+	for i, try := range tries {
+		a.Store(5)
+		old, new, ok := try(func(v int64) (int64, bool) { return v - 10, v >= 10 })
+		if ok || old != 5 || new != 5 || a.Load() != 5 {
+			t.Fatalf("TryUpdate[%d] declined: got (%d, %d, %v)", i, old, new, ok)
+		}
+		old, new, ok = try(func(v int64) (int64, bool) { return v + 1, true })
+		if !ok || old != 5 || new != 6 || a.Load() != 6 {
+			t.Fatalf("TryUpdate[%d] applied: got (%d, %d, %v)", i, old, new, ok)
+		}
+	}
+}
+
+func TestUpdateIntegerTypes(t *testing.T) {
+	var i32 atomix.Int32
+	var u32 atomix.Uint32
+	var u64 atomix.Uint64
+	var up atomix.Uintptr
+	i32.Store(-4)
+	if old, new := i32.Update(func(v int32) int32 { return -v }); old != -4 || new != 4 {
+		t.Fatalf("Int32.Update: (%d, %d)", old, new)
+	}
+	u32.Store(0b1010)
+	if old, new := u32.UpdateRelease(func(v uint32) uint32 { return v >> 1 }); old != 0b1010 || new != 0b101 {
+		t.Fatalf("Uint32.UpdateRelease: (%d, %d)", old, new)
+	}
+	u64.Store(1 << 40)
+	if _, _, ok := u64.TryUpdateAcquire(func(v uint64) (uint64, bool) { return 0, v == 0 }); ok || u64.Load() != 1<<40 {
+		t.Fatal("Uint64.TryUpdateAcquire should decline")
+	}
+	up.Store(8)
+	if old, new, ok := up.TryUpdateRelaxed(func(v uintptr) (uintptr, bool) { return v &^ 7, true }); !ok || old != 8 || new != 8 {
+		t.Fatalf("Uintptr.TryUpdateRelaxed: (%d, %d, %v)", old, new, ok)
+	}
+}
+
+func TestUpdateBool(t *testing.T) {
+	var b atomix.Bool
+	if old, new := b.Update(func(v bool) bool { return !v }); old || !new || !b.Load() {
+		t.Fatalf("Bool.Update: (%v, %v)", old, new)
+	}
+	if _, _, ok := b.TryUpdateAcqRel(func(v bool) (bool, bool) { return true, !v }); ok {
+		t.Fatal("Bool.TryUpdateAcqRel should decline when already set")
+	}
+
+	// The pointer API treats any non-zero word as true.
+	raw := uint32(7)
+	old, new := atomix.AcqRel.UpdateBool(&raw, func(v bool) bool { return !v })
+	if !old || new || raw != 0 {
+		t.Fatalf("UpdateBool: (%v, %v), raw=%d", old, new, raw)
+	}
+}
+
+func TestUpdatePointer(t *testing.T) {
+	type node struct{ n int }
+	var p atomix.Pointer[node]
+	first := &node{1}
+	old, new := p.UpdateAcquire(func(cur *node) *node {
+		if cur != nil {
+			return cur
+		}
+		return first
+	})
+	if old != nil || new != first || p.Load() != first {
+		t.Fatalf("Pointer.UpdateAcquire: (%v, %v)", old, new)
+	}
+	old, new, ok := p.TryUpdate(func(cur *node) (*node, bool) { return &node{cur.n + 1}, cur.n < 1 })
+	if ok || old != first || new != first {
+		t.Fatal("Pointer.TryUpdate should decline")
+	}
+
+	var raw unsafe.Pointer
+	x := 42
+	prev, next := atomix.Release.UpdatePointer(&raw, func(unsafe.Pointer) unsafe.Pointer { return unsafe.Pointer(&x) })
+	if prev != nil || next != unsafe.Pointer(&x) || raw != unsafe.Pointer(&x) {
+		t.Fatal("UpdatePointer: unexpected result")
+	}
+}
+
+func TestUpdate128(t *testing.T) {
+	buf := make([]byte, 96)
+	n, u := atomix.PlaceAlignedUint128(buf, 0)
+	_, i := atomix.PlaceAlignedInt128(buf, n)
+
+	u.Store(^uint64(0), 0)
+	old, new := u.UpdateRelaxed(func(v atomix.U128) atomix.U128 { return v.Add(atomix.U128From64(1)) })
+	if old != (atomix.U128{Lo: ^uint64(0)}) || new != (atomix.U128{Hi: 1}) || !u.Equal(0, 1) {
+		t.Fatalf("Uint128.UpdateRelaxed: (%v, %v)", old, new)
+	}
+	if _, _, ok := u.TryUpdateRelease(func(v atomix.U128) (atomix.U128, bool) { return v, v.Hi == 0 }); ok {
+		t.Fatal("Uint128.TryUpdateRelease should decline")
+	}
+
+	i.StoreValue(atomix.I128From64(-3))
+	iold, inew, ok := i.TryUpdate(func(v atomix.I128) (atomix.I128, bool) { return v.Neg(), v.Sign() < 0 })
+	if !ok || iold != atomix.I128From64(-3) || inew != atomix.I128From64(3) {
+		t.Fatalf("Int128.TryUpdate: (%v, %v, %v)", iold, inew, ok)
+	}
+	iold, inew = atomix.Acquire.UpdateInt128(i, func(v atomix.I128) atomix.I128 { return v.Lsh(64) })
+	if iold != atomix.I128From64(3) || inew != (atomix.I128{Hi: 3}) {
+		t.Fatalf("UpdateInt128: (%v, %v)", iold, inew)
+	}
+}
+
+func TestUpdateSeqCst(t *testing.T) {
+	var b atomix.Bool
+	if old, new := b.UpdateSeqCst(func(v bool) bool { return !v }); old || !new {
+		t.Fatalf("Bool.UpdateSeqCst: (%v, %v)", old, new)
+	}
+	var p atomix.Pointer[int]
+	x := 1
+	if old, new, ok := p.TryUpdateSeqCst(func(*int) (*int, bool) { return &x, true }); !ok || old != nil || new != &x {
+		t.Fatalf("Pointer.TryUpdateSeqCst: (%v, %v, %v)", old, new, ok)
+	}
+	var u atomix.Uint128
+	if _, new := u.UpdateSeqCst(func(v atomix.U128) atomix.U128 { return v.Sub(atomix.U128From64(1)) }); new != (atomix.U128{Lo: ^uint64(0), Hi: ^uint64(0)}) {
+		t.Fatalf("Uint128.UpdateSeqCst: %v", new)
+	}
+	var i atomix.Int128
+	if _, _, ok := i.TryUpdateSeqCst(func(v atomix.I128) (atomix.I128, bool) { return v, v.Sign() > 0 }); ok {
+		t.Fatal("Int128.TryUpdateSeqCst should decline")
+	}
+	var u32 atomix.Uint32
+	if old, new := u32.UpdateSeqCst(func(v uint32) uint32 { return v + 2 }); old != 0 || new != 2 || u32.LoadSeqCst() != 2 {
+		t.Fatalf("Uint32.UpdateSeqCst: (%d, %d)", old, new)
+	}
+}
+
+func TestUpdateConcurrent(t *testing.T) {
+	const goroutines, iters = 8, 2000
+	var counter atomix.Uint64
+	buf := make([]byte, 32)
+	_, wide := atomix.PlaceAlignedUint128(buf, 0)
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iters {
+				// A non-linear update that is wrong if any step is lost.
+				counter.Update(func(v uint64) uint64 { return v + 1 })
+				wide.UpdateAcqRel(func(v atomix.U128) atomix.U128 {
+					return v.Add(atomix.U128{Lo: 1, Hi: 1})
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	const want = goroutines * iters
+	if counter.Load() != want {
+		t.Fatalf("Update counter: got %d, want %d", counter.Load(), want)
+	}
+	if !wide.Equal(want, want) {
+		lo, hi := wide.Load()
+		t.Fatalf("Uint128 Update: got (%d, %d), want (%d, %d)", lo, hi, want, want)
+	}
+}
+
+func TestTryUpdateBoundedConcurrent(t *testing.T) {
+	const goroutines, limit = 8, 1000
+	var n int32
+	var applied atomix.Int32
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				_, _, ok := atomix.AcqRel.TryUpdateInt32(&n, func(v int32) (int32, bool) {
+					return v + 1, v < limit
+				})
+				if !ok {
+					return
+				}
+				applied.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := atomix.Acquire.LoadInt32(&n); got != limit || applied.Load() != limit {
+		t.Fatalf("TryUpdate bound: value %d, applied %d, want %d", got, applied.Load(), limit)
+	}
+}
@@ -0,0 +1,242 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"errors"
+	"reflect"
+	"unsafe"
+)
+
+// TypePolicy selects how a [Value] treats a store whose type differs from
+// the value it already holds.
+type TypePolicy uint8
+
+const (
+	// TypePanic panics on a nil or inconsistently typed store, as
+	// [sync/atomic.Value] does. It is the zero policy.
+	TypePanic TypePolicy = iota
+	// TypeError rejects a nil or inconsistently typed store, leaving the
+	// Value unchanged, and returns [ErrNilValue] or [ErrInconsistentType].
+	TypeError
+	// TypeAny accepts values of any type, including nil.
+	TypeAny
+)
+
+// Errors returned by [Value] operations under [TypeError].
+var (
+	ErrNilValue         = errors.New("atomix: store of nil value into Value")
+	ErrInconsistentType = errors.New("atomix: store of inconsistently typed value into Value")
+)
+
+// valueBox holds one published interface value. Boxes are never modified
+// after publication.
+type valueBox struct {
+	v any
+}
+
+// NewValue returns a heap-allocated Value that holds nil and uses policy.
+func NewValue(policy TypePolicy) *Value {
+	return &Value{policy: policy}
+}
+
+// SetPolicy sets the type-consistency policy. It must be called before the
+// Value is shared; it is not safe to call concurrently with other methods.
+func (v *Value) SetPolicy(policy TypePolicy) {
+	v.policy = policy
+}
+
+// Policy returns the type-consistency policy.
+func (v *Value) Policy() TypePolicy {
+	return v.policy
+}
+
+// Load atomically loads and returns the value with acquire ordering.
+// Returns nil if no value has been stored.
+func (v *Value) Load() any {
+	return v.load()
+}
+
+// LoadRelaxed atomically loads and returns the value. It still acquires,
+// since the value is read through the published box.
+func (v *Value) LoadRelaxed() any {
+	return v.load()
+}
+
+// LoadAcquire atomically loads and returns the value with acquire ordering.
+func (v *Value) LoadAcquire() any {
+	return v.load()
+}
+
+// Store atomically stores val with release ordering.
+// The error is non-nil only under [TypeError].
+func (v *Value) Store(val any) error {
+	return v.store(val)
+}
+
+// StoreRelaxed atomically stores val. It still releases, so a reader never
+// sees the box before its contents.
+// The error is non-nil only under [TypeError].
+func (v *Value) StoreRelaxed(val any) error {
+	return v.store(val)
+}
+
+// StoreRelease atomically stores val with release ordering.
+// The error is non-nil only under [TypeError].
+func (v *Value) StoreRelease(val any) error {
+	return v.store(val)
+}
+
+// Swap atomically stores new and returns the old value with acquire-release ordering.
+// The error is non-nil only under [TypeError], in which case nothing is stored.
+func (v *Value) Swap(new any) (old any, err error) {
+	return v.swap(new)
+}
+
+// SwapRelaxed atomically stores new and returns the old value. It still
+// orders as AcqRel, since it both publishes and reads a box.
+func (v *Value) SwapRelaxed(new any) (old any, err error) {
+	return v.swap(new)
+}
+
+// SwapAcquire atomically stores new and returns the old value with
+// acquire-release ordering.
+func (v *Value) SwapAcquire(new any) (old any, err error) {
+	return v.swap(new)
+}
+
+// SwapRelease atomically stores new and returns the old value with
+// acquire-release ordering.
+func (v *Value) SwapRelease(new any) (old any, err error) {
+	return v.swap(new)
+}
+
+// SwapAcqRel atomically stores new and returns the old value with acquire-release ordering.
+func (v *Value) SwapAcqRel(new any) (old any, err error) {
+	return v.swap(new)
+}
+
+// CompareAndSwap atomically stores new if the current value equals old,
+// with acquire-release ordering. Values are compared with ==, which panics
+// if old and the current value share an incomparable type.
+// The error is non-nil only under [TypeError], in which case nothing is stored.
+func (v *Value) CompareAndSwap(old, new any) (swapped bool, err error) {
+	return v.compareAndSwap(old, new)
+}
+
+// CompareAndSwapRelaxed atomically compares and swaps. It still orders as
+// AcqRel, since it both reads and publishes a box.
+func (v *Value) CompareAndSwapRelaxed(old, new any) (swapped bool, err error) {
+	return v.compareAndSwap(old, new)
+}
+
+// CompareAndSwapAcquire atomically compares and swaps with acquire-release ordering.
+func (v *Value) CompareAndSwapAcquire(old, new any) (swapped bool, err error) {
+	return v.compareAndSwap(old, new)
+}
+
+// CompareAndSwapRelease atomically compares and swaps with acquire-release ordering.
+func (v *Value) CompareAndSwapRelease(old, new any) (swapped bool, err error) {
+	return v.compareAndSwap(old, new)
+}
+
+// CompareAndSwapAcqRel atomically compares and swaps with acquire-release ordering.
+func (v *Value) CompareAndSwapAcqRel(old, new any) (swapped bool, err error) {
+	return v.compareAndSwap(old, new)
+}
+
+func (v *Value) load() any {
+	if b := (*valueBox)(Acquire.LoadPointer(&v.p)); b != nil {
+		return b.v
+	}
+	return nil
+}
+
+// newValueBox returns the box to publish for val, or nil to clear the Value.
+func newValueBox(val any) unsafe.Pointer {
+	if val == nil {
+		return nil
+	}
+	return unsafe.Pointer(&valueBox{val})
+}
+
+// check reports whether val may replace the box cur under the policy.
+// Under TypePanic it panics instead of returning an error.
+func (v *Value) check(cur unsafe.Pointer, val any) error {
+	if v.policy == TypeAny {
+		return nil
+	}
+	var err error
+	switch {
+	case val == nil:
+		err = ErrNilValue
+	case cur != nil && reflect.TypeOf((*valueBox)(cur).v) != reflect.TypeOf(val):
+		err = ErrInconsistentType
+	default:
+		return nil
+	}
+	if v.policy == TypePanic {
+		panic(err)
+	}
+	return err
+}
+
+// store publishes val. Under a checking policy the type is validated
+// against the value being replaced, so the check and the store are one CAS.
+func (v *Value) store(val any) error {
+	if v.policy == TypeAny {
+		Release.StorePointer(&v.p, newValueBox(val))
+		return nil
+	}
+	_, err := v.swap(val)
+	return err
+}
+
+func (v *Value) swap(new any) (any, error) {
+	if v.policy == TypeAny {
+		if b := (*valueBox)(AcqRel.SwapPointer(&v.p, newValueBox(new))); b != nil {
+			return b.v, nil
+		}
+		return nil, nil
+	}
+	nb := newValueBox(new)
+	var b backoff
+	for {
+		cur := Acquire.LoadPointer(&v.p)
+		if err := v.check(cur, new); err != nil {
+			return nil, err
+		}
+		if AcqRel.CompareAndSwapPointer(&v.p, cur, nb) {
+			if cur == nil {
+				return nil, nil
+			}
+			return (*valueBox)(cur).v, nil
+		}
+		b.wait()
+	}
+}
+
+func (v *Value) compareAndSwap(old, new any) (bool, error) {
+	nb := newValueBox(new)
+	var b backoff
+	for {
+		cur := Acquire.LoadPointer(&v.p)
+		var curVal any
+		if cur != nil {
+			curVal = (*valueBox)(cur).v
+		}
+		if curVal != old {
+			return false, nil
+		}
+		if err := v.check(cur, new); err != nil {
+			return false, err
+		}
+		if AcqRel.CompareAndSwapPointer(&v.p, cur, nb) {
+			return true, nil
+		}
+		// Another box was published; it may still hold a value equal to old.
+		b.wait()
+	}
+}
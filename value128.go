@@ -0,0 +1,258 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix
+
+import (
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+	"strconv"
+)
+
+// U128 is a 128-bit unsigned integer value, (Hi << 64) | Lo.
+//
+// U128 is a plain value type used to load, store, and compute on the
+// contents of a [Uint128]. Arithmetic wraps modulo 2^128.
+type U128 struct {
+	Lo, Hi uint64
+}
+
+// I128 is a 128-bit two's complement signed integer value, (Hi << 64) | Lo.
+//
+// I128 is a plain value type used to load, store, and compute on the
+// contents of an [Int128]. Hi carries the sign; Lo is the unsigned low word.
+// Arithmetic wraps modulo 2^128.
+type I128 struct {
+	Lo uint64
+	Hi int64
+}
+
+// U128From64 returns v as a U128.
+func U128From64(v uint64) U128 {
+	return U128{Lo: v}
+}
+
+// I128From64 returns v as an I128, sign-extended.
+func I128From64(v int64) I128 {
+	return I128{Lo: uint64(v), Hi: v >> 63}
+}
+
+// IsZero reports whether u is 0.
+func (u U128) IsZero() bool {
+	return u.Lo|u.Hi == 0
+}
+
+// Add returns u + v.
+func (u U128) Add(v U128) U128 {
+	lo, c := bits.Add64(u.Lo, v.Lo, 0)
+	hi, _ := bits.Add64(u.Hi, v.Hi, c)
+	return U128{lo, hi}
+}
+
+// Sub returns u - v.
+func (u U128) Sub(v U128) U128 {
+	lo, b := bits.Sub64(u.Lo, v.Lo, 0)
+	hi, _ := bits.Sub64(u.Hi, v.Hi, b)
+	return U128{lo, hi}
+}
+
+// Mul returns u * v.
+func (u U128) Mul(v U128) U128 {
+	hi, lo := bits.Mul64(u.Lo, v.Lo)
+	hi += u.Hi*v.Lo + u.Lo*v.Hi
+	return U128{lo, hi}
+}
+
+// Lsh returns u << n. Shifts of 128 or more return 0.
+func (u U128) Lsh(n uint) U128 {
+	switch {
+	case n >= 128:
+		return U128{}
+	case n >= 64:
+		return U128{0, u.Lo << (n - 64)}
+	default:
+		return U128{u.Lo << n, u.Hi<<n | u.Lo>>(64-n)}
+	}
+}
+
+// Rsh returns u >> n. Shifts of 128 or more return 0.
+func (u U128) Rsh(n uint) U128 {
+	switch {
+	case n >= 128:
+		return U128{}
+	case n >= 64:
+		return U128{u.Hi >> (n - 64), 0}
+	default:
+		return U128{u.Lo>>n | u.Hi<<(64-n), u.Hi >> n}
+	}
+}
+
+// And returns u & v.
+func (u U128) And(v U128) U128 {
+	return U128{u.Lo & v.Lo, u.Hi & v.Hi}
+}
+
+// Or returns u | v.
+func (u U128) Or(v U128) U128 {
+	return U128{u.Lo | v.Lo, u.Hi | v.Hi}
+}
+
+// Xor returns u ^ v.
+func (u U128) Xor(v U128) U128 {
+	return U128{u.Lo ^ v.Lo, u.Hi ^ v.Hi}
+}
+
+// Cmp compares u and v and returns -1, 0, or +1.
+func (u U128) Cmp(v U128) int {
+	switch {
+	case u.Hi < v.Hi || (u.Hi == v.Hi && u.Lo < v.Lo):
+		return -1
+	case u == v:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// I128 returns u reinterpreted as a two's complement I128.
+func (u U128) I128() I128 {
+	return I128{u.Lo, int64(u.Hi)}
+}
+
+// String returns the decimal representation of u.
+func (u U128) String() string {
+	if u.Hi == 0 {
+		return strconv.FormatUint(u.Lo, 10)
+	}
+	const e19 = 1e19
+	q := U128{Hi: u.Hi / e19}
+	var r uint64
+	q.Lo, r = bits.Div64(u.Hi%e19, u.Lo, e19)
+	s := strconv.FormatUint(r, 10)
+	return q.String() + "0000000000000000000"[len(s):] + s
+}
+
+// Big returns u as a [big.Int].
+func (u U128) Big() *big.Int {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], u.Hi)
+	binary.BigEndian.PutUint64(b[8:], u.Lo)
+	return new(big.Int).SetBytes(b[:])
+}
+
+// U128FromBig returns b as a U128. ok is false, and u is 0, if b is
+// negative or does not fit in 128 bits.
+func U128FromBig(b *big.Int) (u U128, ok bool) {
+	if b.Sign() < 0 || b.BitLen() > 128 {
+		return U128{}, false
+	}
+	var buf [16]byte
+	b.FillBytes(buf[:])
+	return U128{binary.BigEndian.Uint64(buf[8:]), binary.BigEndian.Uint64(buf[:8])}, true
+}
+
+// IsZero reports whether i is 0.
+func (i I128) IsZero() bool {
+	return i.Lo == 0 && i.Hi == 0
+}
+
+// Sign returns -1, 0, or +1 depending on the sign of i.
+func (i I128) Sign() int {
+	switch {
+	case i.Hi < 0:
+		return -1
+	case i.IsZero():
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Neg returns -i. The negation of the minimum value is itself.
+func (i I128) Neg() I128 {
+	return I128{}.Sub(i)
+}
+
+// Add returns i + v.
+func (i I128) Add(v I128) I128 {
+	return i.U128().Add(v.U128()).I128()
+}
+
+// Sub returns i - v.
+func (i I128) Sub(v I128) I128 {
+	return i.U128().Sub(v.U128()).I128()
+}
+
+// Mul returns i * v.
+func (i I128) Mul(v I128) I128 {
+	return i.U128().Mul(v.U128()).I128()
+}
+
+// Lsh returns i << n. Shifts of 128 or more return 0.
+func (i I128) Lsh(n uint) I128 {
+	return i.U128().Lsh(n).I128()
+}
+
+// Rsh returns i >> n, sign-extending. Shifts of 128 or more return 0 or -1.
+func (i I128) Rsh(n uint) I128 {
+	switch {
+	case n >= 128:
+		return I128{uint64(i.Hi >> 63), i.Hi >> 63}
+	case n >= 64:
+		return I128{uint64(i.Hi >> (n - 64)), i.Hi >> 63}
+	default:
+		return I128{i.Lo>>n | uint64(i.Hi)<<(64-n), i.Hi >> n}
+	}
+}
+
+// Cmp compares i and v as signed integers and returns -1, 0, or +1.
+func (i I128) Cmp(v I128) int {
+	switch {
+	case i.Hi < v.Hi || (i.Hi == v.Hi && i.Lo < v.Lo):
+		return -1
+	case i == v:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// U128 returns i reinterpreted as an unsigned U128.
+func (i I128) U128() U128 {
+	return U128{i.Lo, uint64(i.Hi)}
+}
+
+// String returns the decimal representation of i.
+func (i I128) String() string {
+	if i.Hi < 0 {
+		return "-" + i.Neg().U128().String()
+	}
+	return i.U128().String()
+}
+
+// Big returns i as a [big.Int].
+func (i I128) Big() *big.Int {
+	if i.Hi < 0 {
+		return new(big.Int).Neg(i.Neg().U128().Big())
+	}
+	return i.U128().Big()
+}
+
+// I128FromBig returns b as an I128. ok is false, and i is 0, if b does not
+// fit in 128-bit two's complement.
+func I128FromBig(b *big.Int) (i I128, ok bool) {
+	if b.Sign() >= 0 {
+		u, ok := U128FromBig(b)
+		if !ok || u.Hi>>63 != 0 {
+			return I128{}, false
+		}
+		return u.I128(), true
+	}
+	u, ok := U128FromBig(new(big.Int).Neg(b))
+	if !ok || u.Cmp(U128{0, 1 << 63}) > 0 {
+		return I128{}, false
+	}
+	return u.I128().Neg(), true
+}
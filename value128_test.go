@@ -0,0 +1,222 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// U128 / I128 Value Tests
+// =============================================================================
+
+var two128 = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// wrapU reduces b modulo 2^128.
+func wrapU(b *big.Int) *big.Int {
+	return new(big.Int).Mod(b, two128)
+}
+
+// wrapI reduces b into the signed 128-bit range.
+func wrapI(b *big.Int) *big.Int {
+	r := wrapU(b)
+	if r.Bit(127) == 1 {
+		r.Sub(r, two128)
+	}
+	return r
+}
+
+func randU128(r *rand.Rand) atomix.U128 {
+	// Mix in edge words so carries and sign bits are exercised.
+	edge := []uint64{0, 1, math.MaxUint64, 1 << 63, 1<<63 - 1}
+	pick := func() uint64 {
+		if r.IntN(3) == 0 {
+			return edge[r.IntN(len(edge))]
+		}
+		return r.Uint64()
+	}
+	return atomix.U128{Lo: pick(), Hi: pick()}
+}
+
+func TestU128ArithmeticMatchesBig(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 2000 {
+		x, y := randU128(r), randU128(r)
+		n := uint(r.IntN(140))
+		bx, by := x.Big(), y.Big()
+		checks := []struct {
+			name string
+			got  atomix.U128
+			want *big.Int
+		}{
+			{"Add", x.Add(y), wrapU(new(big.Int).Add(bx, by))},
+			{"Sub", x.Sub(y), wrapU(new(big.Int).Sub(bx, by))},
+			{"Mul", x.Mul(y), wrapU(new(big.Int).Mul(bx, by))},
+			{"Lsh", x.Lsh(n), wrapU(new(big.Int).Lsh(bx, n))},
+			{"Rsh", x.Rsh(n), new(big.Int).Rsh(bx, n)},
+			{"And", x.And(y), new(big.Int).And(bx, by)},
+			{"Or", x.Or(y), new(big.Int).Or(bx, by)},
+			{"Xor", x.Xor(y), new(big.Int).Xor(bx, by)},
+		}
+		for _, c := range checks {
+			if c.got.Big().Cmp(c.want) != 0 {
+				t.Fatalf("%s(%v, %v, %d): got %v, want %v", c.name, x, y, n, c.got, c.want)
+			}
+		}
+		if got, want := x.Cmp(y), bx.Cmp(by); got != want {
+			t.Fatalf("Cmp(%v, %v): got %d, want %d", x, y, got, want)
+		}
+		if x.String() != bx.String() {
+			t.Fatalf("String: got %s, want %s", x.String(), bx.String())
+		}
+		if back, ok := atomix.U128FromBig(bx); !ok || back != x {
+			t.Fatalf("U128FromBig(%v): got (%v, %v)", bx, back, ok)
+		}
+	}
+}
+
+func TestI128ArithmeticMatchesBig(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 2000 {
+		x, y := randU128(r).I128(), randU128(r).I128()
+		n := uint(r.IntN(140))
+		bx, by := x.Big(), y.Big()
+		checks := []struct {
+			name string
+			got  atomix.I128
+			want *big.Int
+		}{
+			{"Add", x.Add(y), wrapI(new(big.Int).Add(bx, by))},
+			{"Sub", x.Sub(y), wrapI(new(big.Int).Sub(bx, by))},
+			{"Mul", x.Mul(y), wrapI(new(big.Int).Mul(bx, by))},
+			{"Neg", x.Neg(), wrapI(new(big.Int).Neg(bx))},
+			{"Lsh", x.Lsh(n), wrapI(new(big.Int).Lsh(bx, n))},
+			{"Rsh", x.Rsh(n), new(big.Int).Rsh(bx, n)},
+		}
+		for _, c := range checks {
+			if c.got.Big().Cmp(c.want) != 0 {
+				t.Fatalf("%s(%v, %v, %d): got %v, want %v", c.name, x, y, n, c.got, c.want)
+			}
+		}
+		if got, want := x.Cmp(y), bx.Cmp(by); got != want {
+			t.Fatalf("Cmp(%v, %v): got %d, want %d", x, y, got, want)
+		}
+		if x.Sign() != bx.Sign() {
+			t.Fatalf("Sign(%v): got %d, want %d", x, x.Sign(), bx.Sign())
+		}
+		if x.String() != bx.String() {
+			t.Fatalf("String: got %s, want %s", x.String(), bx.String())
+		}
+		if back, ok := atomix.I128FromBig(bx); !ok || back != x {
+			t.Fatalf("I128FromBig(%v): got (%v, %v)", bx, back, ok)
+		}
+	}
+}
+
+func TestValue128Edges(t *testing.T) {
+	maxU := atomix.U128{Lo: math.MaxUint64, Hi: math.MaxUint64}
+	if maxU.String() != "340282366920938463463374607431768211455" {
+		t.Fatalf("max U128 String: %s", maxU)
+	}
+	if maxU.Add(atomix.U128From64(1)) != (atomix.U128{}) || !maxU.Add(atomix.U128From64(1)).IsZero() {
+		t.Fatal("max U128 + 1 should wrap to 0")
+	}
+	minI := atomix.I128{Hi: math.MinInt64}
+	if minI.String() != "-170141183460469231731687303715884105728" {
+		t.Fatalf("min I128 String: %s", minI)
+	}
+	if minI.Neg() != minI {
+		t.Fatal("-min I128 should be min I128")
+	}
+	if atomix.I128From64(-5) != (atomix.I128{Lo: math.MaxUint64 - 4, Hi: -1}) {
+		t.Fatalf("I128From64(-5): got %+v", atomix.I128From64(-5))
+	}
+	if got, _ := atomix.I128FromBig(minI.Big()); got != minI {
+		t.Fatalf("I128FromBig(min): got %v", got)
+	}
+
+	tooBig := new(big.Int).Lsh(big.NewInt(1), 128)
+	if _, ok := atomix.U128FromBig(tooBig); ok {
+		t.Error("U128FromBig(2^128) should fail")
+	}
+	if _, ok := atomix.U128FromBig(big.NewInt(-1)); ok {
+		t.Error("U128FromBig(-1) should fail")
+	}
+	if _, ok := atomix.I128FromBig(new(big.Int).Lsh(big.NewInt(1), 127)); ok {
+		t.Error("I128FromBig(2^127) should fail")
+	}
+	if _, ok := atomix.I128FromBig(new(big.Int).Sub(new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127)), big.NewInt(1))); ok {
+		t.Error("I128FromBig(-2^127-1) should fail")
+	}
+}
+
+func TestUint128ValueMethods(t *testing.T) {
+	_, a := atomix.PlaceAlignedUint128(make([]byte, 64), 0)
+	one := atomix.U128From64(1)
+	a.StoreValue(atomix.U128{Lo: math.MaxUint64})
+	if got := a.AddValue(one); got != (atomix.U128{Hi: 1}) {
+		t.Fatalf("AddValue carry: got %+v", got)
+	}
+	if got := a.SubValue(one); got != (atomix.U128{Lo: math.MaxUint64}) {
+		t.Fatalf("SubValue borrow: got %+v", got)
+	}
+	a.StoreValueRelease(atomix.U128{Lo: 7, Hi: 9})
+	if a.LoadValueAcquire() != (atomix.U128{Lo: 7, Hi: 9}) || a.LoadValueRelaxed() != a.LoadValue() {
+		t.Fatal("LoadValue mismatch")
+	}
+	if old := a.SwapValue(one); old != (atomix.U128{Lo: 7, Hi: 9}) {
+		t.Fatalf("SwapValue: got old %+v", old)
+	}
+	if a.CompareAndSwapValue(atomix.U128{}, one) {
+		t.Fatal("CompareAndSwapValue should fail")
+	}
+	if !a.CompareAndSwapValue(one, atomix.U128{Hi: 2}) {
+		t.Fatal("CompareAndSwapValue should succeed")
+	}
+	if prev := a.CompareExchangeValue(atomix.U128{Hi: 2}, maxU128()); prev != (atomix.U128{Hi: 2}) {
+		t.Fatalf("CompareExchangeValue: got %+v", prev)
+	}
+	a.StoreValueRelaxed(one)
+	if !a.Less(0, 1) || !a.Greater(0, 0) || !a.GreaterOrEqual(1, 0) || !a.LessOrEqual(1, 0) {
+		t.Fatal("comparison helpers disagree with value")
+	}
+}
+
+func maxU128() atomix.U128 {
+	return atomix.U128{Lo: math.MaxUint64, Hi: math.MaxUint64}
+}
+
+func TestInt128ValueMethods(t *testing.T) {
+	_, a := atomix.PlaceAlignedInt128(make([]byte, 64), 0)
+	a.StoreValue(atomix.I128From64(-1))
+	if got := a.AddValue(atomix.I128From64(2)); got != atomix.I128From64(1) {
+		t.Fatalf("AddValue: got %v", got)
+	}
+	if got := a.SubValue(atomix.I128From64(3)); got != atomix.I128From64(-2) {
+		t.Fatalf("SubValue: got %v", got)
+	}
+	if a.LoadValue().String() != "-2" || a.LoadValueAcquire() != a.LoadValueRelaxed() {
+		t.Fatalf("LoadValue: got %v", a.LoadValue())
+	}
+	a.StoreValueRelease(atomix.I128From64(5))
+	a.StoreValueRelaxed(a.LoadValue().Neg())
+	if old := a.SwapValue(atomix.I128From64(10)); old != atomix.I128From64(-5) {
+		t.Fatalf("SwapValue: got old %v", old)
+	}
+	if a.CompareAndSwapValue(atomix.I128From64(0), atomix.I128From64(1)) {
+		t.Fatal("CompareAndSwapValue should fail")
+	}
+	if prev := a.CompareExchangeValue(atomix.I128From64(10), atomix.I128From64(-10)); prev != atomix.I128From64(10) {
+		t.Fatalf("CompareExchangeValue: got %v", prev)
+	}
+	if !a.Less(0, 0) || !a.Greater(-11, -1) || a.GreaterOrEqual(0, 0) || !a.LessOrEqual(-10, -1) {
+		t.Fatal("signed comparison helpers disagree with value")
+	}
+}
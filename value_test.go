@@ -0,0 +1,184 @@
+// ©Hayabusa Cloud Co., Ltd. 2026. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package atomix_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"code.hybscloud.com/atomix"
+)
+
+// =============================================================================
+// Value Tests
+// =============================================================================
+
+func TestValueLoadStore(t *testing.T) {
+	var v atomix.Value
+	if v.Load() != nil || v.Policy() != atomix.TypePanic {
+		t.Fatal("zero Value should hold nil under TypePanic")
+	}
+	stores := []func(any) error{v.Store, v.StoreRelaxed, v.StoreRelease}
+	loads := []func() any{v.Load, v.LoadRelaxed, v.LoadAcquire}
+	for i, store := range stores {
+		if err := store(i); err != nil {
+			t.Fatalf("Store[%d]: %v", i, err)
+		}
+		for j, load := range loads {
+			if got := load(); got != i {
+				t.Fatalf("Store[%d]/Load[%d]: got %v", i, j, got)
+			}
+		}
+	}
+}
+
+func TestValueSwapCompareAndSwap(t *testing.T) {
+	v := atomix.NewValue(atomix.TypeError)
+	swaps := []func(any) (any, error){v.Swap, v.SwapRelaxed, v.SwapAcquire, v.SwapRelease, v.SwapAcqRel}
+	for i, swap := range swaps {
+		old, err := swap("s" + string(rune('0'+i)))
+		if err != nil {
+			t.Fatalf("Swap[%d]: %v", i, err)
+		}
+		if i == 0 && old != nil || i > 0 && old != "s"+string(rune('0'+i-1)) {
+			t.Fatalf("Swap[%d]: old = %v", i, old)
+		}
+	}
+	cases := []func(any, any) (bool, error){
+		v.CompareAndSwap, v.CompareAndSwapRelaxed, v.CompareAndSwapAcquire,
+		v.CompareAndSwapRelease, v.CompareAndSwapAcqRel,
+	}
+	for i, cas := range cases {
+		v.Store("a")
+		if ok, err := cas("b", "c"); ok || err != nil || v.Load() != "a" {
+			t.Fatalf("CompareAndSwap[%d] mismatch: (%v, %v), holds %v", i, ok, err, v.Load())
+		}
+		// old is compared by value, not by box identity.
+		if ok, err := cas(string([]byte("a")), "c"); !ok || err != nil || v.Load() != "c" {
+			t.Fatalf("CompareAndSwap[%d] match: (%v, %v), holds %v", i, ok, err, v.Load())
+		}
+	}
+
+	var empty atomix.Value
+	if ok, _ := empty.CompareAndSwap(nil, 1); !ok || empty.Load() != 1 {
+		t.Fatal("CompareAndSwap(nil, x) on an empty Value should store x")
+	}
+}
+
+func TestValuePolicy(t *testing.T) {
+	mustPanic := func(name string, f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatalf("%s: expected panic", name)
+			}
+		}()
+		f()
+	}
+	var p atomix.Value
+	mustPanic("Store(nil)", func() { p.Store(nil) })
+	p.Store(1)
+	mustPanic("Store(string)", func() { p.Store("x") })
+	mustPanic("Swap(string)", func() { p.Swap("x") })
+	mustPanic("CompareAndSwap(string)", func() { p.CompareAndSwap(1, "x") })
+	if p.Load() != 1 {
+		t.Fatalf("TypePanic: failed stores changed the value to %v", p.Load())
+	}
+
+	e := atomix.NewValue(atomix.TypeError)
+	if err := e.Store(nil); !errors.Is(err, atomix.ErrNilValue) {
+		t.Fatalf("TypeError Store(nil): %v", err)
+	}
+	e.Store(1)
+	if err := e.StoreRelease("x"); !errors.Is(err, atomix.ErrInconsistentType) {
+		t.Fatalf("TypeError Store(string): %v", err)
+	}
+	if old, err := e.Swap(int64(1)); !errors.Is(err, atomix.ErrInconsistentType) || old != nil {
+		t.Fatalf("TypeError Swap(int64): (%v, %v)", old, err)
+	}
+	if ok, err := e.CompareAndSwap(1, 2.0); ok || !errors.Is(err, atomix.ErrInconsistentType) {
+		t.Fatalf("TypeError CompareAndSwap(float64): (%v, %v)", ok, err)
+	}
+	if e.Load() != 1 {
+		t.Fatalf("TypeError: rejected stores changed the value to %v", e.Load())
+	}
+
+	var a atomix.Value
+	a.SetPolicy(atomix.TypeAny)
+	for _, x := range []any{1, "x", nil, 2.5} {
+		if err := a.Store(x); err != nil || a.Load() != x {
+			t.Fatalf("TypeAny Store(%v): err %v, holds %v", x, err, a.Load())
+		}
+	}
+	if old, _ := a.Swap(nil); old != 2.5 || a.Load() != nil {
+		t.Fatalf("TypeAny Swap(nil): old %v, holds %v", old, a.Load())
+	}
+}
+
+func TestValuePublishTypeAny(t *testing.T) {
+	// A TypeAny reader must see each value whole, whichever variant stored
+	// it, even as the dynamic type changes from store to store.
+	type pair struct{ a, b int }
+	v := atomix.NewValue(atomix.TypeAny)
+	var done atomix.Bool
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Go(func() {
+			for !done.LoadRelaxed() {
+				switch x := v.LoadRelaxed().(type) {
+				case nil:
+				case *pair:
+					if x.a != -x.b {
+						t.Errorf("torn *pair %+v", *x)
+						return
+					}
+				case [2]int:
+					if x[0] != -x[1] {
+						t.Errorf("torn [2]int %v", x)
+						return
+					}
+				default:
+					t.Errorf("unexpected %T", x)
+					return
+				}
+			}
+		})
+	}
+	for i := range 100000 {
+		if i%2 == 0 {
+			v.StoreRelaxed(&pair{i, -i})
+		} else {
+			v.CompareAndSwapRelaxed(v.LoadRelaxed(), [2]int{i, -i})
+		}
+	}
+	done.StoreRelaxed(true)
+	wg.Wait()
+}
+
+func TestValueConcurrentFirstStore(t *testing.T) {
+	// Racing first stores of different types: exactly one type wins and
+	// every other store is rejected.
+	for range 100 {
+		v := atomix.NewValue(atomix.TypeError)
+		var wg sync.WaitGroup
+		var rejected atomix.Int32
+		for g := range 8 {
+			wg.Go(func() {
+				var x any = g
+				if g%2 == 1 {
+					x = "s"
+				}
+				if v.StoreRelease(x) != nil {
+					rejected.Add(1)
+				}
+			})
+		}
+		wg.Wait()
+		if want := int32(4); rejected.Load() != want {
+			t.Fatalf("rejected %d stores, want %d (holds %T)", rejected.Load(), want, v.LoadAcquire())
+		}
+	}
+}